@@ -0,0 +1,111 @@
+package cdcexchange
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/sngyai/go-cryptocom/v2/errors"
+	"github.com/sngyai/go-cryptocom/v2/internal/api"
+	"github.com/sngyai/go-cryptocom/v2/internal/auth"
+)
+
+const (
+	methodAcceptOTCQuote = "private/otc/accept-quote"
+)
+
+type (
+	// AcceptOTCQuoteResponse is the base response returned from the private/otc/accept-quote API.
+	AcceptOTCQuoteResponse struct {
+		// BaseResponse is the common response fields.
+		BaseResponse
+		// Result is the response attributes of the endpoint.
+		Result OTCDeal `json:"result"`
+	}
+
+	// OTCDeal represents an OTC quote along with the details of the trade it was accepted into.
+	OTCDeal struct {
+		// OTCQuote is the quote which was accepted.
+		OTCQuote
+		// TradeDirection is the side of the trade (BUY/SELL).
+		TradeDirection OTCQuoteDirection `json:"trade_direction"`
+		// TradePrice is the price the trade was executed at.
+		TradePrice Decimal `json:"trade_price"`
+		// TradeQuantity is the base currency quantity traded.
+		TradeQuantity Decimal `json:"trade_quantity"`
+		// TradeValue is the quote currency value traded.
+		TradeValue Decimal `json:"trade_value"`
+		// TradeTime is the time the trade was executed.
+		TradeTime Timestamp `json:"trade_time"`
+		// Raw is the raw JSON of the result, for fields not decoded by this package (only set when returned by AcceptOTCQuote).
+		Raw json.RawMessage `json:"-"`
+	}
+)
+
+// AcceptOTCQuote accepts a quote previously returned from RequestOTCQuote, completing the RFQ flow.
+//
+// errors.ErrOTCQuoteExpired is returned if the quote expired before it was accepted,
+// and errors.ErrOTCQuoteAlreadyUsed is returned if the quote has already been accepted or rejected.
+//
+// Method: private/otc/accept-quote
+func (c *Client) AcceptOTCQuote(ctx context.Context, quoteID string) (*OTCDeal, error) {
+	if quoteID == "" {
+		return nil, errors.InvalidParameterError{Parameter: "quoteID", Reason: "cannot be empty"}
+	}
+
+	var (
+		id        = c.idGenerator.Generate()
+		timestamp = c.now().UnixMilli()
+		params    = make(map[string]interface{})
+	)
+
+	creds, err := c.credentials(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	params["quote_id"] = quoteID
+
+	signature, err := c.signatureGenerator.GenerateSignature(auth.SignatureRequest{
+		APIKey:    creds.APIKey,
+		SecretKey: creds.SecretKey,
+		ID:        id,
+		Method:    methodAcceptOTCQuote,
+		Timestamp: timestamp,
+		Params:    params,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create signature: %w", err)
+	}
+
+	body := api.Request{
+		ID:        id,
+		Method:    methodAcceptOTCQuote,
+		Nonce:     timestamp,
+		Params:    params,
+		Signature: signature,
+		APIKey:    creds.APIKey,
+	}
+
+	var acceptOTCQuoteResponse AcceptOTCQuoteResponse
+	statusCode, err := c.requester.Post(ctx, body, methodAcceptOTCQuote, &acceptOTCQuoteResponse)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute post request: %w", err)
+	}
+
+	if err := c.requester.CheckResponse(statusCode, api.BaseResponse(acceptOTCQuoteResponse.BaseResponse)); err != nil {
+		return nil, fmt.Errorf("error received in response: %w", err)
+	}
+
+	// the venue reports quotes which can no longer be accepted through the quote status.
+	switch acceptOTCQuoteResponse.Result.QuoteStatus {
+	case OTCQuoteStatusExpired:
+		return nil, fmt.Errorf("quote %s cannot be accepted: %w", quoteID, errors.ErrOTCQuoteExpired)
+	case OTCQuoteStatusRejected:
+		return nil, fmt.Errorf("quote %s cannot be accepted: %w", quoteID, errors.ErrOTCQuoteAlreadyUsed)
+	}
+
+	acceptOTCQuoteResponse.Result.Raw = acceptOTCQuoteResponse.RawResult
+
+	return &acceptOTCQuoteResponse.Result, nil
+}
@@ -0,0 +1,179 @@
+package cdcexchange_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/jonboulle/clockwork"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	cdcexchange "github.com/sngyai/go-cryptocom/v2"
+	cdcerrors "github.com/sngyai/go-cryptocom/v2/errors"
+	"github.com/sngyai/go-cryptocom/v2/internal/auth"
+	id_mocks "github.com/sngyai/go-cryptocom/v2/internal/mocks/id"
+	signature_mocks "github.com/sngyai/go-cryptocom/v2/internal/mocks/signature"
+)
+
+func TestClient_AcceptOTCQuote_Error(t *testing.T) {
+	const (
+		apiKey    = "some api key"
+		secretKey = "some secret key"
+		id        = int64(1234)
+		quoteID   = "2412323"
+	)
+
+	tests := []struct {
+		name        string
+		quoteID     string
+		quoteStatus cdcexchange.OTCQuoteStatus
+		expectedErr error
+	}{
+		{
+			name:        "returns error when quote id is empty",
+			expectedErr: cdcerrors.InvalidParameterError{Parameter: "quoteID", Reason: "cannot be empty"},
+		},
+		{
+			name:        "returns error when quote has expired",
+			quoteID:     quoteID,
+			quoteStatus: cdcexchange.OTCQuoteStatusExpired,
+			expectedErr: cdcerrors.ErrOTCQuoteExpired,
+		},
+		{
+			name:        "returns error when quote has already been used",
+			quoteID:     quoteID,
+			quoteStatus: cdcexchange.OTCQuoteStatusRejected,
+			expectedErr: cdcerrors.ErrOTCQuoteAlreadyUsed,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl, ctx := gomock.WithContext(context.Background(), t)
+			t.Cleanup(ctrl.Finish)
+
+			var (
+				signatureGenerator = signature_mocks.NewMockSignatureGenerator(ctrl)
+				idGenerator        = id_mocks.NewMockIDGenerator(ctrl)
+			)
+
+			s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_, err := fmt.Fprintf(w, `{"id": 1234, "code": 0, "result": {"quote_id": "%s", "quote_status": "%s"}}`, tt.quoteID, tt.quoteStatus)
+				require.NoError(t, err)
+			}))
+			t.Cleanup(s.Close)
+
+			client, err := cdcexchange.New(apiKey, secretKey,
+				cdcexchange.WithIDGenerator(idGenerator),
+				cdcexchange.WithHTTPClient(s.Client()),
+				cdcexchange.WithBaseURL(fmt.Sprintf("%s/", s.URL)),
+				cdcexchange.WithSignatureGenerator(signatureGenerator),
+			)
+			require.NoError(t, err)
+
+			if tt.quoteID != "" {
+				idGenerator.EXPECT().Generate().Return(id)
+				signatureGenerator.EXPECT().GenerateSignature(gomock.Any()).Return("signature", nil)
+			}
+
+			deal, err := client.AcceptOTCQuote(ctx, tt.quoteID)
+			require.Error(t, err)
+
+			assert.Nil(t, deal)
+			assert.True(t, errors.Is(err, tt.expectedErr))
+		})
+	}
+}
+
+func TestClient_AcceptOTCQuote_Success(t *testing.T) {
+	const (
+		apiKey    = "some api key"
+		secretKey = "some secret key"
+		id        = int64(1234)
+		signature = "some signature"
+		quoteID   = "2412323"
+	)
+	now := time.Now()
+
+	ctrl, ctx := gomock.WithContext(context.Background(), t)
+	t.Cleanup(ctrl.Finish)
+
+	var (
+		signatureGenerator = signature_mocks.NewMockSignatureGenerator(ctrl)
+		idGenerator        = id_mocks.NewMockIDGenerator(ctrl)
+		clock              = clockwork.NewFakeClockAt(now)
+	)
+
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Contains(t, r.URL.Path, cdcexchange.MethodAcceptOTCQuote)
+
+		_, err := w.Write([]byte(`{
+			"id": 1234,
+			"method": "private/otc/accept-quote",
+			"code": 0,
+			"result": {
+				"quote_id": "2412323",
+				"quote_status": "FILLED",
+				"quote_direction": "BUY",
+				"base_currency": "BTC",
+				"quote_currency": "USDT",
+				"base_currency_size": "1.5",
+				"quote_buy": "39640.0",
+				"trade_direction": "BUY",
+				"trade_price": "39640.0",
+				"trade_quantity": "1.5",
+				"trade_value": "59460.0",
+				"trade_time": 1635410001000
+			}
+		}`))
+		require.NoError(t, err)
+	}))
+	t.Cleanup(s.Close)
+
+	client, err := cdcexchange.New(apiKey, secretKey,
+		cdcexchange.WithIDGenerator(idGenerator),
+		cdcexchange.WithClock(clock),
+		cdcexchange.WithHTTPClient(s.Client()),
+		cdcexchange.WithBaseURL(fmt.Sprintf("%s/", s.URL)),
+		cdcexchange.WithSignatureGenerator(signatureGenerator),
+	)
+	require.NoError(t, err)
+
+	idGenerator.EXPECT().Generate().Return(id)
+	signatureGenerator.EXPECT().GenerateSignature(auth.SignatureRequest{
+		APIKey:    apiKey,
+		SecretKey: secretKey,
+		ID:        id,
+		Method:    cdcexchange.MethodAcceptOTCQuote,
+		Timestamp: now.UnixMilli(),
+		Params:    map[string]interface{}{"quote_id": quoteID},
+	}).Return(signature, nil)
+
+	deal, err := client.AcceptOTCQuote(ctx, quoteID)
+	require.NoError(t, err)
+
+	require.NotEmpty(t, deal.Raw)
+	deal.Raw = nil
+
+	assert.Equal(t, &cdcexchange.OTCDeal{
+		OTCQuote: cdcexchange.OTCQuote{
+			QuoteID:          quoteID,
+			QuoteStatus:      cdcexchange.OTCQuoteStatusFilled,
+			QuoteDirection:   cdcexchange.OTCQuoteDirectionBuy,
+			BaseCurrency:     "BTC",
+			QuoteCurrency:    "USDT",
+			BaseCurrencySize: cdcexchange.MustParseDecimal("1.5"),
+			QuoteBuy:         cdcexchange.MustParseDecimal("39640"),
+		},
+		TradeDirection: cdcexchange.OTCQuoteDirectionBuy,
+		TradePrice:     cdcexchange.MustParseDecimal("39640"),
+		TradeQuantity:  cdcexchange.MustParseDecimal("1.5"),
+		TradeValue:     cdcexchange.MustParseDecimal("59460"),
+		TradeTime:      cdcexchange.Timestamp(time.UnixMilli(1635410001000)),
+	}, deal)
+}
@@ -0,0 +1,133 @@
+package cdcexchange
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/sngyai/go-cryptocom/v2/errors"
+	"github.com/sngyai/go-cryptocom/v2/internal/api"
+	"github.com/sngyai/go-cryptocom/v2/internal/auth"
+)
+
+const (
+	methodAmendOrder = "private/amend-order"
+)
+
+type (
+	// AmendOrderRequest is the request params sent for the private/amend-order API.
+	//
+	// Exactly one of OrderID and OrigClientOID must be provided.
+	AmendOrderRequest struct {
+		// OrderID is the exchange order ID of the order to amend.
+		OrderID string `json:"order_id"`
+		// OrigClientOID is the Client order ID of the order to amend.
+		OrigClientOID string `json:"orig_client_oid"`
+		// ClientOID is the optional Client order ID given to the amended order (Max: 36 characters).
+		// if ClientOID is omitted, the amended order keeps the Client order ID of the original order.
+		ClientOID string `json:"client_oid"`
+		// NewPrice is the new price of the order.
+		NewPrice Decimal `json:"new_price"`
+		// NewQuantity is the new quantity of the order.
+		NewQuantity Decimal `json:"new_quantity"`
+	}
+
+	// AmendOrderResponse is the base response returned from the private/amend-order API.
+	AmendOrderResponse struct {
+		// BaseResponse is the common response fields.
+		BaseResponse
+		// Result is the response attributes of the endpoint.
+		Result AmendOrderResult `json:"result"`
+	}
+
+	// AmendOrderResult is the result returned from the private/amend-order API.
+	AmendOrderResult struct {
+		// OrderID is the order ID of the amended order.
+		OrderID string `json:"order_id"`
+		// ClientOID is the Client order ID of the amended order.
+		ClientOID string `json:"client_oid"`
+		// Raw is the raw JSON of the result, for fields not decoded by this package.
+		Raw json.RawMessage `json:"-"`
+	}
+)
+
+// AmendOrder amends the price and quantity of a resting order on the Exchange.
+//
+// The venue replaces the order as a single operation, preserving the Client order ID lineage
+// unless req.ClientOID is provided. The returned OrderID identifies the amended order.
+//
+// This call is asynchronous, so the response is simply a confirmation of the request.
+//
+// Method: private/amend-order
+func (c *Client) AmendOrder(ctx context.Context, req AmendOrderRequest) (*AmendOrderResult, error) {
+	switch {
+	case req.OrderID == "" && req.OrigClientOID == "":
+		return nil, errors.InvalidParameterError{Parameter: "req.OrderID", Reason: "or req.OrigClientOID must be provided"}
+	case req.OrderID != "" && req.OrigClientOID != "":
+		return nil, errors.InvalidParameterError{Parameter: "req.OrderID", Reason: "and req.OrigClientOID are mutually exclusive"}
+	case len(req.ClientOID) > maxClientOIDLength:
+		return nil, errors.InvalidParameterError{Parameter: "req.ClientOID", Reason: "cannot be longer than 36 characters"}
+	case req.NewPrice.Sign() <= 0:
+		return nil, errors.InvalidParameterError{Parameter: "req.NewPrice", Reason: "must be greater than 0"}
+	case req.NewQuantity.Sign() <= 0:
+		return nil, errors.InvalidParameterError{Parameter: "req.NewQuantity", Reason: "must be greater than 0"}
+	}
+
+	var (
+		id        = c.idGenerator.Generate()
+		timestamp = c.now().UnixMilli()
+		params    = make(map[string]interface{})
+	)
+
+	creds, err := c.credentials(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.OrderID != "" {
+		params["order_id"] = req.OrderID
+	}
+	if req.OrigClientOID != "" {
+		params["orig_client_oid"] = req.OrigClientOID
+	}
+	if req.ClientOID != "" {
+		params["client_oid"] = req.ClientOID
+	}
+	params["new_price"] = req.NewPrice
+	params["new_quantity"] = req.NewQuantity
+
+	signature, err := c.signatureGenerator.GenerateSignature(auth.SignatureRequest{
+		APIKey:    creds.APIKey,
+		SecretKey: creds.SecretKey,
+		ID:        id,
+		Method:    methodAmendOrder,
+		Timestamp: timestamp,
+		Params:    params,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create signature: %w", err)
+	}
+
+	body := api.Request{
+		ID:        id,
+		Method:    methodAmendOrder,
+		Nonce:     timestamp,
+		Params:    params,
+		Signature: signature,
+		APIKey:    creds.APIKey,
+	}
+
+	var amendOrderResponse AmendOrderResponse
+	statusCode, err := c.requester.Post(ctx, body, methodAmendOrder, &amendOrderResponse)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute post request: %w", err)
+	}
+
+	if err := c.requester.CheckResponse(statusCode, api.BaseResponse(amendOrderResponse.BaseResponse)); err != nil {
+		return nil, fmt.Errorf("error received in response: %w", err)
+	}
+
+	amendOrderResponse.Result.Raw = amendOrderResponse.RawResult
+
+	return &amendOrderResponse.Result, nil
+}
@@ -0,0 +1,165 @@
+package cdcexchange_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/jonboulle/clockwork"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	cdcexchange "github.com/sngyai/go-cryptocom/v2"
+	cdcerrors "github.com/sngyai/go-cryptocom/v2/errors"
+	"github.com/sngyai/go-cryptocom/v2/internal/api"
+	"github.com/sngyai/go-cryptocom/v2/internal/auth"
+	id_mocks "github.com/sngyai/go-cryptocom/v2/internal/mocks/id"
+	signature_mocks "github.com/sngyai/go-cryptocom/v2/internal/mocks/signature"
+)
+
+func TestClient_AmendOrder_Error(t *testing.T) {
+	const (
+		apiKey    = "some api key"
+		secretKey = "some secret key"
+	)
+
+	tests := []struct {
+		name        string
+		req         cdcexchange.AmendOrderRequest
+		expectedErr error
+	}{
+		{
+			name:        "returns error when neither order id nor original client oid is provided",
+			req:         cdcexchange.AmendOrderRequest{NewPrice: cdcexchange.MustParseDecimal("1"), NewQuantity: cdcexchange.MustParseDecimal("1")},
+			expectedErr: cdcerrors.InvalidParameterError{Parameter: "req.OrderID", Reason: "or req.OrigClientOID must be provided"},
+		},
+		{
+			name:        "returns error when both order id and original client oid are provided",
+			req:         cdcexchange.AmendOrderRequest{OrderID: "1", OrigClientOID: "2", NewPrice: cdcexchange.MustParseDecimal("1"), NewQuantity: cdcexchange.MustParseDecimal("1")},
+			expectedErr: cdcerrors.InvalidParameterError{Parameter: "req.OrderID", Reason: "and req.OrigClientOID are mutually exclusive"},
+		},
+		{
+			name:        "returns error when client oid is too long",
+			req:         cdcexchange.AmendOrderRequest{OrderID: "1", ClientOID: strings.Repeat("a", 37), NewPrice: cdcexchange.MustParseDecimal("1"), NewQuantity: cdcexchange.MustParseDecimal("1")},
+			expectedErr: cdcerrors.InvalidParameterError{Parameter: "req.ClientOID", Reason: "cannot be longer than 36 characters"},
+		},
+		{
+			name:        "returns error when new price is not positive",
+			req:         cdcexchange.AmendOrderRequest{OrderID: "1", NewQuantity: cdcexchange.MustParseDecimal("1")},
+			expectedErr: cdcerrors.InvalidParameterError{Parameter: "req.NewPrice", Reason: "must be greater than 0"},
+		},
+		{
+			name:        "returns error when new quantity is not positive",
+			req:         cdcexchange.AmendOrderRequest{OrderID: "1", NewPrice: cdcexchange.MustParseDecimal("1")},
+			expectedErr: cdcerrors.InvalidParameterError{Parameter: "req.NewQuantity", Reason: "must be greater than 0"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client, err := cdcexchange.New(apiKey, secretKey)
+			require.NoError(t, err)
+
+			res, err := client.AmendOrder(context.Background(), tt.req)
+			require.Error(t, err)
+
+			assert.Nil(t, res)
+			assert.True(t, errors.Is(err, tt.expectedErr))
+		})
+	}
+}
+
+func TestClient_AmendOrder_Success(t *testing.T) {
+	const (
+		apiKey    = "some api key"
+		secretKey = "some secret key"
+		id        = int64(1234)
+		signature = "some signature"
+
+		origClientOID = "some original client oid"
+	)
+	var (
+		newPrice    = cdcexchange.MustParseDecimal("1.5")
+		newQuantity = cdcexchange.MustParseDecimal("2.5")
+	)
+	now := time.Now()
+
+	ctrl, ctx := gomock.WithContext(context.Background(), t)
+	t.Cleanup(ctrl.Finish)
+
+	var (
+		signatureGenerator = signature_mocks.NewMockSignatureGenerator(ctrl)
+		idGenerator        = id_mocks.NewMockIDGenerator(ctrl)
+		clock              = clockwork.NewFakeClockAt(now)
+	)
+
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Contains(t, r.URL.Path, cdcexchange.MethodAmendOrder)
+		t.Cleanup(func() { require.NoError(t, r.Body.Close()) })
+
+		var body api.Request
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+
+		assert.Equal(t, origClientOID, body.Params["orig_client_oid"])
+		assert.Equal(t, newPrice.String(), body.Params["new_price"])
+		assert.Equal(t, newQuantity.String(), body.Params["new_quantity"])
+		assert.NotContains(t, body.Params, "order_id")
+		assert.NotContains(t, body.Params, "client_oid")
+
+		_, err := w.Write([]byte(`{
+			"id": 1234,
+			"method": "private/amend-order",
+			"code": 0,
+			"result": {
+				"order_id": "6530219466236720401",
+				"client_oid": "some original client oid"
+			}
+		}`))
+		require.NoError(t, err)
+	}))
+	t.Cleanup(s.Close)
+
+	client, err := cdcexchange.New(apiKey, secretKey,
+		cdcexchange.WithIDGenerator(idGenerator),
+		cdcexchange.WithClock(clock),
+		cdcexchange.WithHTTPClient(s.Client()),
+		cdcexchange.WithBaseURL(fmt.Sprintf("%s/", s.URL)),
+		cdcexchange.WithSignatureGenerator(signatureGenerator),
+	)
+	require.NoError(t, err)
+
+	idGenerator.EXPECT().Generate().Return(id)
+	signatureGenerator.EXPECT().GenerateSignature(auth.SignatureRequest{
+		APIKey:    apiKey,
+		SecretKey: secretKey,
+		ID:        id,
+		Method:    cdcexchange.MethodAmendOrder,
+		Timestamp: now.UnixMilli(),
+		Params: map[string]interface{}{
+			"orig_client_oid": origClientOID,
+			"new_price":       newPrice,
+			"new_quantity":    newQuantity,
+		},
+	}).Return(signature, nil)
+
+	res, err := client.AmendOrder(ctx, cdcexchange.AmendOrderRequest{
+		OrigClientOID: origClientOID,
+		NewPrice:      newPrice,
+		NewQuantity:   newQuantity,
+	})
+	require.NoError(t, err)
+
+	assert.JSONEq(t, `{"order_id": "6530219466236720401", "client_oid": "some original client oid"}`, string(res.Raw))
+	res.Raw = nil
+
+	assert.Equal(t, &cdcexchange.AmendOrderResult{
+		OrderID:   "6530219466236720401",
+		ClientOID: origClientOID,
+	}, res)
+}
@@ -0,0 +1,124 @@
+package cdcexchange
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/sngyai/go-cryptocom/v2/errors"
+	"github.com/sngyai/go-cryptocom/v2/internal/api"
+	"github.com/sngyai/go-cryptocom/v2/internal/auth"
+)
+
+const (
+	methodBorrow = "private/margin/borrow"
+)
+
+type (
+	// BorrowResponse is the base response returned from the private/margin/borrow API.
+	BorrowResponse struct {
+		// BaseResponse is the common response fields.
+		BaseResponse
+		// Result is the response attributes of the endpoint.
+		Result BorrowResult `json:"result"`
+	}
+
+	// BorrowResult is the result returned from the private/margin/borrow API.
+	BorrowResult struct {
+		// Currency is the currency which was borrowed (e.g. USDT).
+		Currency string `json:"currency"`
+		// Amount is the amount which was borrowed.
+		Amount Decimal `json:"amount"`
+		// TotalBorrowed is the total amount of the currency borrowed after the loan.
+		TotalBorrowed Decimal `json:"total_borrowed"`
+		// HourlyInterestRate is the hourly interest rate charged on the borrowed amount.
+		HourlyInterestRate Decimal `json:"hourly_interest_rate"`
+		// Raw is the raw JSON of the result, for fields not decoded by this package.
+		Raw json.RawMessage `json:"-"`
+	}
+)
+
+// Borrow borrows funds in the margin account.
+//
+// currency is validated against GetLoanCurrencies before the loan is requested,
+// so an extra (public) request is made for each call.
+//
+// Method: private/margin/borrow
+func (c *Client) Borrow(ctx context.Context, currency string, amount Decimal) (*BorrowResult, error) {
+	if currency == "" {
+		return nil, errors.InvalidParameterError{Parameter: "currency", Reason: "cannot be empty"}
+	}
+	if amount.Sign() <= 0 {
+		return nil, errors.InvalidParameterError{Parameter: "amount", Reason: "must be greater than 0"}
+	}
+
+	if err := c.validateLoanCurrency(ctx, currency); err != nil {
+		return nil, err
+	}
+
+	var (
+		id        = c.idGenerator.Generate()
+		timestamp = c.now().UnixMilli()
+		params    = make(map[string]interface{})
+	)
+
+	creds, err := c.credentials(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	params["currency"] = currency
+	params["amount"] = amount
+
+	signature, err := c.signatureGenerator.GenerateSignature(auth.SignatureRequest{
+		APIKey:    creds.APIKey,
+		SecretKey: creds.SecretKey,
+		ID:        id,
+		Method:    methodBorrow,
+		Timestamp: timestamp,
+		Params:    params,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create signature: %w", err)
+	}
+
+	body := api.Request{
+		ID:        id,
+		Method:    methodBorrow,
+		Nonce:     timestamp,
+		Params:    params,
+		Signature: signature,
+		APIKey:    creds.APIKey,
+		Version:   api.V2,
+	}
+
+	var borrowResponse BorrowResponse
+	statusCode, err := c.requester.Post(ctx, body, methodBorrow, &borrowResponse)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute post request: %w", err)
+	}
+
+	if err := c.requester.CheckResponse(statusCode, api.BaseResponse(borrowResponse.BaseResponse)); err != nil {
+		return nil, fmt.Errorf("error received in response: %w", err)
+	}
+
+	borrowResponse.Result.Raw = borrowResponse.RawResult
+
+	return &borrowResponse.Result, nil
+}
+
+// validateLoanCurrency returns an InvalidParameterError if currency cannot be borrowed in the margin account.
+func (c *Client) validateLoanCurrency(ctx context.Context, currency string) error {
+	loanCurrencies, err := c.GetLoanCurrencies(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get loan currencies: %w", err)
+	}
+
+	for _, loanCurrency := range loanCurrencies {
+		if loanCurrency == currency {
+			return nil
+		}
+	}
+
+	return errors.InvalidParameterError{Parameter: "currency", Reason: "is not a loan currency"}
+}
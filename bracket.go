@@ -0,0 +1,198 @@
+package cdcexchange
+
+import (
+	"context"
+	stderrors "errors"
+	"fmt"
+	"strings"
+
+	"github.com/sngyai/go-cryptocom/v2/errors"
+)
+
+// Bracket is a handle to an entry order and the OCO order list of the take profit and stop loss orders
+// closing its position, placed by PlaceBracket.
+type Bracket struct {
+	client *Client
+
+	// InstrumentName is the instrument of the orders of the bracket.
+	InstrumentName string
+	// EntryOrderID is the ID of the entry order.
+	EntryOrderID string
+	// Entry is the final state of the entry order, zero if it had not reached a final status.
+	Entry Order
+	// ListID is the ID of the OCO order list of the take profit and stop loss orders, empty if it was not created.
+	ListID string
+}
+
+// PlaceBracket creates the entry order, waits for it to reach a final status (see WaitForOrderFinal, which opts are passed to),
+// then creates the takeProfit and stopLoss orders as an OCO order list, so that the position is closed by whichever
+// executes first and the other is cancelled.
+//
+// The entry order must be a MARKET order, or a LIMIT order with an IMMEDIATE_OR_CANCEL or FILL_OR_KILL time in force,
+// so that it cannot rest on the order book partially filled, leaving the filled part unprotected.
+//
+// The quantity of takeProfit and stopLoss is the quantity filled by the entry order, less its fee if it is a BUY order
+// charged in the base currency of the instrument (rounded towards zero to the quantity tick size), and their instrument and side
+// default to the instrument and opposite side of the entry order. They are validated, including their prices against
+// the instrument, before the entry order is placed.
+//
+// If the entry order was placed, the Bracket is returned along with any error, so that the entry order can be
+// monitored or cancelled. errors.ErrEntryNotFilled is returned if the entry order reached a final status without any fill
+// (or with no more than its fee).
+func (c *Client) PlaceBracket(ctx context.Context, entry CreateOrderRequest, takeProfit CreateOrderRequest, stopLoss CreateOrderRequest, opts ...WaitOption) (*Bracket, error) {
+	if entry.InstrumentName == "" {
+		return nil, errors.InvalidParameterError{Parameter: "entry.InstrumentName", Reason: "cannot be empty"}
+	}
+	if !entry.Side.IsValid() {
+		return nil, errors.InvalidParameterError{Parameter: "entry.Side", Reason: "must be BUY or SELL"}
+	}
+
+	// the entry order must reach a final status immediately, so that no filled part of it is left unprotected
+	// while the rest of it rests on the order book.
+	switch {
+	case entry.Type == OrderTypeMarket:
+	case entry.Type == OrderTypeLimit && (entry.TimeInForce == TimeInForceImmediateOrCancel || entry.TimeInForce == TimeInForceFillOrKill):
+	case entry.Type == OrderTypeLimit:
+		return nil, errors.InvalidParameterError{Parameter: "entry.TimeInForce", Reason: "must be IMMEDIATE_OR_CANCEL or FILL_OR_KILL for LIMIT entry orders"}
+	default:
+		return nil, errors.InvalidParameterError{Parameter: "entry.Type", Reason: "must be LIMIT or MARKET"}
+	}
+
+	exitSide := OrderSideSell
+	if entry.Side == OrderSideSell {
+		exitSide = OrderSideBuy
+	}
+	exits := []struct {
+		parameter string
+		req       *CreateOrderRequest
+	}{
+		{parameter: "takeProfit", req: &takeProfit},
+		{parameter: "stopLoss", req: &stopLoss},
+	}
+	for _, exit := range exits {
+		if exit.req.InstrumentName == "" {
+			exit.req.InstrumentName = entry.InstrumentName
+		}
+		if exit.req.InstrumentName != entry.InstrumentName {
+			return nil, errors.InvalidParameterError{Parameter: exit.parameter + ".InstrumentName", Reason: "must be the instrument of the entry order"}
+		}
+		if exit.req.Side == "" {
+			exit.req.Side = exitSide
+		}
+		if exit.req.Side != exitSide {
+			return nil, errors.InvalidParameterError{Parameter: exit.parameter + ".Side", Reason: "must be the opposite side of the entry order"}
+		}
+		if exit.req.Type == "" {
+			return nil, errors.InvalidParameterError{Parameter: exit.parameter + ".Type", Reason: "cannot be empty"}
+		}
+		if err := validateExecutionOptions(*exit.req); err != nil {
+			return nil, exitOrderError(exit.parameter, err)
+		}
+		if err := validateOrderPrices(*exit.req); err != nil {
+			return nil, exitOrderError(exit.parameter, err)
+		}
+	}
+
+	// the exit orders are validated against the instrument before the entry order is placed,
+	// as they can only be placed once it is filled.
+	instrument, err := c.knownInstrument(ctx, entry.InstrumentName)
+	if err != nil {
+		return nil, err
+	}
+	for _, exit := range exits {
+		if err := validateOrderInstrument(*exit.req, instrument); err != nil {
+			return nil, exitOrderError(exit.parameter, err)
+		}
+	}
+
+	created, err := c.CreateOrder(ctx, entry)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create entry order: %w", err)
+	}
+
+	b := &Bracket{
+		client:         c,
+		InstrumentName: entry.InstrumentName,
+		EntryOrderID:   created.OrderID,
+	}
+
+	final, err := c.WaitForOrderFinal(ctx, created.OrderID, opts...)
+	if err != nil {
+		return b, fmt.Errorf("failed to wait for entry order: %w", err)
+	}
+	b.Entry = *final
+
+	if final.CumulativeQuantity.IsZero() {
+		return b, fmt.Errorf("entry order %s is %s: %w", final.OrderID, final.Status, errors.ErrEntryNotFilled)
+	}
+
+	quantity := final.CumulativeQuantity
+	if entry.Side == OrderSideBuy && final.FeeCurrency != "" && final.FeeCurrency == instrument.BaseCurrency {
+		// the fee was deducted from the quantity bought, so only the rest of it can be sold.
+		quantity = instrument.RoundToLot(quantity.Sub(final.CumulativeFee), RoundTowardZero)
+		if quantity.Sign() <= 0 {
+			return b, fmt.Errorf("entry order %s filled %s, not more than its fee of %s %s: %w",
+				final.OrderID, final.CumulativeQuantity, final.CumulativeFee, final.FeeCurrency, errors.ErrEntryNotFilled)
+		}
+	}
+	takeProfit.Quantity = quantity
+	stopLoss.Quantity = quantity
+
+	list, err := c.CreateOrderList(ctx, CreateOrderListRequest{
+		ContingencyType: ContingencyTypeOCO,
+		OrderList:       []CreateOrderRequest{takeProfit, stopLoss},
+	})
+	if err != nil {
+		return b, fmt.Errorf("failed to create take profit and stop loss orders: %w", err)
+	}
+	b.ListID = list.ListID
+
+	return b, nil
+}
+
+// exitOrderError renames the req parameter of an errors.InvalidParameterError returned for an exit order
+// to the parameter of the exit order (e.g. req.Price to takeProfit.Price).
+func exitOrderError(parameter string, err error) error {
+	var invalidParameter errors.InvalidParameterError
+	if !stderrors.As(err, &invalidParameter) {
+		return err
+	}
+
+	invalidParameter.Parameter = parameter + strings.TrimPrefix(invalidParameter.Parameter, "req")
+	return invalidParameter
+}
+
+// Cancel cancels the take profit and stop loss orders of the bracket,
+// or the entry order if they have not been created.
+func (b *Bracket) Cancel(ctx context.Context) error {
+	if b.ListID == "" {
+		return b.client.CancelOrder(ctx, b.InstrumentName, b.EntryOrderID)
+	}
+
+	return b.client.CancelOrderList(ctx, b.InstrumentName, b.ListID)
+}
+
+// OpenOrders returns the open take profit and stop loss orders of the bracket,
+// which are empty once one of them has been executed (and the other cancelled), or the bracket has been cancelled.
+func (b *Bracket) OpenOrders(ctx context.Context) ([]Order, error) {
+	if b.ListID == "" {
+		return nil, nil
+	}
+
+	open, err := b.client.GetOpenOrders(ctx, GetOpenOrdersRequest{
+		InstrumentName: b.InstrumentName,
+		PageRequest:    PageRequest{PageSize: Some(maxPageSize)},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var orders []Order
+	for _, order := range open.OrderList {
+		if order.OrderListID == b.ListID {
+			orders = append(orders, order)
+		}
+	}
+
+	return orders, nil
+}
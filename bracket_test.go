@@ -0,0 +1,331 @@
+package cdcexchange_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	cdcexchange "github.com/sngyai/go-cryptocom/v2"
+	cdcerrors "github.com/sngyai/go-cryptocom/v2/errors"
+	"github.com/sngyai/go-cryptocom/v2/internal/api"
+)
+
+// bracketServer returns a client for an exchange listing BTC_USDT with a price tick size of 0.01,
+// whose get-order-detail requests are answered with the entry order,
+// and whose create-order-list requests are answered with createListStatusCode, recording the requests.
+func bracketServer(t *testing.T, entry string, createListStatusCode int, requests *[]api.Request) *cdcexchange.Client {
+	t.Helper()
+
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body api.Request
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		*requests = append(*requests, body)
+
+		var (
+			statusCode = http.StatusOK
+			res        string
+		)
+		switch {
+		case strings.HasSuffix(r.URL.Path, cdcexchange.MethodGetInstruments):
+			res = `{"id": 1, "code": 0, "result": {"data": [{"symbol": "BTC_USDT", "base_ccy": "BTC", "quote_ccy": "USDT", "price_tick_size": "0.01", "qty_tick_size": "0.0001", "tradable": true}]}}`
+		case strings.HasSuffix(r.URL.Path, cdcexchange.MethodCreateOrder):
+			res = `{"id": 1, "code": 0, "result": {"order_id": "1"}}`
+		case strings.HasSuffix(r.URL.Path, cdcexchange.MethodGetOrderDetail):
+			res = fmt.Sprintf(`{"id": 1, "code": 0, "result": {"trade_list": [], "order_info": %s}}`, entry)
+		case strings.HasSuffix(r.URL.Path, cdcexchange.MethodCreateOrderList):
+			statusCode = createListStatusCode
+			res = `{"id": 1, "code": 0, "result": {"list_id": 6498090546073120100}}`
+			if statusCode != http.StatusOK {
+				res = `{"id": 1, "code": 306}`
+			}
+		case strings.HasSuffix(r.URL.Path, cdcexchange.MethodCancelOrderList),
+			strings.HasSuffix(r.URL.Path, cdcexchange.MethodCancelOrder):
+			res = `{"id": 1, "code": 0}`
+		case strings.HasSuffix(r.URL.Path, cdcexchange.MethodGetOpenOrders):
+			res = `{"id": 1, "code": 0, "result": {"order_list": [
+				{"order_id": "2", "instrument_name": "BTC_USDT", "status": "ACTIVE", "list_id": 6498090546073120100},
+				{"order_id": "3", "instrument_name": "BTC_USDT", "status": "ACTIVE"}
+			]}}`
+		default:
+			t.Errorf("unexpected request to %s", r.URL.Path)
+		}
+
+		w.WriteHeader(statusCode)
+		_, err := w.Write([]byte(res))
+		require.NoError(t, err)
+	}))
+	t.Cleanup(s.Close)
+
+	client, err := cdcexchange.New("api key", "secret key",
+		cdcexchange.WithHTTPClient(s.Client()),
+		cdcexchange.WithBaseURL(fmt.Sprintf("%s/", s.URL)),
+	)
+	require.NoError(t, err)
+
+	return client
+}
+
+func bracketOrders() (entry cdcexchange.CreateOrderRequest, takeProfit cdcexchange.CreateOrderRequest, stopLoss cdcexchange.CreateOrderRequest) {
+	entry = cdcexchange.CreateOrderRequest{
+		InstrumentName: "BTC_USDT",
+		Side:           cdcexchange.OrderSideBuy,
+		Type:           cdcexchange.OrderTypeLimit,
+		Price:          cdcexchange.MustParseDecimal("25000"),
+		Quantity:       cdcexchange.MustParseDecimal("1"),
+		TimeInForce:    cdcexchange.TimeInForceImmediateOrCancel,
+	}
+	takeProfit = cdcexchange.CreateOrderRequest{
+		Type:  cdcexchange.OrderTypeLimit,
+		Price: cdcexchange.MustParseDecimal("26000"),
+	}
+	stopLoss = cdcexchange.CreateOrderRequest{
+		Type:         cdcexchange.OrderTypeStopLoss,
+		TriggerPrice: cdcexchange.MustParseDecimal("24000"),
+	}
+
+	return entry, takeProfit, stopLoss
+}
+
+func TestClient_PlaceBracket(t *testing.T) {
+	wait := cdcexchange.WithWaitBackoff(time.Millisecond, time.Millisecond)
+
+	t.Run("places the take profit and stop loss orders for the filled quantity", func(t *testing.T) {
+		var requests []api.Request
+		client := bracketServer(t, limitOrder(cdcexchange.OrderStatusCancelled, "0.4"), http.StatusOK, &requests)
+
+		entry, takeProfit, stopLoss := bracketOrders()
+		bracket, err := client.PlaceBracket(context.Background(), entry, takeProfit, stopLoss, wait)
+		require.NoError(t, err)
+
+		assert.Equal(t, "BTC_USDT", bracket.InstrumentName)
+		assert.Equal(t, "1", bracket.EntryOrderID)
+		assert.Equal(t, cdcexchange.OrderStatusCancelled, bracket.Entry.Status)
+		assert.Equal(t, "6498090546073120100", bracket.ListID)
+
+		assert.Equal(t, []string{
+			cdcexchange.MethodGetInstruments,
+			cdcexchange.MethodCreateOrder,
+			cdcexchange.MethodGetOrderDetail,
+			cdcexchange.MethodCreateOrderList,
+		}, methods(requests))
+		assert.Equal(t, map[string]interface{}{
+			"contingency_type": "OCO",
+			"order_list": []interface{}{
+				map[string]interface{}{
+					"instrument_name": "BTC_USDT",
+					"side":            "SELL",
+					"type":            "LIMIT",
+					"price":           "26000",
+					"quantity":        "0.4",
+				},
+				map[string]interface{}{
+					"instrument_name": "BTC_USDT",
+					"side":            "SELL",
+					"type":            "STOP_LOSS",
+					"trigger_price":   "24000",
+					"quantity":        "0.4",
+				},
+			},
+		}, requests[3].Params)
+	})
+
+	t.Run("deducts a fee charged in the base currency from the filled quantity", func(t *testing.T) {
+		var requests []api.Request
+		entry := `{
+			"order_id": "1",
+			"status": "FILLED",
+			"side": "BUY",
+			"instrument_name": "BTC_USDT",
+			"quantity": "0.4",
+			"cumulative_quantity": "0.4",
+			"cumulative_fee": "0.00041",
+			"fee_instrument_name": "BTC"
+		}`
+		client := bracketServer(t, entry, http.StatusOK, &requests)
+
+		_, takeProfit, stopLoss := bracketOrders()
+		_, err := client.PlaceBracket(context.Background(), cdcexchange.CreateOrderRequest{
+			InstrumentName: "BTC_USDT",
+			Side:           cdcexchange.OrderSideBuy,
+			Type:           cdcexchange.OrderTypeMarket,
+			Quantity:       cdcexchange.MustParseDecimal("0.4"),
+		}, takeProfit, stopLoss, wait)
+		require.NoError(t, err)
+
+		orderList := requests[len(requests)-1].Params["order_list"].([]interface{})
+		require.Len(t, orderList, 2)
+		assert.Equal(t, "0.3995", orderList[0].(map[string]interface{})["quantity"])
+		assert.Equal(t, "0.3995", orderList[1].(map[string]interface{})["quantity"])
+	})
+
+	t.Run("cancels the take profit and stop loss orders", func(t *testing.T) {
+		var requests []api.Request
+		client := bracketServer(t, limitOrder(cdcexchange.OrderStatusFilled, "1"), http.StatusOK, &requests)
+
+		entry, takeProfit, stopLoss := bracketOrders()
+		bracket, err := client.PlaceBracket(context.Background(), entry, takeProfit, stopLoss, wait)
+		require.NoError(t, err)
+
+		require.NoError(t, bracket.Cancel(context.Background()))
+		assert.Equal(t, cdcexchange.MethodCancelOrderList, requests[len(requests)-1].Method)
+		assert.Equal(t, map[string]interface{}{
+			"contingency_type": "OCO",
+			"instrument_name":  "BTC_USDT",
+			"list_id":          "6498090546073120100",
+		}, requests[len(requests)-1].Params)
+	})
+
+	t.Run("returns the open orders of the list", func(t *testing.T) {
+		var requests []api.Request
+		client := bracketServer(t, limitOrder(cdcexchange.OrderStatusFilled, "1"), http.StatusOK, &requests)
+
+		entry, takeProfit, stopLoss := bracketOrders()
+		bracket, err := client.PlaceBracket(context.Background(), entry, takeProfit, stopLoss, wait)
+		require.NoError(t, err)
+
+		orders, err := bracket.OpenOrders(context.Background())
+		require.NoError(t, err)
+
+		require.Len(t, orders, 1)
+		assert.Equal(t, "2", orders[0].OrderID)
+	})
+}
+
+func TestClient_PlaceBracket_Error(t *testing.T) {
+	wait := cdcexchange.WithWaitBackoff(time.Millisecond, time.Millisecond)
+
+	t.Run("returns the bracket when the entry order was not filled", func(t *testing.T) {
+		var requests []api.Request
+		client := bracketServer(t, limitOrder(cdcexchange.OrderStatusCancelled, "0"), http.StatusOK, &requests)
+
+		entry, takeProfit, stopLoss := bracketOrders()
+		bracket, err := client.PlaceBracket(context.Background(), entry, takeProfit, stopLoss, wait)
+		assert.True(t, errors.Is(err, cdcerrors.ErrEntryNotFilled))
+
+		require.NotNil(t, bracket)
+		assert.Equal(t, "1", bracket.EntryOrderID)
+		assert.Empty(t, bracket.ListID)
+		assert.NotContains(t, methods(requests), cdcexchange.MethodCreateOrderList)
+
+		orders, err := bracket.OpenOrders(context.Background())
+		require.NoError(t, err)
+		assert.Empty(t, orders)
+
+		require.NoError(t, bracket.Cancel(context.Background()))
+		assert.Equal(t, cdcexchange.MethodCancelOrder, requests[len(requests)-1].Method)
+	})
+
+	t.Run("returns the bracket when the entry order filled no more than its fee", func(t *testing.T) {
+		var requests []api.Request
+		entry := `{
+			"order_id": "1",
+			"status": "CANCELED",
+			"side": "BUY",
+			"instrument_name": "BTC_USDT",
+			"cumulative_quantity": "0.00005",
+			"cumulative_fee": "0.00001",
+			"fee_instrument_name": "BTC"
+		}`
+		client := bracketServer(t, entry, http.StatusOK, &requests)
+
+		entryOrder, takeProfit, stopLoss := bracketOrders()
+		bracket, err := client.PlaceBracket(context.Background(), entryOrder, takeProfit, stopLoss, wait)
+		assert.True(t, errors.Is(err, cdcerrors.ErrEntryNotFilled))
+
+		require.NotNil(t, bracket)
+		assert.NotContains(t, methods(requests), cdcexchange.MethodCreateOrderList)
+	})
+
+	t.Run("returns the bracket when the order list cannot be created", func(t *testing.T) {
+		var requests []api.Request
+		client := bracketServer(t, limitOrder(cdcexchange.OrderStatusFilled, "1"), http.StatusBadRequest, &requests)
+
+		entry, takeProfit, stopLoss := bracketOrders()
+		bracket, err := client.PlaceBracket(context.Background(), entry, takeProfit, stopLoss, wait)
+		assert.True(t, errors.Is(err, cdcerrors.ErrInsufficientAvailableBalance))
+
+		require.NotNil(t, bracket)
+		assert.Equal(t, cdcexchange.OrderStatusFilled, bracket.Entry.Status)
+		assert.Empty(t, bracket.ListID)
+	})
+
+	t.Run("returns error when params are invalid", func(t *testing.T) {
+		client, err := cdcexchange.New("api key", "secret key")
+		require.NoError(t, err)
+
+		entry, takeProfit, stopLoss := bracketOrders()
+
+		invalidEntry := entry
+		invalidEntry.InstrumentName = ""
+		_, err = client.PlaceBracket(context.Background(), invalidEntry, takeProfit, stopLoss)
+		assert.Equal(t, cdcerrors.InvalidParameterError{Parameter: "entry.InstrumentName", Reason: "cannot be empty"}, err)
+
+		invalidEntry = entry
+		invalidEntry.Side = ""
+		_, err = client.PlaceBracket(context.Background(), invalidEntry, takeProfit, stopLoss)
+		assert.Equal(t, cdcerrors.InvalidParameterError{Parameter: "entry.Side", Reason: "must be BUY or SELL"}, err)
+
+		invalidEntry = entry
+		invalidEntry.TimeInForce = cdcexchange.TimeInForceGoodTilCancelled
+		_, err = client.PlaceBracket(context.Background(), invalidEntry, takeProfit, stopLoss)
+		assert.Equal(t, cdcerrors.InvalidParameterError{Parameter: "entry.TimeInForce", Reason: "must be IMMEDIATE_OR_CANCEL or FILL_OR_KILL for LIMIT entry orders"}, err)
+
+		invalidEntry = entry
+		invalidEntry.Type = cdcexchange.OrderTypeStopLimit
+		invalidEntry.TimeInForce = ""
+		_, err = client.PlaceBracket(context.Background(), invalidEntry, takeProfit, stopLoss)
+		assert.Equal(t, cdcerrors.InvalidParameterError{Parameter: "entry.Type", Reason: "must be LIMIT or MARKET"}, err)
+
+		invalidTakeProfit := takeProfit
+		invalidTakeProfit.InstrumentName = "ETH_USDT"
+		_, err = client.PlaceBracket(context.Background(), entry, invalidTakeProfit, stopLoss)
+		assert.Equal(t, cdcerrors.InvalidParameterError{Parameter: "takeProfit.InstrumentName", Reason: "must be the instrument of the entry order"}, err)
+
+		invalidStopLoss := stopLoss
+		invalidStopLoss.Side = cdcexchange.OrderSideBuy
+		_, err = client.PlaceBracket(context.Background(), entry, takeProfit, invalidStopLoss)
+		assert.Equal(t, cdcerrors.InvalidParameterError{Parameter: "stopLoss.Side", Reason: "must be the opposite side of the entry order"}, err)
+
+		invalidTakeProfit = takeProfit
+		invalidTakeProfit.Type = ""
+		_, err = client.PlaceBracket(context.Background(), entry, invalidTakeProfit, stopLoss)
+		assert.Equal(t, cdcerrors.InvalidParameterError{Parameter: "takeProfit.Type", Reason: "cannot be empty"}, err)
+
+		invalidTakeProfit = takeProfit
+		invalidTakeProfit.Price = cdcexchange.Decimal{}
+		_, err = client.PlaceBracket(context.Background(), entry, invalidTakeProfit, stopLoss)
+		assert.Equal(t, cdcerrors.InvalidParameterError{Parameter: "takeProfit.Price", Reason: "cannot be empty for limit orders"}, err)
+
+		invalidStopLoss = stopLoss
+		invalidStopLoss.TimeInForce = cdcexchange.TimeInForceGoodTilCancelled
+		_, err = client.PlaceBracket(context.Background(), entry, takeProfit, invalidStopLoss)
+		assert.Equal(t, cdcerrors.InvalidParameterError{Parameter: "stopLoss.Type", Reason: "must be a limit order type when req.TimeInForce or req.ExecInst is provided"}, err)
+
+		invalidStopLoss = stopLoss
+		invalidStopLoss.TriggerPrice = cdcexchange.Decimal{}
+		_, err = client.PlaceBracket(context.Background(), entry, takeProfit, invalidStopLoss)
+		assert.Equal(t, cdcerrors.InvalidParameterError{Parameter: "stopLoss.TriggerPrice", Reason: "cannot be empty for trigger orders"}, err)
+	})
+
+	t.Run("does not place the entry order when an exit order is invalid for the instrument", func(t *testing.T) {
+		var requests []api.Request
+		client := bracketServer(t, limitOrder(cdcexchange.OrderStatusFilled, "1"), http.StatusOK, &requests)
+
+		entry, takeProfit, stopLoss := bracketOrders()
+		stopLoss.TriggerPrice = cdcexchange.MustParseDecimal("24000.005")
+		bracket, err := client.PlaceBracket(context.Background(), entry, takeProfit, stopLoss, wait)
+		assert.Equal(t, cdcerrors.InvalidParameterError{Parameter: "stopLoss.TriggerPrice", Reason: "must be a multiple of the price tick size 0.01 of BTC_USDT"}, err)
+
+		assert.Nil(t, bracket)
+		assert.Equal(t, []string{cdcexchange.MethodGetInstruments}, methods(requests))
+	})
+}
@@ -4,17 +4,17 @@ import (
 	"context"
 	"fmt"
 
-	"github.com/sngyai/go-cryptocom/errors"
-	"github.com/sngyai/go-cryptocom/internal/api"
-	"github.com/sngyai/go-cryptocom/internal/auth"
+	"github.com/sngyai/go-cryptocom/v2/errors"
+	"github.com/sngyai/go-cryptocom/v2/internal/api"
+	"github.com/sngyai/go-cryptocom/v2/internal/auth"
 )
 
 const methodCancelAllOrders = "private/cancel-all-orders"
 
 // CancelAllOrdersResponse is the base response returned from the private/cancel-all-orders API.
 type CancelAllOrdersResponse struct {
-	// api.BaseResponse is the common response fields.
-	api.BaseResponse
+	// BaseResponse is the common response fields.
+	BaseResponse
 }
 
 // CancelAllOrders cancels  all orders for a particular instrument/pair.
@@ -31,15 +31,20 @@ func (c *Client) CancelAllOrders(ctx context.Context, instrumentName string) err
 
 	var (
 		id        = c.idGenerator.Generate()
-		timestamp = c.clock.Now().UnixMilli()
+		timestamp = c.now().UnixMilli()
 		params    = make(map[string]interface{})
 	)
 
+	creds, err := c.credentials(ctx)
+	if err != nil {
+		return err
+	}
+
 	params["instrument_name"] = instrumentName
 
 	signature, err := c.signatureGenerator.GenerateSignature(auth.SignatureRequest{
-		APIKey:    c.apiKey,
-		SecretKey: c.secretKey,
+		APIKey:    creds.APIKey,
+		SecretKey: creds.SecretKey,
 		ID:        id,
 		Method:    methodCancelAllOrders,
 		Timestamp: timestamp,
@@ -55,7 +60,7 @@ func (c *Client) CancelAllOrders(ctx context.Context, instrumentName string) err
 		Nonce:     timestamp,
 		Params:    params,
 		Signature: signature,
-		APIKey:    c.apiKey,
+		APIKey:    creds.APIKey,
 	}
 
 	var cancelAllOrdersResponse CancelAllOrdersResponse
@@ -64,7 +69,7 @@ func (c *Client) CancelAllOrders(ctx context.Context, instrumentName string) err
 		return fmt.Errorf("failed to execute post request: %w", err)
 	}
 
-	if err := c.requester.CheckErrorResponse(statusCode, cancelAllOrdersResponse.Code); err != nil {
+	if err := c.requester.CheckResponse(statusCode, api.BaseResponse(cancelAllOrdersResponse.BaseResponse)); err != nil {
 		return fmt.Errorf("error received in response: %w", err)
 	}
 
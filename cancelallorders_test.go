@@ -15,12 +15,12 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
-	cdcexchange "github.com/sngyai/go-cryptocom"
-	cdcerrors "github.com/sngyai/go-cryptocom/errors"
-	"github.com/sngyai/go-cryptocom/internal/api"
-	"github.com/sngyai/go-cryptocom/internal/auth"
-	id_mocks "github.com/sngyai/go-cryptocom/internal/mocks/id"
-	signature_mocks "github.com/sngyai/go-cryptocom/internal/mocks/signature"
+	cdcexchange "github.com/sngyai/go-cryptocom/v2"
+	cdcerrors "github.com/sngyai/go-cryptocom/v2/errors"
+	"github.com/sngyai/go-cryptocom/v2/internal/api"
+	"github.com/sngyai/go-cryptocom/v2/internal/auth"
+	id_mocks "github.com/sngyai/go-cryptocom/v2/internal/mocks/id"
+	signature_mocks "github.com/sngyai/go-cryptocom/v2/internal/mocks/signature"
 )
 
 func TestClient_CancelAllOrders_Error(t *testing.T) {
@@ -187,7 +187,7 @@ func TestClient_CancelAllOrders_Success(t *testing.T) {
 				assert.Equal(t, instrumentName, body.Params["instrument_name"])
 
 				res := cdcexchange.CancelAllOrdersResponse{
-					BaseResponse: api.BaseResponse{},
+					BaseResponse: cdcexchange.BaseResponse{},
 				}
 
 				require.NoError(t, json.NewEncoder(w).Encode(res))
@@ -4,17 +4,17 @@ import (
 	"context"
 	"fmt"
 
-	"github.com/sngyai/go-cryptocom/errors"
-	"github.com/sngyai/go-cryptocom/internal/api"
-	"github.com/sngyai/go-cryptocom/internal/auth"
+	"github.com/sngyai/go-cryptocom/v2/errors"
+	"github.com/sngyai/go-cryptocom/v2/internal/api"
+	"github.com/sngyai/go-cryptocom/v2/internal/auth"
 )
 
 const methodCancelOrder = "private/cancel-order"
 
 // CancelOrderResponse is the base response returned from the private/cancel-order API.
 type CancelOrderResponse struct {
-	// api.BaseResponse is the common response fields.
-	api.BaseResponse
+	// BaseResponse is the common response fields.
+	BaseResponse
 }
 
 // CancelOrder cancels an existing order on the Exchange.
@@ -32,18 +32,49 @@ func (c *Client) CancelOrder(ctx context.Context, instrumentName string, orderID
 		return errors.InvalidParameterError{Parameter: "orderID", Reason: "cannot be empty"}
 	}
 
+	return c.cancelOrder(ctx, map[string]interface{}{
+		"instrument_name": instrumentName,
+		"order_id":        orderID,
+	})
+}
+
+// CancelOrderByClientOID cancels an existing order on the Exchange by its Client order ID.
+//
+// This call is asynchronous, so the response is simply a confirmation of the request.
+//
+// The user.order subscription can be used to check when the order is successfully cancelled.
+//
+// Method: private/cancel-order
+func (c *Client) CancelOrderByClientOID(ctx context.Context, instrumentName string, clientOID string) error {
+	if instrumentName == "" {
+		return errors.InvalidParameterError{Parameter: "instrumentName", Reason: "cannot be empty"}
+	}
+	if clientOID == "" {
+		return errors.InvalidParameterError{Parameter: "clientOID", Reason: "cannot be empty"}
+	}
+
+	return c.cancelOrder(ctx, map[string]interface{}{
+		"instrument_name": instrumentName,
+		"client_oid":      clientOID,
+	})
+}
+
+// cancelOrder cancels the order identified by params.
+// The venue accepts either order_id or client_oid, so params must only contain one of them.
+func (c *Client) cancelOrder(ctx context.Context, params map[string]interface{}) error {
 	var (
 		id        = c.idGenerator.Generate()
-		timestamp = c.clock.Now().UnixMilli()
-		params    = make(map[string]interface{})
+		timestamp = c.now().UnixMilli()
 	)
 
-	params["instrument_name"] = instrumentName
-	params["order_id"] = orderID
+	creds, err := c.credentials(ctx)
+	if err != nil {
+		return err
+	}
 
 	signature, err := c.signatureGenerator.GenerateSignature(auth.SignatureRequest{
-		APIKey:    c.apiKey,
-		SecretKey: c.secretKey,
+		APIKey:    creds.APIKey,
+		SecretKey: creds.SecretKey,
 		ID:        id,
 		Method:    methodCancelOrder,
 		Timestamp: timestamp,
@@ -59,7 +90,7 @@ func (c *Client) CancelOrder(ctx context.Context, instrumentName string, orderID
 		Nonce:     timestamp,
 		Params:    params,
 		Signature: signature,
-		APIKey:    c.apiKey,
+		APIKey:    creds.APIKey,
 	}
 
 	var cancelOrderResponse CancelOrderResponse
@@ -68,7 +99,7 @@ func (c *Client) CancelOrder(ctx context.Context, instrumentName string, orderID
 		return fmt.Errorf("failed to execute post request: %w", err)
 	}
 
-	if err := c.requester.CheckErrorResponse(statusCode, cancelOrderResponse.Code); err != nil {
+	if err := c.requester.CheckResponse(statusCode, api.BaseResponse(cancelOrderResponse.BaseResponse)); err != nil {
 		return fmt.Errorf("error received in response: %w", err)
 	}
 
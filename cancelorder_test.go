@@ -15,12 +15,12 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
-	"github.com/sngyai/go-cryptocom/internal/api"
-	"github.com/sngyai/go-cryptocom/internal/auth"
-	cdcexchange "github.com/sngyai/go-cryptocom"
-	cdcerrors "github.com/sngyai/go-cryptocom/errors"
-	id_mocks "github.com/sngyai/go-cryptocom/internal/mocks/id"
-	signature_mocks "github.com/sngyai/go-cryptocom/internal/mocks/signature"
+	"github.com/sngyai/go-cryptocom/v2/internal/api"
+	"github.com/sngyai/go-cryptocom/v2/internal/auth"
+	cdcexchange "github.com/sngyai/go-cryptocom/v2"
+	cdcerrors "github.com/sngyai/go-cryptocom/v2/errors"
+	id_mocks "github.com/sngyai/go-cryptocom/v2/internal/mocks/id"
+	signature_mocks "github.com/sngyai/go-cryptocom/v2/internal/mocks/signature"
 )
 
 func TestClient_CancelOrder_Error(t *testing.T) {
@@ -208,7 +208,7 @@ func TestClient_CancelOrder_Success(t *testing.T) {
 				assert.Equal(t, orderID, body.Params["order_id"])
 
 				res := cdcexchange.CancelOrderResponse{
-					BaseResponse: api.BaseResponse{},
+					BaseResponse: cdcexchange.BaseResponse{},
 				}
 
 				require.NoError(t, json.NewEncoder(w).Encode(res))
@@ -257,3 +257,77 @@ func TestClient_CancelOrder_Success(t *testing.T) {
 		})
 	}
 }
+
+func TestClient_CancelOrderByClientOID(t *testing.T) {
+	const (
+		apiKey    = "some api key"
+		secretKey = "some secret key"
+		id        = int64(1234)
+		signature = "some signature"
+
+		clientOID      = "some client oid"
+		instrumentName = "some instrument name"
+	)
+	now := time.Now()
+
+	t.Run("returns error when client oid is empty", func(t *testing.T) {
+		client, err := cdcexchange.New(apiKey, secretKey)
+		require.NoError(t, err)
+
+		err = client.CancelOrderByClientOID(context.Background(), instrumentName, "")
+		require.Error(t, err)
+
+		assert.True(t, errors.Is(err, cdcerrors.InvalidParameterError{Parameter: "clientOID", Reason: "cannot be empty"}))
+	})
+
+	t.Run("successfully cancels an order", func(t *testing.T) {
+		ctrl, ctx := gomock.WithContext(context.Background(), t)
+		t.Cleanup(ctrl.Finish)
+
+		var (
+			signatureGenerator = signature_mocks.NewMockSignatureGenerator(ctrl)
+			idGenerator        = id_mocks.NewMockIDGenerator(ctrl)
+			clock              = clockwork.NewFakeClockAt(now)
+		)
+
+		s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Contains(t, r.URL.Path, cdcexchange.MethodCancelOrder)
+			t.Cleanup(func() { require.NoError(t, r.Body.Close()) })
+
+			var body api.Request
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+
+			assert.Equal(t, instrumentName, body.Params["instrument_name"])
+			assert.Equal(t, clientOID, body.Params["client_oid"])
+			assert.NotContains(t, body.Params, "order_id")
+
+			require.NoError(t, json.NewEncoder(w).Encode(cdcexchange.CancelOrderResponse{}))
+		}))
+		t.Cleanup(s.Close)
+
+		client, err := cdcexchange.New(apiKey, secretKey,
+			cdcexchange.WithIDGenerator(idGenerator),
+			cdcexchange.WithClock(clock),
+			cdcexchange.WithHTTPClient(s.Client()),
+			cdcexchange.WithBaseURL(fmt.Sprintf("%s/", s.URL)),
+			cdcexchange.WithSignatureGenerator(signatureGenerator),
+		)
+		require.NoError(t, err)
+
+		idGenerator.EXPECT().Generate().Return(id)
+		signatureGenerator.EXPECT().GenerateSignature(auth.SignatureRequest{
+			APIKey:    apiKey,
+			SecretKey: secretKey,
+			ID:        id,
+			Method:    cdcexchange.MethodCancelOrder,
+			Timestamp: now.UnixMilli(),
+			Params: map[string]interface{}{
+				"instrument_name": instrumentName,
+				"client_oid":      clientOID,
+			},
+		}).Return(signature, nil)
+
+		err = client.CancelOrderByClientOID(ctx, instrumentName, clientOID)
+		require.NoError(t, err)
+	})
+}
@@ -1,13 +1,14 @@
 package cdcexchange
 
 import (
+	"context"
 	"net/http"
 
 	"github.com/jonboulle/clockwork"
 
-	"github.com/sngyai/go-cryptocom/errors"
-	"github.com/sngyai/go-cryptocom/internal/auth"
-	"github.com/sngyai/go-cryptocom/internal/id"
+	"github.com/sngyai/go-cryptocom/v2/errors"
+	"github.com/sngyai/go-cryptocom/v2/internal/auth"
+	"github.com/sngyai/go-cryptocom/v2/internal/id"
 )
 
 const (
@@ -15,19 +16,69 @@ const (
 	ProductionBaseURL = productionBaseURL
 
 	// Common API
-	MethodGetInstruments = methodGetInstruments
-	MethodGetBook        = methodGetBook
-	MethodGetTicker      = methodGetTicker
+	MethodGetInstruments           = methodGetInstruments
+	MethodGetBook                  = methodGetBook
+	MethodGetTicker                = methodGetTicker
+	MethodGetCurrencyNetworks      = methodGetCurrencyNetworks
+	MethodGetAnnouncements         = methodGetAnnouncements
+	MethodGetDustConversionPreview = methodGetDustConversionPreview
+	MethodConvertDust              = methodConvertDust
+	MethodGetWithdrawalHistory     = methodGetWithdrawalHistory
+	MethodGetDepositHistory        = methodGetDepositHistory
 
 	// Spot Trading API
 	MethodGetAccountSummary = methodGetAccountSummary
+	MethodGetUserBalance    = methodGetUserBalance
 	MethodCreateOrder       = methodCreateOrder
 	MethodCancelOrder       = methodCancelOrder
+	MethodCreateOrderList   = methodCreateOrderList
+	MethodCancelOrderList   = methodCancelOrderList
+	MethodAmendOrder        = methodAmendOrder
 	MethodCancelAllOrders   = methodCancelAllOrders
 	MethodGetOrderHistory   = methodGetOrderHistory
 	MethodGetOpenOrders     = methodGetOpenOrders
 	MethodGetOrderDetail    = methodGetOrderDetail
 	MethodGetTrades         = methodGetTrades
+	MethodSetSTPSettings    = methodSetSTPSettings
+
+	// Margin Trading API
+	MethodGetMarginAccountSummary = methodGetMarginAccountSummary
+	MethodGetLoanCurrencies       = methodGetLoanCurrencies
+	MethodBorrow                  = methodBorrow
+	MethodRepay                   = methodRepay
+	MethodGetInterestHistory      = methodGetInterestHistory
+
+	// Derivatives Transfer API
+	MethodTransfer           = methodTransfer
+	MethodGetTransferHistory = methodGetTransferHistory
+
+	// Sub-account API
+	MethodCreateSubAccountTransfer     = methodCreateSubAccountTransfer
+	MethodGetSubAccountBalances        = methodGetSubAccountBalances
+	MethodGetSubAccounts               = methodGetSubAccounts
+	MethodGetSubAccountTransferHistory = methodGetSubAccountTransferHistory
+
+	// Staking API
+	MethodStake                 = methodStake
+	MethodUnstake               = methodUnstake
+	MethodGetStakingPosition    = methodGetStakingPosition
+	MethodGetStakingInstruments = methodGetStakingInstruments
+	MethodGetOpenStake          = methodGetOpenStake
+	MethodGetStakeHistory       = methodGetStakeHistory
+	MethodGetRewardHistory      = methodGetRewardHistory
+	MethodConvert               = methodConvert
+	MethodGetOpenConvert        = methodGetOpenConvert
+	MethodGetConvertHistory     = methodGetConvertHistory
+	MethodGetConversionRate     = methodGetConversionRate
+
+	// OTC Trading API
+	MethodGetOTCUser         = methodGetOTCUser
+	MethodGetOTCInstruments  = methodGetOTCInstruments
+	MethodRequestOTCQuote    = methodRequestOTCQuote
+	MethodAcceptOTCQuote     = methodAcceptOTCQuote
+	MethodGetOTCQuoteHistory = methodGetOTCQuoteHistory
+	MethodGetOTCTradeHistory = methodGetOTCTradeHistory
+	MethodCreateOTCOrder     = methodCreateOTCOrder
 )
 
 func (c *Client) BaseURL() string {
@@ -35,11 +86,13 @@ func (c *Client) BaseURL() string {
 }
 
 func (c *Client) APIKey() string {
-	return c.apiKey
+	creds, _ := c.credentials(context.Background())
+	return creds.APIKey
 }
 
 func (c *Client) SecretKey() string {
-	return c.secretKey
+	creds, _ := c.credentials(context.Background())
+	return creds.SecretKey
 }
 
 func (c *Client) HTTPClient() *http.Client {
@@ -2,18 +2,35 @@ package cdcexchange_test
 
 import (
 	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
 	"encoding/json"
+	stderrors "errors"
+	"fmt"
 	"io"
 	"io/ioutil"
+	"log/slog"
 	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
 	"testing"
 	"time"
 
+	"github.com/jonboulle/clockwork"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
 
-	cdcexchange "github.com/sngyai/go-cryptocom"
-	"github.com/sngyai/go-cryptocom/errors"
+	cdcexchange "github.com/sngyai/go-cryptocom/v2"
+	"github.com/sngyai/go-cryptocom/v2/errors"
+	"github.com/sngyai/go-cryptocom/v2/internal/api"
+	"github.com/sngyai/go-cryptocom/v2/internal/auth"
 )
 
 type roundTripper struct {
@@ -132,7 +149,17 @@ func TestNew_Success(t *testing.T) {
 			assert.Equal(t, tt.expectedBaseURL, client.BaseURL())
 
 			if tt.httpClient == nil {
-				assert.Equal(t, http.DefaultClient, client.HTTPClient())
+				httpClient := client.HTTPClient()
+				assert.NotSame(t, http.DefaultClient, httpClient)
+				assert.Equal(t, 30*time.Second, httpClient.Timeout)
+
+				transport, ok := httpClient.Transport.(*http.Transport)
+				require.True(t, ok)
+				assert.Equal(t, 32, transport.MaxIdleConnsPerHost)
+				assert.Equal(t, 5*time.Second, transport.TLSHandshakeTimeout)
+				assert.Equal(t, 10*time.Second, transport.ResponseHeaderTimeout)
+				assert.NotNil(t, transport.DialContext)
+				assert.NotNil(t, transport.Proxy)
 			} else {
 				assert.Equal(t, tt.httpClient, client.HTTPClient())
 			}
@@ -247,3 +274,1086 @@ func TestClient_UpdateConfig_Success(t *testing.T) {
 		})
 	}
 }
+
+func TestClient_APIVersion(t *testing.T) {
+	tests := []struct {
+		name         string
+		opts         []cdcexchange.ClientOption
+		expectedPath string
+	}{
+		{
+			name:         "makes requests against v1 by default",
+			expectedPath: "/exchange/v1/" + cdcexchange.MethodGetTicker,
+		},
+		{
+			name:         "makes requests against the client api version",
+			opts:         []cdcexchange.ClientOption{cdcexchange.WithAPIVersion(cdcexchange.APIVersionV2)},
+			expectedPath: "/v2/" + cdcexchange.MethodGetTicker,
+		},
+		{
+			name: "makes requests against the method api version",
+			opts: []cdcexchange.ClientOption{
+				cdcexchange.WithAPIVersion(cdcexchange.APIVersionV2),
+				cdcexchange.WithMethodAPIVersion(cdcexchange.MethodGetTicker, cdcexchange.APIVersionV1),
+			},
+			expectedPath: "/exchange/v1/" + cdcexchange.MethodGetTicker,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, tt.expectedPath, r.URL.Path)
+
+				_, err := w.Write([]byte(`{"id": 1, "code": 0, "result": {"data": []}}`))
+				require.NoError(t, err)
+			}))
+			t.Cleanup(s.Close)
+
+			opts := append([]cdcexchange.ClientOption{
+				cdcexchange.WithHTTPClient(s.Client()),
+				cdcexchange.WithBaseURL(fmt.Sprintf("%s/", s.URL)),
+			}, tt.opts...)
+
+			client, err := cdcexchange.New("api key", "secret key", opts...)
+			require.NoError(t, err)
+
+			_, err = client.GetTickers(context.Background(), "")
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestClient_APIVersion_Error(t *testing.T) {
+	tests := []struct {
+		name        string
+		opt         cdcexchange.ClientOption
+		expectedErr error
+	}{
+		{
+			name:        "returns error when api version is invalid",
+			opt:         cdcexchange.WithAPIVersion("v3"),
+			expectedErr: errors.InvalidParameterError{Parameter: "version", Reason: "must be v1 or v2"},
+		},
+		{
+			name:        "returns error when method is empty",
+			opt:         cdcexchange.WithMethodAPIVersion("", cdcexchange.APIVersionV2),
+			expectedErr: errors.InvalidParameterError{Parameter: "method", Reason: "cannot be empty"},
+		},
+		{
+			name:        "returns error when method api version is invalid",
+			opt:         cdcexchange.WithMethodAPIVersion(cdcexchange.MethodGetTicker, "v3"),
+			expectedErr: errors.InvalidParameterError{Parameter: "version", Reason: "must be v1 or v2"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client, err := cdcexchange.New("api key", "secret key", tt.opt)
+			require.Error(t, err)
+
+			assert.Nil(t, client)
+			assert.Equal(t, tt.expectedErr, err)
+		})
+	}
+}
+
+func TestClient_RateLimiter(t *testing.T) {
+	tests := []struct {
+		name        string
+		opts        []cdcexchange.ClientOption
+		expectedErr error
+	}{
+		{
+			name:        "waits for the method rate limit by default",
+			expectedErr: context.DeadlineExceeded,
+		},
+		{
+			name: "does not wait when the rate limiter is disabled",
+			opts: []cdcexchange.ClientOption{cdcexchange.WithoutRateLimiter()},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_, err := w.Write([]byte(`{"id": 1, "code": 0, "result": {"trade_list": []}}`))
+				require.NoError(t, err)
+			}))
+			t.Cleanup(s.Close)
+
+			opts := append([]cdcexchange.ClientOption{
+				cdcexchange.WithHTTPClient(s.Client()),
+				cdcexchange.WithBaseURL(fmt.Sprintf("%s/", s.URL)),
+			}, tt.opts...)
+
+			client, err := cdcexchange.New("api key", "secret key", opts...)
+			require.NoError(t, err)
+
+			// private/get-trades is limited to 1 request per second.
+			_, err = client.GetTrades(context.Background(), cdcexchange.GetTradesRequest{})
+			require.NoError(t, err)
+
+			ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+			t.Cleanup(cancel)
+
+			_, err = client.GetTrades(ctx, cdcexchange.GetTradesRequest{})
+			if tt.expectedErr == nil {
+				require.NoError(t, err)
+				return
+			}
+			assert.True(t, stderrors.Is(err, tt.expectedErr))
+		})
+	}
+}
+
+type limiter struct {
+	calls int
+	err   error
+}
+
+func (l *limiter) Wait(context.Context) error {
+	l.calls++
+	return l.err
+}
+
+func TestClient_WithRateLimiter(t *testing.T) {
+	testErr := stderrors.New("some error")
+
+	t.Run("returns error when limiter is nil", func(t *testing.T) {
+		client, err := cdcexchange.New("api key", "secret key", cdcexchange.WithRateLimiter(nil))
+		require.Error(t, err)
+
+		assert.Nil(t, client)
+		assert.Equal(t, errors.InvalidParameterError{Parameter: "limiter", Reason: "cannot be empty"}, err)
+	})
+
+	t.Run("waits for the limiter before each request", func(t *testing.T) {
+		var requests int
+		s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			requests++
+			_, err := w.Write([]byte(`{"id": 1, "code": 0, "result": {"data": []}}`))
+			require.NoError(t, err)
+		}))
+		t.Cleanup(s.Close)
+
+		l := &limiter{}
+		client, err := cdcexchange.New("api key", "secret key",
+			cdcexchange.WithHTTPClient(s.Client()),
+			cdcexchange.WithBaseURL(fmt.Sprintf("%s/", s.URL)),
+			cdcexchange.WithRateLimiter(l),
+		)
+		require.NoError(t, err)
+
+		_, err = client.GetTickers(context.Background(), "")
+		require.NoError(t, err)
+		_, err = client.GetInstruments(context.Background())
+		require.NoError(t, err)
+
+		assert.Equal(t, 2, l.calls)
+		assert.Equal(t, 2, requests)
+	})
+
+	t.Run("returns error without making a request given limiter error", func(t *testing.T) {
+		s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			t.Error("unexpected request")
+		}))
+		t.Cleanup(s.Close)
+
+		client, err := cdcexchange.New("api key", "secret key",
+			cdcexchange.WithHTTPClient(s.Client()),
+			cdcexchange.WithBaseURL(fmt.Sprintf("%s/", s.URL)),
+			cdcexchange.WithRateLimiter(&limiter{err: testErr}),
+		)
+		require.NoError(t, err)
+
+		_, err = client.GetTrades(context.Background(), cdcexchange.GetTradesRequest{})
+		require.Error(t, err)
+
+		assert.True(t, stderrors.Is(err, testErr))
+	})
+}
+
+func TestClient_WithRateLimitRetry_Error(t *testing.T) {
+	tests := []struct {
+		name        string
+		opt         cdcexchange.ClientOption
+		expectedErr error
+	}{
+		{
+			name:        "returns error when backoff is not positive",
+			opt:         cdcexchange.WithRateLimitRetry(0, time.Second),
+			expectedErr: errors.InvalidParameterError{Parameter: "backoff", Reason: "must be greater than 0"},
+		},
+		{
+			name:        "returns error when max wait is not positive",
+			opt:         cdcexchange.WithRateLimitRetry(time.Second, 0),
+			expectedErr: errors.InvalidParameterError{Parameter: "maxWait", Reason: "must be greater than 0"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client, err := cdcexchange.New("api key", "secret key", tt.opt)
+			require.Error(t, err)
+
+			assert.Nil(t, client)
+			assert.Equal(t, tt.expectedErr, err)
+		})
+	}
+}
+
+func TestClient_WithRetryPolicy_Error(t *testing.T) {
+	tests := []struct {
+		name        string
+		policy      cdcexchange.RetryPolicy
+		expectedErr error
+	}{
+		{
+			name:        "returns error when max retries is not positive",
+			policy:      cdcexchange.RetryPolicy{Backoff: time.Second},
+			expectedErr: errors.InvalidParameterError{Parameter: "policy.MaxRetries", Reason: "must be greater than 0"},
+		},
+		{
+			name:        "returns error when backoff is negative",
+			policy:      cdcexchange.RetryPolicy{MaxRetries: 1, Backoff: -1},
+			expectedErr: errors.InvalidParameterError{Parameter: "policy.Backoff", Reason: "cannot be less than 0"},
+		},
+		{
+			name:        "returns error when max backoff is negative",
+			policy:      cdcexchange.RetryPolicy{MaxRetries: 1, MaxBackoff: -1},
+			expectedErr: errors.InvalidParameterError{Parameter: "policy.MaxBackoff", Reason: "cannot be less than 0"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client, err := cdcexchange.New("api key", "secret key", cdcexchange.WithRetryPolicy(tt.policy))
+			require.Error(t, err)
+
+			assert.Nil(t, client)
+			assert.Equal(t, tt.expectedErr, err)
+		})
+	}
+}
+
+func TestClient_WithCircuitBreaker(t *testing.T) {
+	t.Run("returns error given invalid options", func(t *testing.T) {
+		_, err := cdcexchange.New("api key", "secret key", cdcexchange.WithCircuitBreaker(0, time.Second))
+		assert.Equal(t, errors.InvalidParameterError{Parameter: "threshold", Reason: "must be greater than 0"}, err)
+
+		_, err = cdcexchange.New("api key", "secret key", cdcexchange.WithCircuitBreaker(1, 0))
+		assert.Equal(t, errors.InvalidParameterError{Parameter: "cooldown", Reason: "must be greater than 0"}, err)
+	})
+
+	t.Run("fails fast after consecutive failures", func(t *testing.T) {
+		var requests int
+		s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			requests++
+			w.WriteHeader(http.StatusServiceUnavailable)
+			_, err := w.Write([]byte(`{"id": 1, "code": 10001}`))
+			require.NoError(t, err)
+		}))
+		t.Cleanup(s.Close)
+
+		client, err := cdcexchange.New("api key", "secret key",
+			cdcexchange.WithHTTPClient(s.Client()),
+			cdcexchange.WithBaseURL(fmt.Sprintf("%s/", s.URL)),
+			cdcexchange.WithCircuitBreaker(2, time.Minute),
+		)
+		require.NoError(t, err)
+
+		for i := 0; i < 2; i++ {
+			_, err = client.GetTickers(context.Background(), "")
+			require.Error(t, err)
+			assert.True(t, stderrors.Is(err, errors.ErrSystemError))
+		}
+
+		_, err = client.GetTickers(context.Background(), "")
+		require.Error(t, err)
+
+		assert.True(t, stderrors.Is(err, errors.ErrCircuitOpen))
+		assert.Equal(t, 2, requests)
+	})
+
+	t.Run("makes a trial request once cooldown has passed on the clock of the client", func(t *testing.T) {
+		var requests int
+		s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			requests++
+			w.WriteHeader(http.StatusServiceUnavailable)
+			_, err := w.Write([]byte(`{"id": 1, "code": 10001}`))
+			require.NoError(t, err)
+		}))
+		t.Cleanup(s.Close)
+
+		clock := clockwork.NewFakeClockAt(time.Now())
+		client, err := cdcexchange.New("api key", "secret key",
+			cdcexchange.WithHTTPClient(s.Client()),
+			cdcexchange.WithBaseURL(fmt.Sprintf("%s/", s.URL)),
+			cdcexchange.WithClock(clock),
+			cdcexchange.WithCircuitBreaker(1, time.Minute),
+		)
+		require.NoError(t, err)
+
+		_, err = client.GetTickers(context.Background(), "")
+		assert.True(t, stderrors.Is(err, errors.ErrSystemError))
+
+		_, err = client.GetTickers(context.Background(), "")
+		assert.True(t, stderrors.Is(err, errors.ErrCircuitOpen))
+		assert.Equal(t, 1, requests)
+
+		clock.Advance(time.Minute)
+
+		_, err = client.GetTickers(context.Background(), "")
+		assert.True(t, stderrors.Is(err, errors.ErrSystemError))
+		assert.Equal(t, 2, requests)
+	})
+}
+
+func TestClient_WithMiddleware(t *testing.T) {
+	t.Run("returns error given nil middleware", func(t *testing.T) {
+		client, err := cdcexchange.New("api key", "secret key", cdcexchange.WithMiddleware(nil))
+		require.Error(t, err)
+
+		assert.Nil(t, client)
+		assert.Equal(t, errors.InvalidParameterError{Parameter: "middlewares", Reason: "cannot contain nil"}, err)
+	})
+
+	t.Run("passes every request through the middlewares in order", func(t *testing.T) {
+		s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "outer,inner", r.Header.Get("X-Middleware"))
+
+			_, err := w.Write([]byte(`{"id": 1, "code": 0, "result": {"data": []}}`))
+			require.NoError(t, err)
+		}))
+		t.Cleanup(s.Close)
+
+		var calls []string
+		middleware := func(name string) cdcexchange.Middleware {
+			return func(next cdcexchange.RoundTripFunc) cdcexchange.RoundTripFunc {
+				return func(req *http.Request) (*http.Response, error) {
+					calls = append(calls, name)
+
+					header := name
+					if v := req.Header.Get("X-Middleware"); v != "" {
+						header = v + "," + name
+					}
+					req.Header.Set("X-Middleware", header)
+					return next(req)
+				}
+			}
+		}
+
+		client, err := cdcexchange.New("api key", "secret key",
+			cdcexchange.WithHTTPClient(s.Client()),
+			cdcexchange.WithBaseURL(fmt.Sprintf("%s/", s.URL)),
+			cdcexchange.WithMiddleware(middleware("outer")),
+			cdcexchange.WithMiddleware(middleware("inner")),
+		)
+		require.NoError(t, err)
+
+		_, err = client.GetTickers(context.Background(), "")
+		require.NoError(t, err)
+		_, err = client.GetInstruments(context.Background())
+		require.NoError(t, err)
+
+		assert.Equal(t, []string{"outer", "inner", "outer", "inner"}, calls)
+	})
+}
+
+func TestClient_WithLogger(t *testing.T) {
+	const (
+		apiKey    = "some api key"
+		secretKey = "some secret key"
+	)
+
+	t.Run("returns error given nil logger", func(t *testing.T) {
+		client, err := cdcexchange.New(apiKey, secretKey, cdcexchange.WithLogger(nil))
+		require.Error(t, err)
+
+		assert.Nil(t, client)
+		assert.Equal(t, errors.InvalidParameterError{Parameter: "logger", Reason: "cannot be empty"}, err)
+	})
+
+	t.Run("logs requests with credentials redacted", func(t *testing.T) {
+		s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusBadRequest)
+			_, err := w.Write([]byte(`{"id": 1, "code": 10004}`))
+			require.NoError(t, err)
+		}))
+		t.Cleanup(s.Close)
+
+		var buf bytes.Buffer
+		client, err := cdcexchange.New(apiKey, secretKey,
+			cdcexchange.WithHTTPClient(s.Client()),
+			cdcexchange.WithBaseURL(fmt.Sprintf("%s/", s.URL)),
+			cdcexchange.WithLogger(slog.New(slog.NewJSONHandler(&buf, &slog.HandlerOptions{Level: slog.LevelDebug}))),
+		)
+		require.NoError(t, err)
+
+		_, err = client.GetTrades(context.Background(), cdcexchange.GetTradesRequest{})
+		require.Error(t, err)
+
+		logs := buf.String()
+		assert.Contains(t, logs, `"msg":"sending request"`)
+		assert.Contains(t, logs, `"msg":"error received in response"`)
+		assert.Contains(t, logs, `"method":"private/get-trades"`)
+		assert.Contains(t, logs, `"status":400`)
+		assert.Contains(t, logs, `"code":"10004"`)
+		assert.Contains(t, logs, `"api_key":"[REDACTED]"`)
+		assert.NotContains(t, logs, apiKey)
+		assert.NotContains(t, logs, secretKey)
+	})
+}
+
+func TestClient_WithDebugDump(t *testing.T) {
+	const (
+		apiKey    = "some api key"
+		secretKey = "some secret key"
+	)
+
+	t.Run("returns error given nil writer", func(t *testing.T) {
+		client, err := cdcexchange.New(apiKey, secretKey, cdcexchange.WithDebugDump(nil))
+		require.Error(t, err)
+
+		assert.Nil(t, client)
+		assert.Equal(t, errors.InvalidParameterError{Parameter: "w", Reason: "cannot be empty"}, err)
+	})
+
+	t.Run("dumps requests and responses with the secret key and sensitive headers redacted", func(t *testing.T) {
+		s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusUnauthorized)
+			_, err := w.Write([]byte(`{"id": 1, "code": 40101}`))
+			require.NoError(t, err)
+		}))
+		t.Cleanup(s.Close)
+
+		now := time.Now()
+
+		var buf bytes.Buffer
+		client, err := cdcexchange.New(apiKey, secretKey,
+			cdcexchange.WithHTTPClient(s.Client()),
+			cdcexchange.WithBaseURL(fmt.Sprintf("%s/", s.URL)),
+			cdcexchange.WithClock(clockwork.NewFakeClockAt(now)),
+			cdcexchange.WithDefaultHeaders(http.Header{"Authorization": {"some token"}}),
+			cdcexchange.WithDebugDump(&buf),
+		)
+		require.NoError(t, err)
+
+		_, err = client.GetTrades(context.Background(), cdcexchange.GetTradesRequest{InstrumentName: "CRO_USDT"})
+		require.Error(t, err)
+		assert.True(t, stderrors.Is(err, errors.ErrUnauthorized))
+
+		dump := buf.String()
+		assert.Contains(t, dump, "---- request ----\nPOST /exchange/v1/private/get-trades HTTP/1.1")
+		assert.Contains(t, dump, `"api_key":"some api key"`)
+		assert.Contains(t, dump, `"sig":"`)
+		assert.Contains(t, dump, "# signature payload: private/get-trades")
+		assert.Contains(t, dump, fmt.Sprintf("some api keyinstrument_nameCRO_USDT%d", now.UnixMilli()))
+		assert.Contains(t, dump, "Authorization: [REDACTED]")
+		assert.Contains(t, dump, "---- response ----\nHTTP/1.1 401 Unauthorized")
+		assert.Contains(t, dump, `{"id": 1, "code": 40101}`)
+		assert.NotContains(t, dump, secretKey)
+		assert.NotContains(t, dump, "some token")
+	})
+}
+
+func TestClient_WithTracerProvider(t *testing.T) {
+	t.Run("returns error given nil tracer provider", func(t *testing.T) {
+		client, err := cdcexchange.New("api key", "secret key", cdcexchange.WithTracerProvider(nil))
+		require.Error(t, err)
+
+		assert.Nil(t, client)
+		assert.Equal(t, errors.InvalidParameterError{Parameter: "tracerProvider", Reason: "cannot be empty"}, err)
+	})
+
+	t.Run("creates a span for each request", func(t *testing.T) {
+		s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusBadRequest)
+			_, err := w.Write([]byte(`{"id": 1, "code": 30003}`))
+			require.NoError(t, err)
+		}))
+		t.Cleanup(s.Close)
+
+		recorder := tracetest.NewSpanRecorder()
+		tracerProvider := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+
+		client, err := cdcexchange.New("api key", "secret key",
+			cdcexchange.WithHTTPClient(s.Client()),
+			cdcexchange.WithBaseURL(fmt.Sprintf("%s/", s.URL)),
+			cdcexchange.WithTracerProvider(tracerProvider),
+		)
+		require.NoError(t, err)
+
+		ctx, parent := tracerProvider.Tracer("test").Start(context.Background(), "parent")
+		_, err = client.GetTrades(ctx, cdcexchange.GetTradesRequest{InstrumentName: "BTC_USDT"})
+		require.Error(t, err)
+		parent.End()
+
+		spans := recorder.Ended()
+		require.Len(t, spans, 2)
+
+		span := spans[0]
+		assert.Equal(t, cdcexchange.MethodGetTrades, span.Name())
+		assert.Equal(t, parent.SpanContext().SpanID(), span.Parent().SpanID())
+		assert.Equal(t, codes.Error, span.Status().Code)
+
+		attrs := make(map[attribute.Key]attribute.Value)
+		for _, attr := range span.Attributes() {
+			attrs[attr.Key] = attr.Value
+		}
+		assert.Equal(t, cdcexchange.MethodGetTrades, attrs["cdcexchange.method"].AsString())
+		assert.Equal(t, "BTC_USDT", attrs["cdcexchange.instrument_name"].AsString())
+		assert.Equal(t, int64(http.StatusBadRequest), attrs["http.response.status_code"].AsInt64())
+		assert.Equal(t, "30003", attrs["cdcexchange.response_code"].AsString())
+		assert.Contains(t, attrs, attribute.Key("cdcexchange.latency_ms"))
+	})
+}
+
+type metrics struct {
+	methods []string
+	codes   []string
+}
+
+func (m *metrics) ObserveRequest(method string, _ int, code string, _ time.Duration, _ error) {
+	m.methods = append(m.methods, method)
+	m.codes = append(m.codes, code)
+}
+
+func TestClient_WithMetrics(t *testing.T) {
+	t.Run("returns error given nil metrics", func(t *testing.T) {
+		client, err := cdcexchange.New("api key", "secret key", cdcexchange.WithMetrics(nil))
+		require.Error(t, err)
+
+		assert.Nil(t, client)
+		assert.Equal(t, errors.InvalidParameterError{Parameter: "metrics", Reason: "cannot be empty"}, err)
+	})
+
+	t.Run("records every request", func(t *testing.T) {
+		s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			_, err := w.Write([]byte(`{"id": 1, "code": 0, "result": {"data": []}}`))
+			require.NoError(t, err)
+		}))
+		t.Cleanup(s.Close)
+
+		m := &metrics{}
+		client, err := cdcexchange.New("api key", "secret key",
+			cdcexchange.WithHTTPClient(s.Client()),
+			cdcexchange.WithBaseURL(fmt.Sprintf("%s/", s.URL)),
+			cdcexchange.WithMetrics(m),
+		)
+		require.NoError(t, err)
+
+		_, err = client.GetTickers(context.Background(), "")
+		require.NoError(t, err)
+		_, err = client.GetInstruments(context.Background())
+		require.NoError(t, err)
+
+		assert.Equal(t, []string{cdcexchange.MethodGetTicker, cdcexchange.MethodGetInstruments}, m.methods)
+		assert.Equal(t, []string{"0", "0"}, m.codes)
+	})
+}
+
+func TestClient_WithServerTimeSync(t *testing.T) {
+	t.Run("returns error given non-positive interval", func(t *testing.T) {
+		client, err := cdcexchange.New("api key", "secret key", cdcexchange.WithServerTimeSync(0))
+		require.Error(t, err)
+
+		assert.Nil(t, client)
+		assert.Equal(t, errors.InvalidParameterError{Parameter: "interval", Reason: "must be greater than 0"}, err)
+	})
+
+	t.Run("corrects the nonce for the drift measured from responses", func(t *testing.T) {
+		serverTime := time.Now().Add(-time.Hour).UTC().Truncate(time.Second)
+
+		var nonces []int64
+		s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			var body api.Request
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+			nonces = append(nonces, body.Nonce)
+
+			w.Header().Set("Date", serverTime.Format(http.TimeFormat))
+			_, err := w.Write([]byte(`{"id": 1, "code": 0, "result": {"data": []}}`))
+			require.NoError(t, err)
+		}))
+		t.Cleanup(s.Close)
+
+		client, err := cdcexchange.New("api key", "secret key",
+			cdcexchange.WithHTTPClient(s.Client()),
+			cdcexchange.WithBaseURL(fmt.Sprintf("%s/", s.URL)),
+			cdcexchange.WithServerTimeSync(time.Hour),
+		)
+		require.NoError(t, err)
+
+		for i := 0; i < 2; i++ {
+			_, err = client.GetUserBalance(context.Background())
+			require.NoError(t, err)
+		}
+
+		require.Len(t, nonces, 2)
+		assert.WithinDuration(t, time.Now(), time.UnixMilli(nonces[0]), 2*time.Second)
+		assert.WithinDuration(t, serverTime, time.UnixMilli(nonces[1]), 2*time.Second)
+	})
+}
+
+func TestClient_WithProxy(t *testing.T) {
+	t.Run("returns error given invalid proxy url", func(t *testing.T) {
+		client, err := cdcexchange.New("api key", "secret key", cdcexchange.WithProxy("not a url"))
+		require.Error(t, err)
+
+		assert.Nil(t, client)
+		assert.Equal(t, errors.InvalidParameterError{Parameter: "proxyURL", Reason: "must be a valid URL"}, err)
+	})
+
+	t.Run("returns error given http client with custom transport", func(t *testing.T) {
+		client, err := cdcexchange.New("api key", "secret key",
+			cdcexchange.WithHTTPClient(&http.Client{Transport: roundTripper{}}),
+			cdcexchange.WithProxy("http://proxy.example.com:8080"),
+		)
+		require.Error(t, err)
+
+		assert.Nil(t, client)
+		assert.Equal(t, errors.InvalidParameterError{Parameter: "proxyURL", Reason: "cannot be applied to an HTTP client with a custom transport"}, err)
+	})
+
+	t.Run("sends requests through the proxy", func(t *testing.T) {
+		var host string
+		proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			host = r.URL.Host
+			_, err := w.Write([]byte(`{"id": 1, "code": 0, "result": {"data": []}}`))
+			require.NoError(t, err)
+		}))
+		t.Cleanup(proxy.Close)
+
+		client, err := cdcexchange.New("api key", "secret key",
+			cdcexchange.WithBaseURL("http://exchange.example.com/"),
+			cdcexchange.WithProxy(proxy.URL),
+		)
+		require.NoError(t, err)
+
+		_, err = client.GetTickers(context.Background(), "")
+		require.NoError(t, err)
+
+		assert.Equal(t, "exchange.example.com", host)
+	})
+}
+
+func TestClient_WithTLSConfig(t *testing.T) {
+	t.Run("returns error given nil tls config", func(t *testing.T) {
+		client, err := cdcexchange.New("api key", "secret key", cdcexchange.WithTLSConfig(nil))
+		require.Error(t, err)
+
+		assert.Nil(t, client)
+		assert.Equal(t, errors.InvalidParameterError{Parameter: "tlsConfig", Reason: "cannot be empty"}, err)
+	})
+
+	t.Run("uses the tls config for connections", func(t *testing.T) {
+		s := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			_, err := w.Write([]byte(`{"id": 1, "code": 0, "result": {"data": []}}`))
+			require.NoError(t, err)
+		}))
+		t.Cleanup(s.Close)
+
+		// the certificate of the test server is not trusted by default
+		client, err := cdcexchange.New("api key", "secret key", cdcexchange.WithBaseURL(fmt.Sprintf("%s/", s.URL)))
+		require.NoError(t, err)
+		_, err = client.GetTickers(context.Background(), "")
+		require.Error(t, err)
+
+		rootCAs := x509.NewCertPool()
+		rootCAs.AddCert(s.Certificate())
+
+		client, err = cdcexchange.New("api key", "secret key",
+			cdcexchange.WithBaseURL(fmt.Sprintf("%s/", s.URL)),
+			cdcexchange.WithTLSConfig(&tls.Config{RootCAs: rootCAs}),
+		)
+		require.NoError(t, err)
+		_, err = client.GetTickers(context.Background(), "")
+		require.NoError(t, err)
+	})
+}
+
+func TestClient_WithUserAgent(t *testing.T) {
+	t.Run("returns error given empty user agent", func(t *testing.T) {
+		client, err := cdcexchange.New("api key", "secret key", cdcexchange.WithUserAgent(""))
+		require.Error(t, err)
+
+		assert.Nil(t, client)
+		assert.Equal(t, errors.InvalidParameterError{Parameter: "userAgent", Reason: "cannot be empty"}, err)
+	})
+
+	t.Run("sets the user agent of every request", func(t *testing.T) {
+		var userAgents []string
+		s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			userAgents = append(userAgents, r.UserAgent())
+			_, err := w.Write([]byte(`{"id": 1, "code": 0, "result": {"data": []}}`))
+			require.NoError(t, err)
+		}))
+		t.Cleanup(s.Close)
+
+		client, err := cdcexchange.New("api key", "secret key",
+			cdcexchange.WithHTTPClient(s.Client()),
+			cdcexchange.WithBaseURL(fmt.Sprintf("%s/", s.URL)),
+			cdcexchange.WithUserAgent("some user agent"),
+		)
+		require.NoError(t, err)
+
+		_, err = client.GetTickers(context.Background(), "")
+		require.NoError(t, err)
+		_, err = client.GetUserBalance(context.Background())
+		require.NoError(t, err)
+
+		assert.Equal(t, []string{"some user agent", "some user agent"}, userAgents)
+	})
+}
+
+func TestClient_WithDefaultHeaders(t *testing.T) {
+	t.Run("returns error given empty headers", func(t *testing.T) {
+		client, err := cdcexchange.New("api key", "secret key", cdcexchange.WithDefaultHeaders(nil))
+		require.Error(t, err)
+
+		assert.Nil(t, client)
+		assert.Equal(t, errors.InvalidParameterError{Parameter: "headers", Reason: "cannot be empty"}, err)
+	})
+
+	t.Run("adds the headers to every request", func(t *testing.T) {
+		var headers []http.Header
+		s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			headers = append(headers, r.Header.Clone())
+			_, err := w.Write([]byte(`{"id": 1, "code": 0, "result": {"data": []}}`))
+			require.NoError(t, err)
+		}))
+		t.Cleanup(s.Close)
+
+		client, err := cdcexchange.New("api key", "secret key",
+			cdcexchange.WithHTTPClient(s.Client()),
+			cdcexchange.WithBaseURL(fmt.Sprintf("%s/", s.URL)),
+			cdcexchange.WithDefaultHeaders(http.Header{"x-team": []string{"some team"}, "Content-Type": []string{"text/plain"}}),
+			cdcexchange.WithDefaultHeaders(http.Header{"X-Job": []string{"some job"}}),
+		)
+		require.NoError(t, err)
+
+		_, err = client.GetTickers(context.Background(), "")
+		require.NoError(t, err)
+		_, err = client.GetUserBalance(context.Background())
+		require.NoError(t, err)
+
+		require.Len(t, headers, 2)
+		for _, header := range headers {
+			assert.Equal(t, "some team", header.Get("X-Team"))
+			assert.Equal(t, "some job", header.Get("X-Job"))
+			assert.Equal(t, "application/json", header.Get("Content-Type"))
+		}
+	})
+}
+
+type keySignatureGenerator struct{}
+
+func (keySignatureGenerator) GenerateSignature(req auth.SignatureRequest) (string, error) {
+	return req.APIKey + ":" + req.SecretKey, nil
+}
+
+func TestClient_UpdateConfig_Concurrent(t *testing.T) {
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body api.Request
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+
+		// the key pair used to sign the request is never a mix of the old and new keys
+		assert.Contains(t, []string{"old api key:old secret key", "new api key:new secret key"}, body.Signature)
+		assert.True(t, strings.HasPrefix(body.Signature, body.APIKey+":"))
+
+		_, err := w.Write([]byte(`{"id": 1, "code": 0, "result": {"data": []}}`))
+		require.NoError(t, err)
+	}))
+	t.Cleanup(s.Close)
+
+	client, err := cdcexchange.New("old api key", "old secret key",
+		cdcexchange.WithHTTPClient(s.Client()),
+		cdcexchange.WithBaseURL(fmt.Sprintf("%s/", s.URL)),
+		cdcexchange.WithSignatureGenerator(keySignatureGenerator{}),
+		cdcexchange.WithoutRateLimiter(),
+	)
+	require.NoError(t, err)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			_, err := client.GetUserBalance(context.Background())
+			assert.NoError(t, err)
+		}()
+	}
+
+	for i := 0; i < 10; i++ {
+		if i%2 == 0 {
+			require.NoError(t, client.UpdateConfig("new api key", "new secret key"))
+		} else {
+			require.NoError(t, client.UpdateConfig("old api key", "old secret key"))
+		}
+	}
+	wg.Wait()
+}
+
+func TestClient_WithAPIKeyPool(t *testing.T) {
+	t.Run("returns error given invalid keys", func(t *testing.T) {
+		tests := []struct {
+			name        string
+			keys        []cdcexchange.Credentials
+			expectedErr error
+		}{
+			{
+				name:        "no keys",
+				expectedErr: errors.InvalidParameterError{Parameter: "keys", Reason: "cannot be empty"},
+			},
+			{
+				name:        "empty api key",
+				keys:        []cdcexchange.Credentials{{APIKey: "api key", SecretKey: "secret key"}, {SecretKey: "secret key"}},
+				expectedErr: errors.InvalidParameterError{Parameter: "keys[1].APIKey", Reason: "cannot be empty"},
+			},
+			{
+				name:        "empty secret key",
+				keys:        []cdcexchange.Credentials{{APIKey: "api key"}},
+				expectedErr: errors.InvalidParameterError{Parameter: "keys[0].SecretKey", Reason: "cannot be empty"},
+			},
+		}
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				client, err := cdcexchange.New("api key", "secret key", cdcexchange.WithAPIKeyPool(tt.keys...))
+				require.Error(t, err)
+
+				assert.Nil(t, client)
+				assert.Equal(t, tt.expectedErr, err)
+			})
+		}
+	})
+
+	t.Run("rotates between keys round-robin", func(t *testing.T) {
+		var apiKeys []string
+		s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			var body api.Request
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+			assert.Equal(t, body.APIKey+":"+secretKeyOf(body.APIKey), body.Signature)
+			apiKeys = append(apiKeys, body.APIKey)
+
+			_, err := w.Write([]byte(`{"id": 1, "code": 0, "result": {"data": []}}`))
+			require.NoError(t, err)
+		}))
+		t.Cleanup(s.Close)
+
+		client, err := cdcexchange.New("key 1", secretKeyOf("key 1"),
+			cdcexchange.WithHTTPClient(s.Client()),
+			cdcexchange.WithBaseURL(fmt.Sprintf("%s/", s.URL)),
+			cdcexchange.WithSignatureGenerator(keySignatureGenerator{}),
+			cdcexchange.WithAPIKeyPool(
+				cdcexchange.Credentials{APIKey: "key 2", SecretKey: secretKeyOf("key 2")},
+				cdcexchange.Credentials{APIKey: "key 3", SecretKey: secretKeyOf("key 3")},
+			),
+		)
+		require.NoError(t, err)
+
+		start := time.Now()
+		for i := 0; i < 3; i++ {
+			// private/get-trades is limited to 1 request per second for each api key.
+			_, err = client.GetTrades(context.Background(), cdcexchange.GetTradesRequest{})
+			require.NoError(t, err)
+		}
+		assert.Less(t, int64(time.Since(start)), int64(500*time.Millisecond))
+
+		for i := 0; i < 3; i++ {
+			_, err = client.GetUserBalance(context.Background())
+			require.NoError(t, err)
+		}
+
+		assert.Equal(t, []string{"key 1", "key 2", "key 3", "key 1", "key 2", "key 3"}, apiKeys)
+	})
+}
+
+func secretKeyOf(apiKey string) string {
+	return "secret of " + apiKey
+}
+
+func TestContextWithCredentials(t *testing.T) {
+	var apiKeys []string
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body api.Request
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.Equal(t, body.APIKey+":"+secretKeyOf(body.APIKey), body.Signature)
+		apiKeys = append(apiKeys, body.APIKey)
+
+		_, err := w.Write([]byte(`{"id": 1, "code": 0, "result": {"data": []}}`))
+		require.NoError(t, err)
+	}))
+	t.Cleanup(s.Close)
+
+	client, err := cdcexchange.New("key 1", secretKeyOf("key 1"),
+		cdcexchange.WithHTTPClient(s.Client()),
+		cdcexchange.WithBaseURL(fmt.Sprintf("%s/", s.URL)),
+		cdcexchange.WithSignatureGenerator(keySignatureGenerator{}),
+	)
+	require.NoError(t, err)
+
+	ctx := cdcexchange.ContextWithCredentials(context.Background(), cdcexchange.Credentials{
+		APIKey:    "sub-account key",
+		SecretKey: secretKeyOf("sub-account key"),
+	})
+
+	_, err = client.GetUserBalance(ctx)
+	require.NoError(t, err)
+	_, err = client.GetUserBalance(context.Background())
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"sub-account key", "key 1"}, apiKeys)
+}
+
+func TestContextWithCredentials_Error(t *testing.T) {
+	tests := []struct {
+		name        string
+		creds       cdcexchange.Credentials
+		expectedErr error
+	}{
+		{
+			name:        "returns error when api key is empty",
+			creds:       cdcexchange.Credentials{SecretKey: "secret key"},
+			expectedErr: errors.InvalidParameterError{Parameter: "creds.APIKey", Reason: "cannot be empty"},
+		},
+		{
+			name:        "returns error when secret key is empty",
+			creds:       cdcexchange.Credentials{APIKey: "api key"},
+			expectedErr: errors.InvalidParameterError{Parameter: "creds.SecretKey", Reason: "cannot be empty"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client, err := cdcexchange.New("api key", "secret key",
+				cdcexchange.WithHTTPClient(&http.Client{Transport: roundTripper{err: stderrors.New("unexpected request")}}),
+			)
+			require.NoError(t, err)
+
+			_, err = client.GetUserBalance(cdcexchange.ContextWithCredentials(context.Background(), tt.creds))
+			require.Error(t, err)
+
+			assert.True(t, stderrors.Is(err, tt.expectedErr))
+		})
+	}
+}
+
+func TestClient_WithDryRun(t *testing.T) {
+	var methods []string
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body api.Request
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		methods = append(methods, body.Method)
+
+		_, err := w.Write([]byte(`{"id": 1, "code": 0, "result": {"data": []}}`))
+		require.NoError(t, err)
+	}))
+	t.Cleanup(s.Close)
+
+	client, err := cdcexchange.New("api key", "secret key",
+		cdcexchange.WithHTTPClient(s.Client()),
+		cdcexchange.WithBaseURL(fmt.Sprintf("%s/", s.URL)),
+		cdcexchange.WithDryRun(),
+		cdcexchange.WithClientOIDGeneration(),
+	)
+	require.NoError(t, err)
+
+	order, err := client.CreateOrder(context.Background(), cdcexchange.CreateOrderRequest{
+		InstrumentName: "CRO_BTC",
+		Side:           cdcexchange.OrderSideBuy,
+		Type:           cdcexchange.OrderTypeLimit,
+		Price:          cdcexchange.MustParseDecimal("1"),
+		Quantity:       cdcexchange.MustParseDecimal("1"),
+	})
+	require.NoError(t, err)
+	assert.Empty(t, order.OrderID)
+	assert.NotEmpty(t, order.ClientOID)
+
+	withdrawal, err := client.CreateWithdrawal(context.Background(), cdcexchange.CreateWithdrawalRequest{
+		Currency: "BTC",
+		Amount:   cdcexchange.MustParseDecimal("1.5"),
+		Address:  "some address",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, cdcexchange.MustParseDecimal("1.5"), withdrawal.Amount)
+	assert.Equal(t, "some address", withdrawal.Address)
+
+	require.NoError(t, client.Transfer(context.Background(), "USDT", cdcexchange.MustParseDecimal("1"), cdcexchange.WalletSpot, cdcexchange.WalletDerivatives))
+
+	// read-only requests are sent as normal.
+	_, err = client.GetUserBalance(context.Background())
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{cdcexchange.MethodGetUserBalance}, methods)
+}
+
+func TestClient_WithReadOnly(t *testing.T) {
+	var methods []string
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body api.Request
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		methods = append(methods, body.Method)
+
+		_, err := w.Write([]byte(`{"id": 1, "code": 0, "result": {"data": []}}`))
+		require.NoError(t, err)
+	}))
+	t.Cleanup(s.Close)
+
+	client, err := cdcexchange.New("api key", "secret key",
+		cdcexchange.WithHTTPClient(s.Client()),
+		cdcexchange.WithBaseURL(fmt.Sprintf("%s/", s.URL)),
+		cdcexchange.WithReadOnly(),
+	)
+	require.NoError(t, err)
+
+	_, err = client.CreateOrder(context.Background(), cdcexchange.CreateOrderRequest{InstrumentName: "CRO_BTC"})
+	assert.True(t, stderrors.Is(err, errors.ErrReadOnlyClient))
+
+	_, err = client.CreateWithdrawal(context.Background(), cdcexchange.CreateWithdrawalRequest{Currency: "BTC", Amount: cdcexchange.MustParseDecimal("1"), Address: "some address"})
+	assert.True(t, stderrors.Is(err, errors.ErrReadOnlyClient))
+
+	err = client.Transfer(context.Background(), "USDT", cdcexchange.MustParseDecimal("1"), cdcexchange.WalletSpot, cdcexchange.WalletDerivatives)
+	assert.True(t, stderrors.Is(err, errors.ErrReadOnlyClient))
+
+	_, err = client.Stake(context.Background(), "SOL.staked", cdcexchange.MustParseDecimal("1"))
+	assert.True(t, stderrors.Is(err, errors.ErrReadOnlyClient))
+
+	// read-only requests are sent as normal.
+	_, err = client.GetUserBalance(context.Background())
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{cdcexchange.MethodGetUserBalance}, methods)
+}
+
+func TestClient_WithResponseIDVerification(t *testing.T) {
+	var crossWired bool
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body api.Request
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+
+		id := body.ID
+		if crossWired {
+			id++
+		}
+
+		_, err := fmt.Fprintf(w, `{"id": %d, "code": 0, "result": {"data": []}}`, id)
+		require.NoError(t, err)
+	}))
+	t.Cleanup(s.Close)
+
+	client, err := cdcexchange.New("api key", "secret key",
+		cdcexchange.WithHTTPClient(s.Client()),
+		cdcexchange.WithBaseURL(fmt.Sprintf("%s/", s.URL)),
+		cdcexchange.WithResponseIDVerification(),
+	)
+	require.NoError(t, err)
+
+	_, err = client.GetUserBalance(context.Background())
+	require.NoError(t, err)
+
+	crossWired = true
+
+	_, err = client.GetUserBalance(context.Background())
+	require.Error(t, err)
+
+	var mismatchErr errors.ResponseIDMismatchError
+	require.True(t, stderrors.As(err, &mismatchErr))
+	assert.Equal(t, cdcexchange.MethodGetUserBalance, mismatchErr.Method)
+	assert.Equal(t, strconv.FormatInt(mismatchErr.RequestID+1, 10), mismatchErr.ResponseID)
+}
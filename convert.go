@@ -0,0 +1,137 @@
+package cdcexchange
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/sngyai/go-cryptocom/v2/errors"
+	"github.com/sngyai/go-cryptocom/v2/internal/api"
+	"github.com/sngyai/go-cryptocom/v2/internal/auth"
+)
+
+const (
+	methodConvert = "private/staking/convert"
+)
+
+type (
+	// ConvertRequest is the request params sent for the private/staking/convert API.
+	//
+	// The conversion is rejected if the actual rate deviates from ExpectedRate by more than SlippageToleranceBps.
+	ConvertRequest struct {
+		// FromInstrumentName is the instrument to convert from (e.g. ETH.staked).
+		FromInstrumentName string `json:"from_instrument_name"`
+		// ToInstrumentName is the instrument to convert to (e.g. CDCETH).
+		ToInstrumentName string `json:"to_instrument_name"`
+		// ExpectedRate is the expected conversion rate, as returned by the public/staking/get-conversion-rate API.
+		ExpectedRate Decimal `json:"expected_rate"`
+		// FromQuantity is the quantity to convert, with at most 8 decimal places.
+		FromQuantity Decimal `json:"from_quantity"`
+		// SlippageToleranceBps is the maximum deviation from ExpectedRate accepted, in basis points.
+		SlippageToleranceBps int `json:"slippage_tolerance_bps"`
+	}
+
+	// ConvertResponse is the base response returned from the private/staking/convert API.
+	ConvertResponse struct {
+		// BaseResponse is the common response fields.
+		BaseResponse
+		// Result is the response attributes of the endpoint.
+		Result ConvertResult `json:"result"`
+	}
+
+	// ConvertResult is the result returned from the private/staking/convert API.
+	ConvertResult struct {
+		// ConvertID is the unique identifier of the conversion request.
+		ConvertID int64 `json:"convert_id"`
+		// FromInstrumentName is the instrument converted from.
+		FromInstrumentName string `json:"from_instrument_name"`
+		// ToInstrumentName is the instrument converted to.
+		ToInstrumentName string `json:"to_instrument_name"`
+		// ExpectedRate is the expected conversion rate.
+		ExpectedRate Decimal `json:"expected_rate"`
+		// FromQuantity is the quantity requested to be converted.
+		FromQuantity Decimal `json:"from_quantity"`
+		// SlippageToleranceBps is the accepted slippage, in basis points.
+		SlippageToleranceBps Decimal `json:"slippage_tolerance_bps"`
+		// Reason is the reason code, set when the request is rejected.
+		Reason string `json:"reason"`
+		// Raw is the raw JSON of the result, for fields not decoded by this package.
+		Raw json.RawMessage `json:"-"`
+	}
+)
+
+// Convert creates a request to convert between staked token representations (e.g. ETH.staked to CDCETH).
+//
+// Method: private/staking/convert
+func (c *Client) Convert(ctx context.Context, req ConvertRequest) (*ConvertResult, error) {
+	switch {
+	case req.FromInstrumentName == "":
+		return nil, errors.InvalidParameterError{Parameter: "req.FromInstrumentName", Reason: "cannot be empty"}
+	case req.ToInstrumentName == "":
+		return nil, errors.InvalidParameterError{Parameter: "req.ToInstrumentName", Reason: "cannot be empty"}
+	case req.FromInstrumentName == req.ToInstrumentName:
+		return nil, errors.InvalidParameterError{Parameter: "req.ToInstrumentName", Reason: "cannot be the same as req.FromInstrumentName"}
+	case req.ExpectedRate.Sign() <= 0:
+		return nil, errors.InvalidParameterError{Parameter: "req.ExpectedRate", Reason: "must be greater than 0"}
+	case req.SlippageToleranceBps < 0:
+		return nil, errors.InvalidParameterError{Parameter: "req.SlippageToleranceBps", Reason: "cannot be less than 0"}
+	case req.SlippageToleranceBps > 10000:
+		return nil, errors.InvalidParameterError{Parameter: "req.SlippageToleranceBps", Reason: "cannot be greater than 10000"}
+	}
+	if err := validateStakingQuantity("req.FromQuantity", req.FromQuantity); err != nil {
+		return nil, err
+	}
+
+	var (
+		id        = c.idGenerator.Generate()
+		timestamp = c.now().UnixMilli()
+		params    = make(map[string]interface{})
+	)
+
+	creds, err := c.credentials(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	params["from_instrument_name"] = req.FromInstrumentName
+	params["to_instrument_name"] = req.ToInstrumentName
+	params["expected_rate"] = req.ExpectedRate
+	params["from_quantity"] = req.FromQuantity
+	params["slippage_tolerance_bps"] = strconv.Itoa(req.SlippageToleranceBps)
+
+	signature, err := c.signatureGenerator.GenerateSignature(auth.SignatureRequest{
+		APIKey:    creds.APIKey,
+		SecretKey: creds.SecretKey,
+		ID:        id,
+		Method:    methodConvert,
+		Timestamp: timestamp,
+		Params:    params,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create signature: %w", err)
+	}
+
+	body := api.Request{
+		ID:        id,
+		Method:    methodConvert,
+		Nonce:     timestamp,
+		Params:    params,
+		Signature: signature,
+		APIKey:    creds.APIKey,
+	}
+
+	var convertResponse ConvertResponse
+	statusCode, err := c.requester.Post(ctx, body, methodConvert, &convertResponse)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute post request: %w", err)
+	}
+
+	if err := c.requester.CheckResponse(statusCode, api.BaseResponse(convertResponse.BaseResponse)); err != nil {
+		return nil, fmt.Errorf("error received in response: %w", err)
+	}
+
+	convertResponse.Result.Raw = convertResponse.RawResult
+
+	return &convertResponse.Result, nil
+}
@@ -0,0 +1,203 @@
+package cdcexchange_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/jonboulle/clockwork"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	cdcexchange "github.com/sngyai/go-cryptocom/v2"
+	cdcerrors "github.com/sngyai/go-cryptocom/v2/errors"
+	"github.com/sngyai/go-cryptocom/v2/internal/api"
+	"github.com/sngyai/go-cryptocom/v2/internal/auth"
+	id_mocks "github.com/sngyai/go-cryptocom/v2/internal/mocks/id"
+	signature_mocks "github.com/sngyai/go-cryptocom/v2/internal/mocks/signature"
+)
+
+func TestClient_Convert_Error(t *testing.T) {
+	const (
+		apiKey    = "some api key"
+		secretKey = "some secret key"
+	)
+
+	valid := cdcexchange.ConvertRequest{
+		FromInstrumentName:   "ETH.staked",
+		ToInstrumentName:     "CDCETH",
+		ExpectedRate:         cdcexchange.MustParseDecimal("1.0203"),
+		FromQuantity:         cdcexchange.MustParseDecimal("3.14159265"),
+		SlippageToleranceBps: 3,
+	}
+
+	tests := []struct {
+		name        string
+		modify      func(req *cdcexchange.ConvertRequest)
+		expectedErr error
+	}{
+		{
+			name:        "returns error when from instrument name is empty",
+			modify:      func(req *cdcexchange.ConvertRequest) { req.FromInstrumentName = "" },
+			expectedErr: cdcerrors.InvalidParameterError{Parameter: "req.FromInstrumentName", Reason: "cannot be empty"},
+		},
+		{
+			name:        "returns error when to instrument name is empty",
+			modify:      func(req *cdcexchange.ConvertRequest) { req.ToInstrumentName = "" },
+			expectedErr: cdcerrors.InvalidParameterError{Parameter: "req.ToInstrumentName", Reason: "cannot be empty"},
+		},
+		{
+			name:        "returns error when instruments are the same",
+			modify:      func(req *cdcexchange.ConvertRequest) { req.ToInstrumentName = req.FromInstrumentName },
+			expectedErr: cdcerrors.InvalidParameterError{Parameter: "req.ToInstrumentName", Reason: "cannot be the same as req.FromInstrumentName"},
+		},
+		{
+			name:        "returns error when expected rate is not positive",
+			modify:      func(req *cdcexchange.ConvertRequest) { req.ExpectedRate = cdcexchange.MustParseDecimal("0") },
+			expectedErr: cdcerrors.InvalidParameterError{Parameter: "req.ExpectedRate", Reason: "must be greater than 0"},
+		},
+		{
+			name:        "returns error when slippage tolerance is negative",
+			modify:      func(req *cdcexchange.ConvertRequest) { req.SlippageToleranceBps = -1 },
+			expectedErr: cdcerrors.InvalidParameterError{Parameter: "req.SlippageToleranceBps", Reason: "cannot be less than 0"},
+		},
+		{
+			name:        "returns error when slippage tolerance is greater than 100%",
+			modify:      func(req *cdcexchange.ConvertRequest) { req.SlippageToleranceBps = 10001 },
+			expectedErr: cdcerrors.InvalidParameterError{Parameter: "req.SlippageToleranceBps", Reason: "cannot be greater than 10000"},
+		},
+		{
+			name:        "returns error when from quantity has too many decimal places",
+			modify:      func(req *cdcexchange.ConvertRequest) { req.FromQuantity = cdcexchange.MustParseDecimal("0.123456789") },
+			expectedErr: cdcerrors.InvalidParameterError{Parameter: "req.FromQuantity", Reason: "cannot have more than 8 decimal places"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client, err := cdcexchange.New(apiKey, secretKey)
+			require.NoError(t, err)
+
+			req := valid
+			tt.modify(&req)
+
+			res, err := client.Convert(context.Background(), req)
+			require.Error(t, err)
+
+			assert.Nil(t, res)
+			assert.True(t, errors.Is(err, tt.expectedErr))
+		})
+	}
+}
+
+func TestClient_Convert_Success(t *testing.T) {
+	const (
+		apiKey    = "some api key"
+		secretKey = "some secret key"
+		id        = int64(1234)
+		signature = "some signature"
+	)
+	var (
+		now          = time.Now()
+		expectedRate = cdcexchange.MustParseDecimal("1.0203")
+		fromQuantity = cdcexchange.MustParseDecimal("3.14159265")
+	)
+
+	ctrl, ctx := gomock.WithContext(context.Background(), t)
+	t.Cleanup(ctrl.Finish)
+
+	var (
+		signatureGenerator = signature_mocks.NewMockSignatureGenerator(ctrl)
+		idGenerator        = id_mocks.NewMockIDGenerator(ctrl)
+		clock              = clockwork.NewFakeClockAt(now)
+	)
+
+	expectedParams := map[string]interface{}{
+		"from_instrument_name":   "ETH.staked",
+		"to_instrument_name":     "CDCETH",
+		"expected_rate":          expectedRate,
+		"from_quantity":          fromQuantity,
+		"slippage_tolerance_bps": "3",
+	}
+
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Contains(t, r.URL.Path, cdcexchange.MethodConvert)
+		t.Cleanup(func() { require.NoError(t, r.Body.Close()) })
+
+		var body api.Request
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+
+		assert.Equal(t, cdcexchange.MethodConvert, body.Method)
+		assert.Equal(t, signature, body.Signature)
+		assert.Equal(t, map[string]interface{}{
+			"from_instrument_name":   "ETH.staked",
+			"to_instrument_name":     "CDCETH",
+			"expected_rate":          "1.0203",
+			"from_quantity":          "3.14159265",
+			"slippage_tolerance_bps": "3",
+		}, body.Params)
+
+		_, err := w.Write([]byte(`{
+			"id": 1234,
+			"method": "private/staking/convert",
+			"code": 0,
+			"result": {
+				"from_instrument_name": "ETH.staked",
+				"to_instrument_name": "CDCETH",
+				"expected_rate": "1.0203",
+				"from_quantity": "3.14159265",
+				"slippage_tolerance_bps": "3",
+				"convert_id": 1,
+				"reason": "NO_ERROR"
+			}
+		}`))
+		require.NoError(t, err)
+	}))
+	t.Cleanup(s.Close)
+
+	client, err := cdcexchange.New(apiKey, secretKey,
+		cdcexchange.WithIDGenerator(idGenerator),
+		cdcexchange.WithClock(clock),
+		cdcexchange.WithHTTPClient(s.Client()),
+		cdcexchange.WithBaseURL(fmt.Sprintf("%s/", s.URL)),
+		cdcexchange.WithSignatureGenerator(signatureGenerator),
+	)
+	require.NoError(t, err)
+
+	idGenerator.EXPECT().Generate().Return(id)
+	signatureGenerator.EXPECT().GenerateSignature(auth.SignatureRequest{
+		APIKey:    apiKey,
+		SecretKey: secretKey,
+		ID:        id,
+		Method:    cdcexchange.MethodConvert,
+		Timestamp: now.UnixMilli(),
+		Params:    expectedParams,
+	}).Return(signature, nil)
+
+	res, err := client.Convert(ctx, cdcexchange.ConvertRequest{
+		FromInstrumentName:   "ETH.staked",
+		ToInstrumentName:     "CDCETH",
+		ExpectedRate:         expectedRate,
+		FromQuantity:         fromQuantity,
+		SlippageToleranceBps: 3,
+	})
+	require.NoError(t, err)
+
+	require.NotEmpty(t, res.Raw)
+	res.Raw = nil
+
+	assert.Equal(t, &cdcexchange.ConvertResult{
+		ConvertID:            1,
+		FromInstrumentName:   "ETH.staked",
+		ToInstrumentName:     "CDCETH",
+		ExpectedRate:         cdcexchange.MustParseDecimal("1.0203"),
+		FromQuantity:         cdcexchange.MustParseDecimal("3.14159265"),
+		SlippageToleranceBps: cdcexchange.MustParseDecimal("3"),
+		Reason:               "NO_ERROR",
+	}, res)
+}
@@ -0,0 +1,191 @@
+package cdcexchange
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/sngyai/go-cryptocom/v2/internal/api"
+	"github.com/sngyai/go-cryptocom/v2/internal/auth"
+)
+
+const (
+	methodGetDustConversionPreview = "private/get-dust-conversion-preview"
+	methodConvertDust              = "private/convert-dust"
+)
+
+type (
+	// GetDustConversionPreviewResponse is the base response returned from the private/get-dust-conversion-preview API.
+	GetDustConversionPreviewResponse struct {
+		// BaseResponse is the common response fields.
+		BaseResponse
+		// Result is the response attributes of the endpoint.
+		Result DustConversionPreview `json:"result"`
+	}
+
+	// DustConversionPreview is the result returned from the private/get-dust-conversion-preview API.
+	DustConversionPreview struct {
+		// DustList is the list of balances eligible for conversion.
+		DustList []DustBalance `json:"dust_list"`
+		// TotalEstimatedCROQuantity is the estimated quantity of CRO received for all eligible balances, after fees.
+		TotalEstimatedCROQuantity Decimal `json:"total_estimated_cro_quantity"`
+		// TotalEstimatedFee is the estimated fee in CRO for converting all eligible balances.
+		TotalEstimatedFee Decimal `json:"total_estimated_fee"`
+		// Raw is the raw JSON of the result, for fields not decoded by this package.
+		Raw json.RawMessage `json:"-"`
+	}
+
+	// DustBalance represents a small balance which is eligible to be converted to CRO.
+	DustBalance struct {
+		// Currency is the currency of the balance (e.g. DOGE).
+		Currency string `json:"currency"`
+		// Quantity is the quantity of the balance.
+		Quantity Decimal `json:"quantity"`
+		// EstimatedCROQuantity is the estimated quantity of CRO received for the balance, after fees.
+		EstimatedCROQuantity Decimal `json:"estimated_cro_quantity"`
+		// EstimatedFee is the estimated fee in CRO for converting the balance.
+		EstimatedFee Decimal `json:"estimated_fee"`
+	}
+
+	// ConvertDustResponse is the base response returned from the private/convert-dust API.
+	ConvertDustResponse struct {
+		// BaseResponse is the common response fields.
+		BaseResponse
+		// Result is the response attributes of the endpoint.
+		Result DustConversion `json:"result"`
+	}
+
+	// DustConversion is the result returned from the private/convert-dust API.
+	DustConversion struct {
+		// ConversionID is the unique identifier of the conversion.
+		ConversionID string `json:"conversion_id"`
+		// DustList is the list of balances which were converted.
+		DustList []ConvertedDust `json:"dust_list"`
+		// TotalCROQuantity is the total quantity of CRO received, after fees.
+		TotalCROQuantity Decimal `json:"total_cro_quantity"`
+		// TotalFee is the total fee in CRO charged for the conversion.
+		TotalFee Decimal `json:"total_fee"`
+		// ConversionTime is the time the conversion was made.
+		ConversionTime Timestamp `json:"conversion_time"`
+		// Raw is the raw JSON of the result, for fields not decoded by this package.
+		Raw json.RawMessage `json:"-"`
+	}
+
+	// ConvertedDust represents a small balance which was converted to CRO.
+	ConvertedDust struct {
+		// Currency is the currency of the balance (e.g. DOGE).
+		Currency string `json:"currency"`
+		// Quantity is the quantity of the balance which was converted.
+		Quantity Decimal `json:"quantity"`
+		// CROQuantity is the quantity of CRO received for the balance, after fees.
+		CROQuantity Decimal `json:"cro_quantity"`
+		// Fee is the fee in CRO charged for converting the balance.
+		Fee Decimal `json:"fee"`
+	}
+)
+
+// GetDustConversionPreview lists the small balances eligible to be converted to CRO, along with the estimated proceeds.
+//
+// No conversion is made, ConvertDust can be used to convert the balances.
+//
+// Method: private/get-dust-conversion-preview
+func (c *Client) GetDustConversionPreview(ctx context.Context) (*DustConversionPreview, error) {
+	var (
+		id        = c.idGenerator.Generate()
+		timestamp = c.now().UnixMilli()
+		params    = make(map[string]interface{})
+	)
+
+	creds, err := c.credentials(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	signature, err := c.signatureGenerator.GenerateSignature(auth.SignatureRequest{
+		APIKey:    creds.APIKey,
+		SecretKey: creds.SecretKey,
+		ID:        id,
+		Method:    methodGetDustConversionPreview,
+		Timestamp: timestamp,
+		Params:    params,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create signature: %w", err)
+	}
+
+	body := api.Request{
+		ID:        id,
+		Method:    methodGetDustConversionPreview,
+		Nonce:     timestamp,
+		Params:    params,
+		Signature: signature,
+		APIKey:    creds.APIKey,
+	}
+
+	var getDustConversionPreviewResponse GetDustConversionPreviewResponse
+	statusCode, err := c.requester.Post(ctx, body, methodGetDustConversionPreview, &getDustConversionPreviewResponse)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute post request: %w", err)
+	}
+
+	if err := c.requester.CheckResponse(statusCode, api.BaseResponse(getDustConversionPreviewResponse.BaseResponse)); err != nil {
+		return nil, fmt.Errorf("error received in response: %w", err)
+	}
+
+	getDustConversionPreviewResponse.Result.Raw = getDustConversionPreviewResponse.RawResult
+
+	return &getDustConversionPreviewResponse.Result, nil
+}
+
+// ConvertDust converts all small balances eligible for conversion to CRO.
+//
+// GetDustConversionPreview can be used beforehand to check the eligible balances and estimated proceeds.
+//
+// Method: private/convert-dust
+func (c *Client) ConvertDust(ctx context.Context) (*DustConversion, error) {
+	var (
+		id        = c.idGenerator.Generate()
+		timestamp = c.now().UnixMilli()
+		params    = make(map[string]interface{})
+	)
+
+	creds, err := c.credentials(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	signature, err := c.signatureGenerator.GenerateSignature(auth.SignatureRequest{
+		APIKey:    creds.APIKey,
+		SecretKey: creds.SecretKey,
+		ID:        id,
+		Method:    methodConvertDust,
+		Timestamp: timestamp,
+		Params:    params,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create signature: %w", err)
+	}
+
+	body := api.Request{
+		ID:        id,
+		Method:    methodConvertDust,
+		Nonce:     timestamp,
+		Params:    params,
+		Signature: signature,
+		APIKey:    creds.APIKey,
+	}
+
+	var convertDustResponse ConvertDustResponse
+	statusCode, err := c.requester.Post(ctx, body, methodConvertDust, &convertDustResponse)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute post request: %w", err)
+	}
+
+	if err := c.requester.CheckResponse(statusCode, api.BaseResponse(convertDustResponse.BaseResponse)); err != nil {
+		return nil, fmt.Errorf("error received in response: %w", err)
+	}
+
+	convertDustResponse.Result.Raw = convertDustResponse.RawResult
+
+	return &convertDustResponse.Result, nil
+}
@@ -0,0 +1,378 @@
+package cdcexchange_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/jonboulle/clockwork"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	cdcexchange "github.com/sngyai/go-cryptocom/v2"
+	cdcerrors "github.com/sngyai/go-cryptocom/v2/errors"
+	"github.com/sngyai/go-cryptocom/v2/internal/api"
+	"github.com/sngyai/go-cryptocom/v2/internal/auth"
+	id_mocks "github.com/sngyai/go-cryptocom/v2/internal/mocks/id"
+	signature_mocks "github.com/sngyai/go-cryptocom/v2/internal/mocks/signature"
+)
+
+func TestClient_GetDustConversionPreview_Error(t *testing.T) {
+	const (
+		apiKey    = "some api key"
+		secretKey = "some secret key"
+		id        = int64(1234)
+	)
+	testErr := errors.New("some error")
+
+	tests := []struct {
+		name         string
+		client       http.Client
+		signatureErr error
+		expectedErr  error
+	}{
+		{
+			name:         "returns error given error generating signature",
+			signatureErr: testErr,
+			expectedErr:  testErr,
+		},
+		{
+			name: "returns error given error making request",
+			client: http.Client{
+				Transport: roundTripper{
+					err: testErr,
+				},
+			},
+			expectedErr: testErr,
+		},
+		{
+			name: "returns error given error response",
+			client: http.Client{
+				Transport: roundTripper{
+					statusCode: http.StatusTeapot,
+					response: api.BaseResponse{
+						Code: "10002",
+					},
+				},
+			},
+			expectedErr: cdcerrors.ResponseError{
+				Code:           10002,
+				HTTPStatusCode: http.StatusTeapot,
+				Err:            cdcerrors.ErrUnauthorized,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl, ctx := gomock.WithContext(context.Background(), t)
+			t.Cleanup(ctrl.Finish)
+
+			var (
+				idGenerator        = id_mocks.NewMockIDGenerator(ctrl)
+				signatureGenerator = signature_mocks.NewMockSignatureGenerator(ctrl)
+				now                = time.Now()
+				clock              = clockwork.NewFakeClockAt(now)
+			)
+
+			client, err := cdcexchange.New(apiKey, secretKey,
+				cdcexchange.WithIDGenerator(idGenerator),
+				cdcexchange.WithClock(clock),
+				cdcexchange.WithHTTPClient(&tt.client),
+				cdcexchange.WithSignatureGenerator(signatureGenerator),
+			)
+			require.NoError(t, err)
+
+			idGenerator.EXPECT().Generate().Return(id)
+			signatureGenerator.EXPECT().GenerateSignature(auth.SignatureRequest{
+				APIKey:    apiKey,
+				SecretKey: secretKey,
+				ID:        id,
+				Method:    cdcexchange.MethodGetDustConversionPreview,
+				Timestamp: now.UnixMilli(),
+				Params:    map[string]interface{}{},
+			}).Return("signature", tt.signatureErr)
+
+			preview, err := client.GetDustConversionPreview(ctx)
+			require.Error(t, err)
+
+			assert.Empty(t, preview)
+			assert.True(t, errors.Is(err, tt.expectedErr))
+		})
+	}
+}
+
+func TestClient_GetDustConversionPreview_Success(t *testing.T) {
+	const (
+		apiKey    = "some api key"
+		secretKey = "some secret key"
+		id        = int64(1234)
+		signature = "some signature"
+	)
+	now := time.Now()
+
+	ctrl, ctx := gomock.WithContext(context.Background(), t)
+	t.Cleanup(ctrl.Finish)
+
+	var (
+		signatureGenerator = signature_mocks.NewMockSignatureGenerator(ctrl)
+		idGenerator        = id_mocks.NewMockIDGenerator(ctrl)
+		clock              = clockwork.NewFakeClockAt(now)
+	)
+
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Contains(t, r.URL.Path, cdcexchange.MethodGetDustConversionPreview)
+		t.Cleanup(func() { require.NoError(t, r.Body.Close()) })
+
+		var body api.Request
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+
+		assert.Equal(t, cdcexchange.MethodGetDustConversionPreview, body.Method)
+		assert.Equal(t, id, body.ID)
+		assert.Equal(t, apiKey, body.APIKey)
+		assert.Equal(t, now.UnixMilli(), body.Nonce)
+		assert.Equal(t, signature, body.Signature)
+
+		_, err := w.Write([]byte(`{
+			"id": 1234,
+			"method": "private/get-dust-conversion-preview",
+			"code": 0,
+			"result": {
+				"dust_list": [
+					{
+						"currency": "DOGE",
+						"quantity": "12.5",
+						"estimated_cro_quantity": "9.9",
+						"estimated_fee": "0.1"
+					}
+				],
+				"total_estimated_cro_quantity": "9.9",
+				"total_estimated_fee": "0.1"
+			}
+		}`))
+		require.NoError(t, err)
+	}))
+	t.Cleanup(s.Close)
+
+	client, err := cdcexchange.New(apiKey, secretKey,
+		cdcexchange.WithIDGenerator(idGenerator),
+		cdcexchange.WithClock(clock),
+		cdcexchange.WithHTTPClient(s.Client()),
+		cdcexchange.WithBaseURL(fmt.Sprintf("%s/", s.URL)),
+		cdcexchange.WithSignatureGenerator(signatureGenerator),
+	)
+	require.NoError(t, err)
+
+	idGenerator.EXPECT().Generate().Return(id)
+	signatureGenerator.EXPECT().GenerateSignature(auth.SignatureRequest{
+		APIKey:    apiKey,
+		SecretKey: secretKey,
+		ID:        id,
+		Method:    cdcexchange.MethodGetDustConversionPreview,
+		Timestamp: now.UnixMilli(),
+		Params:    map[string]interface{}{},
+	}).Return(signature, nil)
+
+	preview, err := client.GetDustConversionPreview(ctx)
+	require.NoError(t, err)
+
+	require.NotEmpty(t, preview.Raw)
+	preview.Raw = nil
+
+	assert.Equal(t, &cdcexchange.DustConversionPreview{
+		DustList: []cdcexchange.DustBalance{
+			{
+				Currency:             "DOGE",
+				Quantity:             cdcexchange.MustParseDecimal("12.5"),
+				EstimatedCROQuantity: cdcexchange.MustParseDecimal("9.9"),
+				EstimatedFee:         cdcexchange.MustParseDecimal("0.1"),
+			},
+		},
+		TotalEstimatedCROQuantity: cdcexchange.MustParseDecimal("9.9"),
+		TotalEstimatedFee:         cdcexchange.MustParseDecimal("0.1"),
+	}, preview)
+}
+
+func TestClient_ConvertDust_Error(t *testing.T) {
+	const (
+		apiKey    = "some api key"
+		secretKey = "some secret key"
+		id        = int64(1234)
+	)
+	testErr := errors.New("some error")
+
+	tests := []struct {
+		name         string
+		client       http.Client
+		signatureErr error
+		expectedErr  error
+	}{
+		{
+			name:         "returns error given error generating signature",
+			signatureErr: testErr,
+			expectedErr:  testErr,
+		},
+		{
+			name: "returns error given error making request",
+			client: http.Client{
+				Transport: roundTripper{
+					err: testErr,
+				},
+			},
+			expectedErr: testErr,
+		},
+		{
+			name: "returns error given error response",
+			client: http.Client{
+				Transport: roundTripper{
+					statusCode: http.StatusTeapot,
+					response: api.BaseResponse{
+						Code: "10002",
+					},
+				},
+			},
+			expectedErr: cdcerrors.ResponseError{
+				Code:           10002,
+				HTTPStatusCode: http.StatusTeapot,
+				Err:            cdcerrors.ErrUnauthorized,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl, ctx := gomock.WithContext(context.Background(), t)
+			t.Cleanup(ctrl.Finish)
+
+			var (
+				idGenerator        = id_mocks.NewMockIDGenerator(ctrl)
+				signatureGenerator = signature_mocks.NewMockSignatureGenerator(ctrl)
+				now                = time.Now()
+				clock              = clockwork.NewFakeClockAt(now)
+			)
+
+			client, err := cdcexchange.New(apiKey, secretKey,
+				cdcexchange.WithIDGenerator(idGenerator),
+				cdcexchange.WithClock(clock),
+				cdcexchange.WithHTTPClient(&tt.client),
+				cdcexchange.WithSignatureGenerator(signatureGenerator),
+			)
+			require.NoError(t, err)
+
+			idGenerator.EXPECT().Generate().Return(id)
+			signatureGenerator.EXPECT().GenerateSignature(auth.SignatureRequest{
+				APIKey:    apiKey,
+				SecretKey: secretKey,
+				ID:        id,
+				Method:    cdcexchange.MethodConvertDust,
+				Timestamp: now.UnixMilli(),
+				Params:    map[string]interface{}{},
+			}).Return("signature", tt.signatureErr)
+
+			conversion, err := client.ConvertDust(ctx)
+			require.Error(t, err)
+
+			assert.Empty(t, conversion)
+			assert.True(t, errors.Is(err, tt.expectedErr))
+		})
+	}
+}
+
+func TestClient_ConvertDust_Success(t *testing.T) {
+	const (
+		apiKey    = "some api key"
+		secretKey = "some secret key"
+		id        = int64(1234)
+		signature = "some signature"
+	)
+	now := time.Now()
+
+	ctrl, ctx := gomock.WithContext(context.Background(), t)
+	t.Cleanup(ctrl.Finish)
+
+	var (
+		signatureGenerator = signature_mocks.NewMockSignatureGenerator(ctrl)
+		idGenerator        = id_mocks.NewMockIDGenerator(ctrl)
+		clock              = clockwork.NewFakeClockAt(now)
+	)
+
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Contains(t, r.URL.Path, cdcexchange.MethodConvertDust)
+		t.Cleanup(func() { require.NoError(t, r.Body.Close()) })
+
+		var body api.Request
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+
+		assert.Equal(t, cdcexchange.MethodConvertDust, body.Method)
+		assert.Equal(t, id, body.ID)
+		assert.Equal(t, apiKey, body.APIKey)
+		assert.Equal(t, now.UnixMilli(), body.Nonce)
+		assert.Equal(t, signature, body.Signature)
+
+		_, err := w.Write([]byte(`{
+			"id": 1234,
+			"method": "private/convert-dust",
+			"code": 0,
+			"result": {
+				"conversion_id": "1234567890",
+				"dust_list": [
+					{
+						"currency": "DOGE",
+						"quantity": "12.5",
+						"cro_quantity": "9.9",
+						"fee": "0.1"
+					}
+				],
+				"total_cro_quantity": "9.9",
+				"total_fee": "0.1",
+				"conversion_time": 1620962543792
+			}
+		}`))
+		require.NoError(t, err)
+	}))
+	t.Cleanup(s.Close)
+
+	client, err := cdcexchange.New(apiKey, secretKey,
+		cdcexchange.WithIDGenerator(idGenerator),
+		cdcexchange.WithClock(clock),
+		cdcexchange.WithHTTPClient(s.Client()),
+		cdcexchange.WithBaseURL(fmt.Sprintf("%s/", s.URL)),
+		cdcexchange.WithSignatureGenerator(signatureGenerator),
+	)
+	require.NoError(t, err)
+
+	idGenerator.EXPECT().Generate().Return(id)
+	signatureGenerator.EXPECT().GenerateSignature(auth.SignatureRequest{
+		APIKey:    apiKey,
+		SecretKey: secretKey,
+		ID:        id,
+		Method:    cdcexchange.MethodConvertDust,
+		Timestamp: now.UnixMilli(),
+		Params:    map[string]interface{}{},
+	}).Return(signature, nil)
+
+	conversion, err := client.ConvertDust(ctx)
+	require.NoError(t, err)
+
+	require.NotEmpty(t, conversion.Raw)
+	conversion.Raw = nil
+
+	assert.Equal(t, &cdcexchange.DustConversion{
+		ConversionID: "1234567890",
+		DustList: []cdcexchange.ConvertedDust{
+			{
+				Currency:    "DOGE",
+				Quantity:    cdcexchange.MustParseDecimal("12.5"),
+				CROQuantity: cdcexchange.MustParseDecimal("9.9"),
+				Fee:         cdcexchange.MustParseDecimal("0.1"),
+			},
+		},
+		TotalCROQuantity: cdcexchange.MustParseDecimal("9.9"),
+		TotalFee:         cdcexchange.MustParseDecimal("0.1"),
+		ConversionTime:   cdcexchange.Timestamp(time.UnixMilli(1620962543792)),
+	}, conversion)
+}
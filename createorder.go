@@ -2,15 +2,20 @@ package cdcexchange
 
 import (
 	"context"
+	"encoding/json"
+	stderrors "errors"
 	"fmt"
 
-	"github.com/sngyai/go-cryptocom/internal/api"
-	"github.com/sngyai/go-cryptocom/internal/auth"
+	"github.com/sngyai/go-cryptocom/v2/errors"
+	"github.com/sngyai/go-cryptocom/v2/internal/api"
+	"github.com/sngyai/go-cryptocom/v2/internal/auth"
 )
 
 const (
 	methodCreateOrder = "private/create-order"
 
+	maxClientOIDLength = 36
+
 	OrderSideBuy  OrderSide = "BUY"
 	OrderSideSell OrderSide = "SELL"
 
@@ -25,7 +30,11 @@ const (
 	TimeInForceFillOrKill        TimeInForce = "FILL_OR_KILL"
 	TimeInForceImmediateOrCancel TimeInForce = "IMMEDIATE_OR_CANCEL"
 
-	ExecInstPostOnly ExecInst = "POST_ONLY"
+	ExecInstPostOnly      ExecInst = "POST_ONLY"
+	ExecInstSmartPostOnly ExecInst = "SMART_POST_ONLY"
+
+	SpotMarginSpot   SpotMargin = "SPOT"
+	SpotMarginMargin SpotMargin = "MARGIN"
 )
 
 type (
@@ -35,8 +44,10 @@ type (
 	OrderType string
 	// TimeInForce represents how long the order should be active before being cancelled.
 	TimeInForce string
-	// ExecInst for Limit Orders Only (POST_ONLY or left blank).
+	// ExecInst for Limit Orders Only (POST_ONLY, SMART_POST_ONLY or left blank).
 	ExecInst string
+	// SpotMargin represents whether an order is placed against the spot or the margin account (SPOT/MARGIN).
+	SpotMargin string
 
 	// CreateOrderRequest is the request params sent for the private/create-order API.
 	// Mandatory parameters based on order type:
@@ -62,14 +73,16 @@ type (
 		Type OrderType `json:"type"`
 		// Price determines the price of which the trade should be executed.
 		// For LIMIT and STOP_LIMIT orders only.
-		Price float64 `json:"price"`
+		Price Decimal `json:"price"`
 		// Quantity is the quantity to be sold
 		// For LIMIT, MARKET, STOP_LOSS, TAKE_PROFIT orders only.
-		Quantity float64 `json:"quantity"`
+		Quantity Decimal `json:"quantity"`
 		// Notional is the amount to spend.
 		// For MARKET (BUY), STOP_LOSS (BUY), TAKE_PROFIT (BUY) orders only.
-		Notional float64 `json:"notional"`
-		// ClientOID is the optional Client order ID.
+		Notional Decimal `json:"notional"`
+		// ClientOID is the optional Client order ID (Max: 36 characters).
+		// It can be used to look the order up with GetOrderDetailByClientOID or GetOpenOrdersRequest.ClientOID.
+		// A UUID is generated if left blank and WithClientOIDGeneration is used.
 		ClientOID string `json:"client_oid"`
 		// TimeInForce represents how long the order should be active before being cancelled.
 		// (Limit Orders Only) Options are:
@@ -78,18 +91,29 @@ type (
 		//  - IMMEDIATE_OR_CANCEL
 		TimeInForce TimeInForce `json:"time_in_force"`
 		// (Limit Orders Only) Options are:
-		// - POST_ONLY
+		// - POST_ONLY: the order is rejected if it would immediately match.
+		// - SMART_POST_ONLY: the order price is adjusted so that it does not immediately match.
 		// - Or leave empty
+		// Maker-only orders cannot be combined with FILL_OR_KILL or IMMEDIATE_OR_CANCEL.
 		ExecInst ExecInst `json:"exec_inst"`
 		// TriggerPrice is the price at which the order is triggered.
 		// Used with STOP_LOSS, STOP_LIMIT, TAKE_PROFIT, and TAKE_PROFIT_LIMIT orders.
-		TriggerPrice float64 `json:"trigger_price"`
+		TriggerPrice Decimal `json:"trigger_price"`
+		// RefPrice is the v1 reference price at which a trigger order is triggered.
+		// Used with STOP_LOSS, STOP_LIMIT, TAKE_PROFIT, and TAKE_PROFIT_LIMIT orders.
+		RefPrice Decimal `json:"ref_price"`
+		// SpotMargin represents whether the order is placed against the spot or the margin account.
+		// (Default: SPOT)
+		SpotMargin SpotMargin `json:"spot_margin"`
+		// STPInst overrides the account self-trade prevention instruction for this order.
+		// (Default: the instruction configured with SetSTPSettings)
+		STPInst STPInst `json:"stp_inst"`
 	}
 
 	// CreateOrderResponse is the base response returned from the private/create-order API.
 	CreateOrderResponse struct {
-		// api.BaseResponse is the common response fields.
-		api.BaseResponse
+		// BaseResponse is the common response fields.
+		BaseResponse
 		// Result is the response attributes of the endpoint.
 		Result CreateOrderResult `json:"result"`
 	}
@@ -98,25 +122,160 @@ type (
 	CreateOrderResult struct {
 		// OrderID is the newly created order ID.
 		OrderID string `json:"order_id"`
-		// ClientOID is the optional Client order ID (if provided in request).
+		// ClientOID is the optional Client order ID (if provided in request, or generated by WithClientOIDGeneration).
 		ClientOID string `json:"client_oid"`
+		// Raw is the raw JSON of the result, for fields not decoded by this package.
+		Raw json.RawMessage `json:"-"`
 	}
 )
 
+// IsValid returns whether s is BUY or SELL.
+func (s OrderSide) IsValid() bool {
+	switch s {
+	case OrderSideBuy, OrderSideSell:
+		return true
+	default:
+		return false
+	}
+}
+
+// IsValid returns whether t is a known order type.
+func (t OrderType) IsValid() bool {
+	switch t {
+	case OrderTypeLimit, OrderTypeMarket, OrderTypeStopLoss, OrderTypeStopLimit, OrderTypeTakeProfit, OrderTypeTakeProfitLimit:
+		return true
+	default:
+		return false
+	}
+}
+
+// IsLimit returns whether t is a limit order type (LIMIT, STOP_LIMIT or TAKE_PROFIT_LIMIT).
+func (t OrderType) IsLimit() bool {
+	switch t {
+	case OrderTypeLimit, OrderTypeStopLimit, OrderTypeTakeProfitLimit:
+		return true
+	default:
+		return false
+	}
+}
+
+// IsValid returns whether t is GOOD_TILL_CANCEL, FILL_OR_KILL or IMMEDIATE_OR_CANCEL.
+func (t TimeInForce) IsValid() bool {
+	switch t {
+	case TimeInForceGoodTilCancelled, TimeInForceFillOrKill, TimeInForceImmediateOrCancel:
+		return true
+	default:
+		return false
+	}
+}
+
+// IsValid returns whether e is POST_ONLY or SMART_POST_ONLY.
+func (e ExecInst) IsValid() bool {
+	switch e {
+	case ExecInstPostOnly, ExecInstSmartPostOnly:
+		return true
+	default:
+		return false
+	}
+}
+
 // CreateOrder creates a new BUY or SELL order on the Exchange.
 //
 // This call is asynchronous, so the response is simply a confirmation of the request.
 //
 // The user.order subscription can be used to check when the order is successfully created.
 //
+// If an order with a client oid was sent but no response was received, errors.UnconfirmedOrderError is returned
+// (see WithClientOIDGeneration). Errors returned before the order is sent (e.g. errors.ErrReadOnlyClient) are not wrapped.
+//
 // Method: private/create-order
 func (c *Client) CreateOrder(ctx context.Context, req CreateOrderRequest) (*CreateOrderResult, error) {
+	if err := validateExecutionOptions(req); err != nil {
+		return nil, err
+	}
+
+	if c.orderValidation {
+		instrument, ok, err := c.instrument(ctx, req.InstrumentName)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get instrument to validate order: %w", err)
+		}
+		if !ok {
+			return nil, errors.InvalidParameterError{Parameter: "req.InstrumentName", Reason: fmt.Sprintf("%q is not a known instrument", req.InstrumentName)}
+		}
+
+		if err := validateOrderInstrument(req, instrument); err != nil {
+			return nil, err
+		}
+	}
+
+	if req.ClientOID == "" && c.clientOIDGenerator != nil {
+		clientOID, err := c.clientOIDGenerator()
+		if err != nil {
+			return nil, fmt.Errorf("failed to generate client oid: %w", err)
+		}
+		req.ClientOID = clientOID
+	}
+
 	var (
 		id        = c.idGenerator.Generate()
-		timestamp = c.clock.Now().UnixMilli()
-		params    = make(map[string]interface{})
+		timestamp = c.now().UnixMilli()
+		params    = createOrderParams(req)
 	)
 
+	creds, err := c.credentials(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	signature, err := c.signatureGenerator.GenerateSignature(auth.SignatureRequest{
+		APIKey:    creds.APIKey,
+		SecretKey: creds.SecretKey,
+		ID:        id,
+		Method:    methodCreateOrder,
+		Timestamp: timestamp,
+		Params:    params,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create signature: %w", err)
+	}
+
+	body := api.Request{
+		ID:        id,
+		Method:    methodCreateOrder,
+		Nonce:     timestamp,
+		Params:    params,
+		Signature: signature,
+		APIKey:    creds.APIKey,
+	}
+
+	var createOrderResponse CreateOrderResponse
+	statusCode, err := c.requester.Post(ctx, body, methodCreateOrder, &createOrderResponse)
+	if err != nil {
+		// only an order which was sent without a response being received may have been created.
+		var sentErr api.SentRequestError
+		if req.ClientOID != "" && stderrors.As(err, &sentErr) {
+			err = errors.UnconfirmedOrderError{ClientOID: req.ClientOID, Err: err}
+		}
+		return nil, fmt.Errorf("failed to execute post request: %w", err)
+	}
+
+	if err := c.requester.CheckResponse(statusCode, api.BaseResponse(createOrderResponse.BaseResponse)); err != nil {
+		return nil, fmt.Errorf("error received in response: %w", err)
+	}
+
+	if createOrderResponse.Result.ClientOID == "" {
+		createOrderResponse.Result.ClientOID = req.ClientOID
+	}
+
+	createOrderResponse.Result.Raw = createOrderResponse.RawResult
+
+	return &createOrderResponse.Result, nil
+}
+
+// createOrderParams returns the params of the order req, omitting empty values.
+func createOrderParams(req CreateOrderRequest) map[string]interface{} {
+	params := make(map[string]interface{})
+
 	if req.InstrumentName != "" {
 		params["instrument_name"] = req.InstrumentName
 	}
@@ -126,13 +285,13 @@ func (c *Client) CreateOrder(ctx context.Context, req CreateOrderRequest) (*Crea
 	if req.Type != "" {
 		params["type"] = req.Type
 	}
-	if req.Price != 0 {
+	if !req.Price.IsZero() {
 		params["price"] = req.Price
 	}
-	if req.Quantity != 0 {
+	if !req.Quantity.IsZero() {
 		params["quantity"] = req.Quantity
 	}
-	if req.Notional != 0 {
+	if !req.Notional.IsZero() {
 		params["notional"] = req.Notional
 	}
 	if req.ClientOID != "" {
@@ -144,40 +303,127 @@ func (c *Client) CreateOrder(ctx context.Context, req CreateOrderRequest) (*Crea
 	if req.ExecInst != "" {
 		params["exec_inst"] = req.ExecInst
 	}
-	if req.TriggerPrice != 0 {
+	if !req.TriggerPrice.IsZero() {
 		params["trigger_price"] = req.TriggerPrice
 	}
+	if !req.RefPrice.IsZero() {
+		params["ref_price"] = req.RefPrice
+	}
+	if req.SpotMargin != "" {
+		params["spot_margin"] = req.SpotMargin
+	}
+	if req.STPInst != "" {
+		params["stp_inst"] = req.STPInst
+	}
 
-	signature, err := c.signatureGenerator.GenerateSignature(auth.SignatureRequest{
-		APIKey:    c.apiKey,
-		SecretKey: c.secretKey,
-		ID:        id,
-		Method:    methodCreateOrder,
-		Timestamp: timestamp,
-		Params:    params,
-	})
-	if err != nil {
-		return nil, fmt.Errorf("failed to create signature: %w", err)
+	return params
+}
+
+// validateExecutionOptions validates the side, type, time in force, exec inst, client oid, ref price, spot margin & stp inst of a CreateOrderRequest.
+//
+// Empty values are left for the Exchange to default or reject.
+func validateExecutionOptions(req CreateOrderRequest) error {
+	if req.Side != "" && !req.Side.IsValid() {
+		return errors.InvalidParameterError{Parameter: "req.Side", Reason: "must be BUY or SELL"}
 	}
 
-	body := api.Request{
-		ID:        id,
-		Method:    methodCreateOrder,
-		Nonce:     timestamp,
-		Params:    params,
-		Signature: signature,
-		APIKey:    c.apiKey,
+	if req.Type != "" && !req.Type.IsValid() {
+		return errors.InvalidParameterError{Parameter: "req.Type", Reason: "must be LIMIT, MARKET, STOP_LOSS, STOP_LIMIT, TAKE_PROFIT or TAKE_PROFIT_LIMIT"}
 	}
 
-	var createOrderResponse CreateOrderResponse
-	statusCode, err := c.requester.Post(ctx, body, methodCreateOrder, &createOrderResponse)
-	if err != nil {
-		return nil, fmt.Errorf("failed to execute post request: %w", err)
+	if req.TimeInForce != "" && !req.TimeInForce.IsValid() {
+		return errors.InvalidParameterError{Parameter: "req.TimeInForce", Reason: "must be GOOD_TILL_CANCEL, FILL_OR_KILL or IMMEDIATE_OR_CANCEL"}
 	}
 
-	if err := c.requester.CheckErrorResponse(statusCode, createOrderResponse.Code); err != nil {
-		return nil, fmt.Errorf("error received in response: %w", err)
+	if req.ExecInst != "" && !req.ExecInst.IsValid() {
+		return errors.InvalidParameterError{Parameter: "req.ExecInst", Reason: "must be POST_ONLY or SMART_POST_ONLY"}
 	}
 
-	return &createOrderResponse.Result, nil
+	switch req.SpotMargin {
+	case "", SpotMarginSpot, SpotMarginMargin:
+	default:
+		return errors.InvalidParameterError{Parameter: "req.SpotMargin", Reason: "must be SPOT or MARGIN"}
+	}
+
+	switch req.STPInst {
+	case "", STPInstCancelMaker, STPInstCancelTaker, STPInstCancelBoth:
+	default:
+		return errors.InvalidParameterError{Parameter: "req.STPInst", Reason: "must be M, T or B"}
+	}
+
+	if (req.TimeInForce != "" || req.ExecInst != "") && req.Type != "" && !req.Type.IsLimit() {
+		return errors.InvalidParameterError{Parameter: "req.Type", Reason: "must be a limit order type when req.TimeInForce or req.ExecInst is provided"}
+	}
+
+	if req.ExecInst != "" && req.TimeInForce != "" && req.TimeInForce != TimeInForceGoodTilCancelled {
+		return errors.InvalidParameterError{Parameter: "req.TimeInForce", Reason: "must be GOOD_TILL_CANCEL when req.ExecInst is provided"}
+	}
+
+	if len(req.ClientOID) > maxClientOIDLength {
+		return errors.InvalidParameterError{Parameter: "req.ClientOID", Reason: "cannot be longer than 36 characters"}
+	}
+
+	if req.RefPrice.Sign() < 0 {
+		return errors.InvalidParameterError{Parameter: "req.RefPrice", Reason: "cannot be less than 0"}
+	}
+
+	return nil
+}
+
+// validateOrderInstrument validates the prices and quantity of a CreateOrderRequest against the metadata of its instrument.
+//
+// Checks are skipped for tick sizes which are unknown, in which case the number of decimal places is checked instead.
+func validateOrderInstrument(req CreateOrderRequest, instrument Instrument) error {
+	if !instrument.Tradable {
+		return errors.InvalidParameterError{Parameter: "req.InstrumentName", Reason: fmt.Sprintf("%s is not tradable", instrument.Symbol)}
+	}
+
+	for _, price := range []struct {
+		parameter string
+		value     Decimal
+	}{
+		{parameter: "req.Price", value: req.Price},
+		{parameter: "req.TriggerPrice", value: req.TriggerPrice},
+		{parameter: "req.RefPrice", value: req.RefPrice},
+	} {
+		if price.value.IsZero() {
+			continue
+		}
+		if !isMultiple(price.value, instrument.PriceTickSize) {
+			return errors.InvalidParameterError{
+				Parameter: price.parameter,
+				Reason:    fmt.Sprintf("must be a multiple of the price tick size %s of %s", instrument.PriceTickSize, instrument.Symbol),
+			}
+		}
+		if instrument.PriceTickSize.IsZero() && instrument.QuoteDecimals > 0 && price.value.decimalPlaces() > instrument.QuoteDecimals {
+			return errors.InvalidParameterError{
+				Parameter: price.parameter,
+				Reason:    fmt.Sprintf("cannot have more than %d decimal places for %s", instrument.QuoteDecimals, instrument.Symbol),
+			}
+		}
+	}
+
+	if req.Quantity.IsZero() {
+		return nil
+	}
+	if req.Quantity.Cmp(instrument.QtyTickSize) < 0 {
+		return errors.InvalidParameterError{
+			Parameter: "req.Quantity",
+			Reason:    fmt.Sprintf("cannot be less than the minimum quantity %s of %s", instrument.QtyTickSize, instrument.Symbol),
+		}
+	}
+	if !isMultiple(req.Quantity, instrument.QtyTickSize) {
+		return errors.InvalidParameterError{
+			Parameter: "req.Quantity",
+			Reason:    fmt.Sprintf("must be a multiple of the quantity tick size %s of %s", instrument.QtyTickSize, instrument.Symbol),
+		}
+	}
+	if instrument.QtyTickSize.IsZero() && instrument.QuantityDecimals > 0 && req.Quantity.decimalPlaces() > instrument.QuantityDecimals {
+		return errors.InvalidParameterError{
+			Parameter: "req.Quantity",
+			Reason:    fmt.Sprintf("cannot have more than %d decimal places for %s", instrument.QuantityDecimals, instrument.Symbol),
+		}
+	}
+
+	return nil
 }
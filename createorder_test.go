@@ -7,6 +7,8 @@ import (
 	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"regexp"
+	"strings"
 	"testing"
 	"time"
 
@@ -15,12 +17,12 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
-	"github.com/sngyai/go-cryptocom/internal/api"
-	"github.com/sngyai/go-cryptocom/internal/auth"
-	cdcexchange "github.com/sngyai/go-cryptocom"
-	cdcerrors "github.com/sngyai/go-cryptocom/errors"
-	id_mocks "github.com/sngyai/go-cryptocom/internal/mocks/id"
-	signature_mocks "github.com/sngyai/go-cryptocom/internal/mocks/signature"
+	cdcexchange "github.com/sngyai/go-cryptocom/v2"
+	cdcerrors "github.com/sngyai/go-cryptocom/v2/errors"
+	"github.com/sngyai/go-cryptocom/v2/internal/api"
+	"github.com/sngyai/go-cryptocom/v2/internal/auth"
+	id_mocks "github.com/sngyai/go-cryptocom/v2/internal/mocks/id"
+	signature_mocks "github.com/sngyai/go-cryptocom/v2/internal/mocks/signature"
 )
 
 func TestClient_CreateOrder_Error(t *testing.T) {
@@ -33,11 +35,64 @@ func TestClient_CreateOrder_Error(t *testing.T) {
 
 	tests := []struct {
 		name         string
+		req          cdcexchange.CreateOrderRequest
 		client       http.Client
 		signatureErr error
 		responseErr  error
 		expectedErr  error
 	}{
+		{
+			name:        "returns error when side is invalid",
+			req:         cdcexchange.CreateOrderRequest{Side: "buy"},
+			expectedErr: cdcerrors.InvalidParameterError{Parameter: "req.Side", Reason: "must be BUY or SELL"},
+		},
+		{
+			name:        "returns error when type is invalid",
+			req:         cdcexchange.CreateOrderRequest{Type: "STOP_MARKET"},
+			expectedErr: cdcerrors.InvalidParameterError{Parameter: "req.Type", Reason: "must be LIMIT, MARKET, STOP_LOSS, STOP_LIMIT, TAKE_PROFIT or TAKE_PROFIT_LIMIT"},
+		},
+		{
+			name:        "returns error when time in force is invalid",
+			req:         cdcexchange.CreateOrderRequest{TimeInForce: "GOOD_TILL_DATE"},
+			expectedErr: cdcerrors.InvalidParameterError{Parameter: "req.TimeInForce", Reason: "must be GOOD_TILL_CANCEL, FILL_OR_KILL or IMMEDIATE_OR_CANCEL"},
+		},
+		{
+			name:        "returns error when exec inst is invalid",
+			req:         cdcexchange.CreateOrderRequest{ExecInst: "REDUCE_ONLY"},
+			expectedErr: cdcerrors.InvalidParameterError{Parameter: "req.ExecInst", Reason: "must be POST_ONLY or SMART_POST_ONLY"},
+		},
+		{
+			name:        "returns error when spot margin is invalid",
+			req:         cdcexchange.CreateOrderRequest{SpotMargin: "DERIVATIVES"},
+			expectedErr: cdcerrors.InvalidParameterError{Parameter: "req.SpotMargin", Reason: "must be SPOT or MARGIN"},
+		},
+		{
+			name: "returns error when exec inst is provided for a market order",
+			req: cdcexchange.CreateOrderRequest{
+				Type:     cdcexchange.OrderTypeMarket,
+				ExecInst: cdcexchange.ExecInstPostOnly,
+			},
+			expectedErr: cdcerrors.InvalidParameterError{Parameter: "req.Type", Reason: "must be a limit order type when req.TimeInForce or req.ExecInst is provided"},
+		},
+		{
+			name: "returns error when smart post only is combined with immediate or cancel",
+			req: cdcexchange.CreateOrderRequest{
+				Type:        cdcexchange.OrderTypeLimit,
+				TimeInForce: cdcexchange.TimeInForceImmediateOrCancel,
+				ExecInst:    cdcexchange.ExecInstSmartPostOnly,
+			},
+			expectedErr: cdcerrors.InvalidParameterError{Parameter: "req.TimeInForce", Reason: "must be GOOD_TILL_CANCEL when req.ExecInst is provided"},
+		},
+		{
+			name:        "returns error when stp inst is invalid",
+			req:         cdcexchange.CreateOrderRequest{STPInst: "X"},
+			expectedErr: cdcerrors.InvalidParameterError{Parameter: "req.STPInst", Reason: "must be M, T or B"},
+		},
+		{
+			name:        "returns error when ref price is less than 0",
+			req:         cdcexchange.CreateOrderRequest{RefPrice: cdcexchange.MustParseDecimal("-1")},
+			expectedErr: cdcerrors.InvalidParameterError{Parameter: "req.RefPrice", Reason: "cannot be less than 0"},
+		},
 		{
 			name:         "returns error given error generating signature",
 			signatureErr: testErr,
@@ -90,17 +145,20 @@ func TestClient_CreateOrder_Error(t *testing.T) {
 			)
 			require.NoError(t, err)
 
-			idGenerator.EXPECT().Generate().Return(id)
-			signatureGenerator.EXPECT().GenerateSignature(auth.SignatureRequest{
-				APIKey:    apiKey,
-				SecretKey: secretKey,
-				ID:        id,
-				Method:    cdcexchange.MethodCreateOrder,
-				Timestamp: now.UnixMilli(),
-				Params:    map[string]interface{}{},
-			}).Return("signature", tt.signatureErr)
+			var invalidParameterError cdcerrors.InvalidParameterError
+			if !errors.As(tt.expectedErr, &invalidParameterError) {
+				idGenerator.EXPECT().Generate().Return(id)
+				signatureGenerator.EXPECT().GenerateSignature(auth.SignatureRequest{
+					APIKey:    apiKey,
+					SecretKey: secretKey,
+					ID:        id,
+					Method:    cdcexchange.MethodCreateOrder,
+					Timestamp: now.UnixMilli(),
+					Params:    map[string]interface{}{},
+				}).Return("signature", tt.signatureErr)
+			}
 
-			res, err := client.CreateOrder(ctx, cdcexchange.CreateOrderRequest{})
+			res, err := client.CreateOrder(ctx, tt.req)
 			require.Error(t, err)
 
 			assert.Empty(t, res)
@@ -122,6 +180,26 @@ func TestClient_CreateOrder_Error(t *testing.T) {
 	}
 }
 
+func TestOrderEnums_IsValid(t *testing.T) {
+	assert.True(t, cdcexchange.OrderSideBuy.IsValid())
+	assert.True(t, cdcexchange.OrderSideSell.IsValid())
+	assert.False(t, cdcexchange.OrderSide("buy").IsValid())
+
+	assert.True(t, cdcexchange.OrderTypeTakeProfitLimit.IsValid())
+	assert.False(t, cdcexchange.OrderType("").IsValid())
+	assert.True(t, cdcexchange.OrderTypeStopLimit.IsLimit())
+	assert.False(t, cdcexchange.OrderTypeMarket.IsLimit())
+
+	assert.True(t, cdcexchange.TimeInForceFillOrKill.IsValid())
+	assert.False(t, cdcexchange.TimeInForce("GOOD_TILL_DATE").IsValid())
+
+	assert.True(t, cdcexchange.ExecInstSmartPostOnly.IsValid())
+	assert.False(t, cdcexchange.ExecInst("REDUCE_ONLY").IsValid())
+
+	assert.True(t, cdcexchange.OrderStatusPending.IsValid())
+	assert.False(t, cdcexchange.OrderStatus("CANCELLED").IsValid())
+}
+
 func TestClient_CreateOrder_Success(t *testing.T) {
 	const (
 		apiKey    = "some api key"
@@ -129,19 +207,24 @@ func TestClient_CreateOrder_Success(t *testing.T) {
 		id        = int64(1234)
 		signature = "some signature"
 
-		instrument   = "some instrument"
-		orderSide    = cdcexchange.OrderSideBuy
-		orderType    = cdcexchange.OrderTypeMarket
-		price        = 1.234
-		quantity     = 5.678
-		notional     = 9.012
-		clientOID    = "some Client oid"
-		timeInForce  = cdcexchange.TimeInForceGoodTilCancelled
-		execInst     = cdcexchange.ExecInstPostOnly
-		triggerPrice = 3.456
+		instrument  = "some instrument"
+		orderSide   = cdcexchange.OrderSideBuy
+		orderType   = cdcexchange.OrderTypeLimit
+		clientOID   = "some Client oid"
+		timeInForce = cdcexchange.TimeInForceGoodTilCancelled
+		execInst    = cdcexchange.ExecInstPostOnly
+		spotMargin  = cdcexchange.SpotMarginMargin
+		stpInst     = cdcexchange.STPInstCancelMaker
 
 		orderID = "5678"
 	)
+	var (
+		notional     = cdcexchange.MustParseDecimal("9.012")
+		price        = cdcexchange.MustParseDecimal("1.234")
+		quantity     = cdcexchange.MustParseDecimal("5.678")
+		refPrice     = cdcexchange.MustParseDecimal("2.345")
+		triggerPrice = cdcexchange.MustParseDecimal("3.456")
+	)
 	now := time.Now()
 
 	type args struct {
@@ -168,6 +251,9 @@ func TestClient_CreateOrder_Success(t *testing.T) {
 					TimeInForce:    timeInForce,
 					ExecInst:       execInst,
 					TriggerPrice:   triggerPrice,
+					RefPrice:       refPrice,
+					SpotMargin:     spotMargin,
+					STPInst:        stpInst,
 				},
 			},
 			handlerFunc: func(w http.ResponseWriter, r *http.Request) {
@@ -185,16 +271,19 @@ func TestClient_CreateOrder_Success(t *testing.T) {
 				assert.Equal(t, instrument, body.Params["instrument_name"])
 				assert.Equal(t, string(orderSide), body.Params["side"])
 				assert.Equal(t, string(orderType), body.Params["type"])
-				assert.Equal(t, price, body.Params["price"])
-				assert.Equal(t, quantity, body.Params["quantity"])
-				assert.Equal(t, notional, body.Params["notional"])
+				assert.Equal(t, price.String(), body.Params["price"])
+				assert.Equal(t, quantity.String(), body.Params["quantity"])
+				assert.Equal(t, notional.String(), body.Params["notional"])
 				assert.Equal(t, clientOID, body.Params["client_oid"])
 				assert.Equal(t, string(timeInForce), body.Params["time_in_force"])
 				assert.Equal(t, string(execInst), body.Params["exec_inst"])
-				assert.Equal(t, triggerPrice, body.Params["trigger_price"])
+				assert.Equal(t, triggerPrice.String(), body.Params["trigger_price"])
+				assert.Equal(t, refPrice.String(), body.Params["ref_price"])
+				assert.Equal(t, string(spotMargin), body.Params["spot_margin"])
+				assert.Equal(t, string(stpInst), body.Params["stp_inst"])
 
 				res := cdcexchange.CreateOrderResponse{
-					BaseResponse: api.BaseResponse{},
+					BaseResponse: cdcexchange.BaseResponse{},
 					Result: cdcexchange.CreateOrderResult{
 						ClientOID: clientOID,
 						OrderID:   orderID,
@@ -214,6 +303,9 @@ func TestClient_CreateOrder_Success(t *testing.T) {
 				"time_in_force":   timeInForce,
 				"exec_inst":       execInst,
 				"trigger_price":   triggerPrice,
+				"ref_price":       refPrice,
+				"spot_margin":     spotMargin,
+				"stp_inst":        stpInst,
 			},
 			expectedResult: cdcexchange.CreateOrderResult{
 				ClientOID: clientOID,
@@ -257,7 +349,246 @@ func TestClient_CreateOrder_Success(t *testing.T) {
 			res, err := client.CreateOrder(ctx, tt.req)
 			require.NoError(t, err)
 
+			require.NotEmpty(t, res.Raw)
+			res.Raw = nil
+
 			assert.Equal(t, tt.expectedResult, *res)
 		})
 	}
 }
+
+func TestClient_CreateOrder_ClientOIDGeneration(t *testing.T) {
+	uuidPattern := regexp.MustCompile(`^[0-9a-f]{8}-[0-9a-f]{4}-4[0-9a-f]{3}-[89ab][0-9a-f]{3}-[0-9a-f]{12}$`)
+
+	t.Run("generates a client oid when none is provided", func(t *testing.T) {
+		var clientOID string
+		s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			var body api.Request
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+
+			clientOID, _ = body.Params["client_oid"].(string)
+			_, err := w.Write([]byte(`{"id": 1, "code": 0, "result": {"order_id": "1"}}`))
+			require.NoError(t, err)
+		}))
+		t.Cleanup(s.Close)
+
+		client, err := cdcexchange.New("api key", "secret key",
+			cdcexchange.WithHTTPClient(s.Client()),
+			cdcexchange.WithBaseURL(fmt.Sprintf("%s/", s.URL)),
+			cdcexchange.WithClientOIDGeneration(),
+		)
+		require.NoError(t, err)
+
+		res, err := client.CreateOrder(context.Background(), cdcexchange.CreateOrderRequest{
+			InstrumentName: "CRO_BTC",
+			Side:           cdcexchange.OrderSideBuy,
+			Type:           cdcexchange.OrderTypeLimit,
+			Price:          cdcexchange.MustParseDecimal("1"),
+			Quantity:       cdcexchange.MustParseDecimal("1"),
+		})
+		require.NoError(t, err)
+
+		assert.Regexp(t, uuidPattern, clientOID)
+		require.NotEmpty(t, res.Raw)
+		res.Raw = nil
+
+		assert.Equal(t, &cdcexchange.CreateOrderResult{OrderID: "1", ClientOID: clientOID}, res)
+	})
+
+	t.Run("keeps the client oid when provided", func(t *testing.T) {
+		var clientOID string
+		s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			var body api.Request
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+
+			clientOID, _ = body.Params["client_oid"].(string)
+			_, err := w.Write([]byte(`{"id": 1, "code": 0, "result": {"order_id": "1", "client_oid": "some client oid"}}`))
+			require.NoError(t, err)
+		}))
+		t.Cleanup(s.Close)
+
+		client, err := cdcexchange.New("api key", "secret key",
+			cdcexchange.WithHTTPClient(s.Client()),
+			cdcexchange.WithBaseURL(fmt.Sprintf("%s/", s.URL)),
+			cdcexchange.WithClientOIDGeneration(),
+		)
+		require.NoError(t, err)
+
+		res, err := client.CreateOrder(context.Background(), cdcexchange.CreateOrderRequest{ClientOID: "some client oid"})
+		require.NoError(t, err)
+
+		assert.Equal(t, "some client oid", clientOID)
+		assert.Equal(t, "some client oid", res.ClientOID)
+	})
+
+	t.Run("returns unconfirmed order error when no response is received", func(t *testing.T) {
+		client, err := cdcexchange.New("api key", "secret key",
+			cdcexchange.WithHTTPClient(&http.Client{Transport: roundTripper{err: errors.New("connection reset")}}),
+			cdcexchange.WithClientOIDGeneration(),
+		)
+		require.NoError(t, err)
+
+		res, err := client.CreateOrder(context.Background(), cdcexchange.CreateOrderRequest{})
+		require.Error(t, err)
+		assert.Nil(t, res)
+
+		var unconfirmedErr cdcerrors.UnconfirmedOrderError
+		require.True(t, errors.As(err, &unconfirmedErr))
+		assert.Regexp(t, uuidPattern, unconfirmedErr.ClientOID)
+	})
+
+	t.Run("does not return unconfirmed order error when the order is not sent by a read only client", func(t *testing.T) {
+		client, err := cdcexchange.New("api key", "secret key",
+			cdcexchange.WithHTTPClient(&http.Client{Transport: roundTripper{err: errors.New("connection reset")}}),
+			cdcexchange.WithClientOIDGeneration(),
+			cdcexchange.WithReadOnly(),
+		)
+		require.NoError(t, err)
+
+		res, err := client.CreateOrder(context.Background(), cdcexchange.CreateOrderRequest{})
+		require.Error(t, err)
+		assert.Nil(t, res)
+
+		assert.True(t, errors.Is(err, cdcerrors.ErrReadOnlyClient))
+		assert.False(t, errors.As(err, &cdcerrors.UnconfirmedOrderError{}))
+	})
+
+	t.Run("does not return unconfirmed order error when the order is not sent as the circuit is open", func(t *testing.T) {
+		client, err := cdcexchange.New("api key", "secret key",
+			cdcexchange.WithHTTPClient(&http.Client{Transport: roundTripper{err: errors.New("connection reset")}}),
+			cdcexchange.WithClientOIDGeneration(),
+			cdcexchange.WithCircuitBreaker(1, time.Minute),
+		)
+		require.NoError(t, err)
+
+		// the first order is sent, opening the circuit as no response is received.
+		_, err = client.CreateOrder(context.Background(), cdcexchange.CreateOrderRequest{})
+		require.True(t, errors.As(err, &cdcerrors.UnconfirmedOrderError{}))
+
+		res, err := client.CreateOrder(context.Background(), cdcexchange.CreateOrderRequest{})
+		require.Error(t, err)
+		assert.Nil(t, res)
+
+		assert.True(t, errors.Is(err, cdcerrors.ErrCircuitOpen))
+		assert.False(t, errors.As(err, &cdcerrors.UnconfirmedOrderError{}))
+	})
+}
+
+func TestClient_CreateOrder_OrderValidation(t *testing.T) {
+	var instrumentsRequests, orderRequests int
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var res string
+		switch {
+		case strings.HasSuffix(r.URL.Path, cdcexchange.MethodGetInstruments):
+			instrumentsRequests++
+			res = `{"id": 1, "code": 0, "result": {"data": [
+				{"symbol": "BTC_USD", "quote_decimals": 2, "quantity_decimals": 5, "price_tick_size": "0.01", "qty_tick_size": "0.00001", "tradable": true},
+				{"symbol": "CRO_USD", "quote_decimals": 5, "quantity_decimals": 0, "tradable": true},
+				{"symbol": "OLD_USD", "price_tick_size": "0.01", "qty_tick_size": "1", "tradable": false}
+			]}}`
+		case strings.HasSuffix(r.URL.Path, cdcexchange.MethodCreateOrder):
+			orderRequests++
+			res = `{"id": 1, "code": 0, "result": {"order_id": "1"}}`
+		default:
+			t.Errorf("unexpected request to %s", r.URL.Path)
+		}
+
+		_, err := w.Write([]byte(res))
+		require.NoError(t, err)
+	}))
+	t.Cleanup(s.Close)
+
+	client, err := cdcexchange.New("api key", "secret key",
+		cdcexchange.WithHTTPClient(s.Client()),
+		cdcexchange.WithBaseURL(fmt.Sprintf("%s/", s.URL)),
+		cdcexchange.WithOrderValidation(),
+	)
+	require.NoError(t, err)
+
+	order := func(instrumentName string, price string, quantity string) cdcexchange.CreateOrderRequest {
+		return cdcexchange.CreateOrderRequest{
+			InstrumentName: instrumentName,
+			Side:           cdcexchange.OrderSideBuy,
+			Type:           cdcexchange.OrderTypeLimit,
+			Price:          cdcexchange.MustParseDecimal(price),
+			Quantity:       cdcexchange.MustParseDecimal(quantity),
+		}
+	}
+
+	tests := []struct {
+		name        string
+		req         cdcexchange.CreateOrderRequest
+		expectedErr error
+	}{
+		{
+			name: "creates a valid order",
+			req:  order("BTC_USD", "19600.01", "0.00015"),
+		},
+		{
+			name:        "returns error when instrument is unknown",
+			req:         order("ABC_USD", "1", "1"),
+			expectedErr: cdcerrors.InvalidParameterError{Parameter: "req.InstrumentName", Reason: `"ABC_USD" is not a known instrument`},
+		},
+		{
+			name:        "returns error when instrument is not tradable",
+			req:         order("OLD_USD", "1", "1"),
+			expectedErr: cdcerrors.InvalidParameterError{Parameter: "req.InstrumentName", Reason: "OLD_USD is not tradable"},
+		},
+		{
+			name:        "returns error when price is not a multiple of the price tick size",
+			req:         order("BTC_USD", "19600.015", "1"),
+			expectedErr: cdcerrors.InvalidParameterError{Parameter: "req.Price", Reason: "must be a multiple of the price tick size 0.01 of BTC_USD"},
+		},
+		{
+			name: "returns error when trigger price is not a multiple of the price tick size",
+			req: cdcexchange.CreateOrderRequest{
+				InstrumentName: "BTC_USD",
+				Side:           cdcexchange.OrderSideSell,
+				Type:           cdcexchange.OrderTypeStopLoss,
+				Quantity:       cdcexchange.MustParseDecimal("1"),
+				TriggerPrice:   cdcexchange.MustParseDecimal("19000.001"),
+			},
+			expectedErr: cdcerrors.InvalidParameterError{Parameter: "req.TriggerPrice", Reason: "must be a multiple of the price tick size 0.01 of BTC_USD"},
+		},
+		{
+			name:        "returns error when quantity is less than the quantity tick size",
+			req:         order("BTC_USD", "1", "0.000001"),
+			expectedErr: cdcerrors.InvalidParameterError{Parameter: "req.Quantity", Reason: "cannot be less than the minimum quantity 0.00001 of BTC_USD"},
+		},
+		{
+			name:        "returns error when quantity is not a multiple of the quantity tick size",
+			req:         order("BTC_USD", "1", "0.000015"),
+			expectedErr: cdcerrors.InvalidParameterError{Parameter: "req.Quantity", Reason: "must be a multiple of the quantity tick size 0.00001 of BTC_USD"},
+		},
+		{
+			name:        "returns error when price has more decimal places than the quote decimals",
+			req:         order("CRO_USD", "0.000001", "1"),
+			expectedErr: cdcerrors.InvalidParameterError{Parameter: "req.Price", Reason: "cannot have more than 5 decimal places for CRO_USD"},
+		},
+		{
+			name: "creates an order when tick sizes and quantity decimals are unknown",
+			req:  order("CRO_USD", "0.08765", "1.5"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			before := orderRequests
+
+			res, err := client.CreateOrder(context.Background(), tt.req)
+			if tt.expectedErr != nil {
+				require.Error(t, err)
+
+				assert.Nil(t, res)
+				assert.Equal(t, tt.expectedErr, err)
+				assert.Equal(t, before, orderRequests)
+				return
+			}
+			require.NoError(t, err)
+
+			assert.Equal(t, "1", res.OrderID)
+			assert.Equal(t, before+1, orderRequests)
+		})
+	}
+
+	assert.Equal(t, 1, instrumentsRequests)
+}
@@ -0,0 +1,208 @@
+package cdcexchange
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/sngyai/go-cryptocom/v2/errors"
+	"github.com/sngyai/go-cryptocom/v2/internal/api"
+	"github.com/sngyai/go-cryptocom/v2/internal/auth"
+)
+
+const (
+	methodCreateOrderList = "private/create-order-list"
+	methodCancelOrderList = "private/cancel-order-list"
+
+	// ContingencyTypeOCO is a one-cancels-the-other order list:
+	// when one order of the list is executed, the other is cancelled.
+	ContingencyTypeOCO ContingencyType = "OCO"
+)
+
+type (
+	// ContingencyType is the type of an order list (e.g. OCO).
+	ContingencyType string
+
+	// CreateOrderListRequest is the request params sent for the private/create-order-list API.
+	CreateOrderListRequest struct {
+		// ContingencyType is the type of the order list (only OCO is supported).
+		ContingencyType ContingencyType `json:"contingency_type"`
+		// OrderList is the orders of the list (exactly 2 for OCO).
+		// For OCO, one order is usually a LIMIT or TAKE_PROFIT_LIMIT order and the other a STOP_LOSS or STOP_LIMIT order.
+		OrderList []CreateOrderRequest `json:"order_list"`
+	}
+
+	// CreateOrderListResponse is the base response returned from the private/create-order-list API.
+	CreateOrderListResponse struct {
+		// BaseResponse is the common response fields.
+		BaseResponse
+		// Result is the response attributes of the endpoint.
+		Result CreateOrderListResult `json:"result"`
+	}
+
+	// CreateOrderListResult is the result returned from the private/create-order-list API.
+	CreateOrderListResult struct {
+		// ListID is the ID of the newly created order list.
+		ListID string `json:"list_id"`
+		// Raw is the raw JSON of the result, for fields not decoded by this package.
+		Raw json.RawMessage `json:"-"`
+	}
+
+	// CancelOrderListResponse is the base response returned from the private/cancel-order-list API.
+	CancelOrderListResponse struct {
+		// BaseResponse is the common response fields.
+		BaseResponse
+	}
+)
+
+// UnmarshalJSON decodes a result whose list_id is sent as either a string or a number.
+func (r *CreateOrderListResult) UnmarshalJSON(data []byte) error {
+	var v struct {
+		ListID json.RawMessage `json:"list_id"`
+	}
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+
+	listID, err := unmarshalID("list_id", v.ListID)
+	if err != nil {
+		return err
+	}
+	r.ListID = listID
+
+	return nil
+}
+
+// CreateOrderList creates a list of orders (e.g. OCO) on the Exchange.
+//
+// This call is asynchronous, so the response is simply a confirmation of the request.
+//
+// Method: private/create-order-list
+func (c *Client) CreateOrderList(ctx context.Context, req CreateOrderListRequest) (*CreateOrderListResult, error) {
+	if req.ContingencyType != ContingencyTypeOCO {
+		return nil, errors.InvalidParameterError{Parameter: "req.ContingencyType", Reason: "must be OCO"}
+	}
+	if len(req.OrderList) != 2 {
+		return nil, errors.InvalidParameterError{Parameter: "req.OrderList", Reason: "must contain exactly 2 orders for OCO"}
+	}
+
+	orderList := make([]map[string]interface{}, 0, len(req.OrderList))
+	for _, order := range req.OrderList {
+		if err := validateExecutionOptions(order); err != nil {
+			return nil, err
+		}
+
+		orderList = append(orderList, createOrderParams(order))
+	}
+
+	var (
+		id        = c.idGenerator.Generate()
+		timestamp = c.now().UnixMilli()
+		params    = map[string]interface{}{
+			"contingency_type": req.ContingencyType,
+			"order_list":       orderList,
+		}
+	)
+
+	creds, err := c.credentials(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	signature, err := c.signatureGenerator.GenerateSignature(auth.SignatureRequest{
+		APIKey:    creds.APIKey,
+		SecretKey: creds.SecretKey,
+		ID:        id,
+		Method:    methodCreateOrderList,
+		Timestamp: timestamp,
+		Params:    params,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create signature: %w", err)
+	}
+
+	body := api.Request{
+		ID:        id,
+		Method:    methodCreateOrderList,
+		Nonce:     timestamp,
+		Params:    params,
+		Signature: signature,
+		APIKey:    creds.APIKey,
+	}
+
+	var createOrderListResponse CreateOrderListResponse
+	statusCode, err := c.requester.Post(ctx, body, methodCreateOrderList, &createOrderListResponse)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute post request: %w", err)
+	}
+
+	if err := c.requester.CheckResponse(statusCode, api.BaseResponse(createOrderListResponse.BaseResponse)); err != nil {
+		return nil, fmt.Errorf("error received in response: %w", err)
+	}
+
+	createOrderListResponse.Result.Raw = createOrderListResponse.RawResult
+
+	return &createOrderListResponse.Result, nil
+}
+
+// CancelOrderList cancels the remaining orders of an OCO order list on the Exchange.
+//
+// This call is asynchronous, so the response is simply a confirmation of the request.
+//
+// Method: private/cancel-order-list
+func (c *Client) CancelOrderList(ctx context.Context, instrumentName string, listID string) error {
+	if instrumentName == "" {
+		return errors.InvalidParameterError{Parameter: "instrumentName", Reason: "cannot be empty"}
+	}
+	if listID == "" {
+		return errors.InvalidParameterError{Parameter: "listID", Reason: "cannot be empty"}
+	}
+
+	var (
+		id        = c.idGenerator.Generate()
+		timestamp = c.now().UnixMilli()
+		params    = map[string]interface{}{
+			"contingency_type": ContingencyTypeOCO,
+			"instrument_name":  instrumentName,
+			"list_id":          listID,
+		}
+	)
+
+	creds, err := c.credentials(ctx)
+	if err != nil {
+		return err
+	}
+
+	signature, err := c.signatureGenerator.GenerateSignature(auth.SignatureRequest{
+		APIKey:    creds.APIKey,
+		SecretKey: creds.SecretKey,
+		ID:        id,
+		Method:    methodCancelOrderList,
+		Timestamp: timestamp,
+		Params:    params,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create signature: %w", err)
+	}
+
+	body := api.Request{
+		ID:        id,
+		Method:    methodCancelOrderList,
+		Nonce:     timestamp,
+		Params:    params,
+		Signature: signature,
+		APIKey:    creds.APIKey,
+	}
+
+	var cancelOrderListResponse CancelOrderListResponse
+	statusCode, err := c.requester.Post(ctx, body, methodCancelOrderList, &cancelOrderListResponse)
+	if err != nil {
+		return fmt.Errorf("failed to execute post request: %w", err)
+	}
+
+	if err := c.requester.CheckResponse(statusCode, api.BaseResponse(cancelOrderListResponse.BaseResponse)); err != nil {
+		return fmt.Errorf("error received in response: %w", err)
+	}
+
+	return nil
+}
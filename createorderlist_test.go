@@ -0,0 +1,190 @@
+package cdcexchange_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	cdcexchange "github.com/sngyai/go-cryptocom/v2"
+	cdcerrors "github.com/sngyai/go-cryptocom/v2/errors"
+	"github.com/sngyai/go-cryptocom/v2/internal/api"
+)
+
+func TestClient_CreateOrderList_Success(t *testing.T) {
+	const (
+		apiKey    = "some api key"
+		secretKey = "some secret key"
+	)
+
+	var body api.Request
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.True(t, strings.HasSuffix(r.URL.Path, cdcexchange.MethodCreateOrderList))
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+
+		_, err := w.Write([]byte(`{"id": 1, "method": "private/create-order-list", "code": 0, "result": {"list_id": 6498090546073120100}}`))
+		require.NoError(t, err)
+	}))
+	t.Cleanup(s.Close)
+
+	client, err := cdcexchange.New(apiKey, secretKey,
+		cdcexchange.WithHTTPClient(s.Client()),
+		cdcexchange.WithBaseURL(fmt.Sprintf("%s/", s.URL)),
+	)
+	require.NoError(t, err)
+
+	res, err := client.CreateOrderList(context.Background(), cdcexchange.CreateOrderListRequest{
+		ContingencyType: cdcexchange.ContingencyTypeOCO,
+		OrderList: []cdcexchange.CreateOrderRequest{
+			{
+				InstrumentName: "BTC_USDT",
+				Side:           cdcexchange.OrderSideSell,
+				Type:           cdcexchange.OrderTypeLimit,
+				Price:          cdcexchange.MustParseDecimal("26000"),
+				Quantity:       cdcexchange.MustParseDecimal("0.5"),
+			},
+			{
+				InstrumentName: "BTC_USDT",
+				Side:           cdcexchange.OrderSideSell,
+				Type:           cdcexchange.OrderTypeStopLoss,
+				TriggerPrice:   cdcexchange.MustParseDecimal("24000"),
+				Quantity:       cdcexchange.MustParseDecimal("0.5"),
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, "6498090546073120100", res.ListID)
+	assert.JSONEq(t, `{"list_id": 6498090546073120100}`, string(res.Raw))
+
+	assert.Equal(t, cdcexchange.MethodCreateOrderList, body.Method)
+	assert.Equal(t, apiKey, body.APIKey)
+	assert.NotEmpty(t, body.Signature)
+	assert.Equal(t, map[string]interface{}{
+		"contingency_type": "OCO",
+		"order_list": []interface{}{
+			map[string]interface{}{
+				"instrument_name": "BTC_USDT",
+				"side":            "SELL",
+				"type":            "LIMIT",
+				"price":           "26000",
+				"quantity":        "0.5",
+			},
+			map[string]interface{}{
+				"instrument_name": "BTC_USDT",
+				"side":            "SELL",
+				"type":            "STOP_LOSS",
+				"trigger_price":   "24000",
+				"quantity":        "0.5",
+			},
+		},
+	}, body.Params)
+}
+
+func TestClient_CreateOrderList_Error(t *testing.T) {
+	client, err := cdcexchange.New("api key", "secret key")
+	require.NoError(t, err)
+
+	order := cdcexchange.CreateOrderRequest{
+		InstrumentName: "BTC_USDT",
+		Side:           cdcexchange.OrderSideSell,
+		Type:           cdcexchange.OrderTypeLimit,
+		Price:          cdcexchange.MustParseDecimal("26000"),
+		Quantity:       cdcexchange.MustParseDecimal("0.5"),
+	}
+
+	tests := []struct {
+		name        string
+		req         cdcexchange.CreateOrderListRequest
+		expectedErr error
+	}{
+		{
+			name:        "returns error when contingency type is not OCO",
+			req:         cdcexchange.CreateOrderListRequest{ContingencyType: "LIST", OrderList: []cdcexchange.CreateOrderRequest{order, order}},
+			expectedErr: cdcerrors.InvalidParameterError{Parameter: "req.ContingencyType", Reason: "must be OCO"},
+		},
+		{
+			name:        "returns error when order list does not contain 2 orders",
+			req:         cdcexchange.CreateOrderListRequest{ContingencyType: cdcexchange.ContingencyTypeOCO, OrderList: []cdcexchange.CreateOrderRequest{order}},
+			expectedErr: cdcerrors.InvalidParameterError{Parameter: "req.OrderList", Reason: "must contain exactly 2 orders for OCO"},
+		},
+		{
+			name: "returns error when an order is invalid",
+			req: cdcexchange.CreateOrderListRequest{ContingencyType: cdcexchange.ContingencyTypeOCO, OrderList: []cdcexchange.CreateOrderRequest{
+				order,
+				{InstrumentName: "BTC_USDT", Side: "HOLD"},
+			}},
+			expectedErr: cdcerrors.InvalidParameterError{Parameter: "req.Side", Reason: "must be BUY or SELL"},
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := client.CreateOrderList(context.Background(), tt.req)
+			assert.Equal(t, tt.expectedErr, err)
+		})
+	}
+}
+
+func TestClient_CancelOrderList(t *testing.T) {
+	t.Run("sends the list to cancel", func(t *testing.T) {
+		var body api.Request
+		s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.True(t, strings.HasSuffix(r.URL.Path, cdcexchange.MethodCancelOrderList))
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+
+			_, err := w.Write([]byte(`{"id": 1, "method": "private/cancel-order-list", "code": 0}`))
+			require.NoError(t, err)
+		}))
+		t.Cleanup(s.Close)
+
+		client, err := cdcexchange.New("api key", "secret key",
+			cdcexchange.WithHTTPClient(s.Client()),
+			cdcexchange.WithBaseURL(fmt.Sprintf("%s/", s.URL)),
+		)
+		require.NoError(t, err)
+
+		require.NoError(t, client.CancelOrderList(context.Background(), "BTC_USDT", "6498090546073120100"))
+		assert.Equal(t, map[string]interface{}{
+			"contingency_type": "OCO",
+			"instrument_name":  "BTC_USDT",
+			"list_id":          "6498090546073120100",
+		}, body.Params)
+	})
+
+	t.Run("returns error received in response", func(t *testing.T) {
+		s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusBadRequest)
+			_, err := w.Write([]byte(`{"id": 1, "method": "private/cancel-order-list", "code": 212}`))
+			require.NoError(t, err)
+		}))
+		t.Cleanup(s.Close)
+
+		client, err := cdcexchange.New("api key", "secret key",
+			cdcexchange.WithHTTPClient(s.Client()),
+			cdcexchange.WithBaseURL(fmt.Sprintf("%s/", s.URL)),
+		)
+		require.NoError(t, err)
+
+		err = client.CancelOrderList(context.Background(), "BTC_USDT", "6498090546073120100")
+		assert.True(t, errors.Is(err, cdcerrors.ErrInvalidOrderID))
+	})
+
+	t.Run("returns error when params are empty", func(t *testing.T) {
+		client, err := cdcexchange.New("api key", "secret key")
+		require.NoError(t, err)
+
+		err = client.CancelOrderList(context.Background(), "", "1")
+		assert.Equal(t, cdcerrors.InvalidParameterError{Parameter: "instrumentName", Reason: "cannot be empty"}, err)
+
+		err = client.CancelOrderList(context.Background(), "BTC_USDT", "")
+		assert.Equal(t, cdcerrors.InvalidParameterError{Parameter: "listID", Reason: "cannot be empty"}, err)
+	})
+}
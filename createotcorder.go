@@ -0,0 +1,143 @@
+package cdcexchange
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/sngyai/go-cryptocom/v2/errors"
+	"github.com/sngyai/go-cryptocom/v2/internal/api"
+	"github.com/sngyai/go-cryptocom/v2/internal/auth"
+)
+
+const (
+	methodCreateOTCOrder = "private/otc/create-order"
+)
+
+type (
+	// CreateOTCOrderRequest is the request params sent for the private/otc/create-order API.
+	//
+	// Exactly one of Quantity and Notional must be provided.
+	CreateOTCOrderRequest struct {
+		// InstrumentName represents the OTC instrument to trade (e.g. BTC_USDT).
+		// The tradable instruments can be retrieved with GetOTCInstruments.
+		InstrumentName string `json:"instrument_name"`
+		// Side represents whether the order is buy or sell.
+		Side OrderSide `json:"side"`
+		// Quantity is the quantity of base currency to trade.
+		Quantity Decimal `json:"quantity"`
+		// Notional is the amount of quote currency to trade.
+		Notional Decimal `json:"notional"`
+		// Price is the optional worst price the order should be executed at.
+		// if Price is 0, the order will be executed at the current OTC price.
+		Price Decimal `json:"price"`
+		// ClientOID is the optional Client order ID.
+		ClientOID string `json:"client_oid"`
+	}
+
+	// CreateOTCOrderResponse is the base response returned from the private/otc/create-order API.
+	CreateOTCOrderResponse struct {
+		// BaseResponse is the common response fields.
+		BaseResponse
+		// Result is the response attributes of the endpoint.
+		Result CreateOTCOrderResult `json:"result"`
+	}
+
+	// CreateOTCOrderResult is the result returned from the private/otc/create-order API.
+	CreateOTCOrderResult struct {
+		// OrderID is the newly created order ID.
+		OrderID string `json:"order_id"`
+		// ClientOID is the optional Client order ID (if provided in request).
+		ClientOID string `json:"client_oid"`
+		// Raw is the raw JSON of the result, for fields not decoded by this package.
+		Raw json.RawMessage `json:"-"`
+	}
+)
+
+// CreateOTCOrder creates a new BUY or SELL order against an OTC instrument,
+// without having to request and accept a quote first.
+//
+// Method: private/otc/create-order
+func (c *Client) CreateOTCOrder(ctx context.Context, req CreateOTCOrderRequest) (*CreateOTCOrderResult, error) {
+	switch {
+	case req.InstrumentName == "":
+		return nil, errors.InvalidParameterError{Parameter: "req.InstrumentName", Reason: "cannot be empty"}
+	case req.Quantity.Sign() < 0:
+		return nil, errors.InvalidParameterError{Parameter: "req.Quantity", Reason: "cannot be less than 0"}
+	case req.Notional.Sign() < 0:
+		return nil, errors.InvalidParameterError{Parameter: "req.Notional", Reason: "cannot be less than 0"}
+	case req.Price.Sign() < 0:
+		return nil, errors.InvalidParameterError{Parameter: "req.Price", Reason: "cannot be less than 0"}
+	case req.Quantity.IsZero() && req.Notional.IsZero():
+		return nil, errors.InvalidParameterError{Parameter: "req.Quantity", Reason: "or req.Notional must be provided"}
+	case !req.Quantity.IsZero() && !req.Notional.IsZero():
+		return nil, errors.InvalidParameterError{Parameter: "req.Quantity", Reason: "and req.Notional are mutually exclusive"}
+	}
+
+	switch req.Side {
+	case OrderSideBuy, OrderSideSell:
+	default:
+		return nil, errors.InvalidParameterError{Parameter: "req.Side", Reason: "must be BUY or SELL"}
+	}
+
+	var (
+		id        = c.idGenerator.Generate()
+		timestamp = c.now().UnixMilli()
+		params    = make(map[string]interface{})
+	)
+
+	creds, err := c.credentials(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	params["instrument_name"] = req.InstrumentName
+	params["side"] = req.Side
+	if !req.Quantity.IsZero() {
+		params["quantity"] = req.Quantity
+	}
+	if !req.Notional.IsZero() {
+		params["notional"] = req.Notional
+	}
+	if !req.Price.IsZero() {
+		params["price"] = req.Price
+	}
+	if req.ClientOID != "" {
+		params["client_oid"] = req.ClientOID
+	}
+
+	signature, err := c.signatureGenerator.GenerateSignature(auth.SignatureRequest{
+		APIKey:    creds.APIKey,
+		SecretKey: creds.SecretKey,
+		ID:        id,
+		Method:    methodCreateOTCOrder,
+		Timestamp: timestamp,
+		Params:    params,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create signature: %w", err)
+	}
+
+	body := api.Request{
+		ID:        id,
+		Method:    methodCreateOTCOrder,
+		Nonce:     timestamp,
+		Params:    params,
+		Signature: signature,
+		APIKey:    creds.APIKey,
+	}
+
+	var createOTCOrderResponse CreateOTCOrderResponse
+	statusCode, err := c.requester.Post(ctx, body, methodCreateOTCOrder, &createOTCOrderResponse)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute post request: %w", err)
+	}
+
+	if err := c.requester.CheckResponse(statusCode, api.BaseResponse(createOTCOrderResponse.BaseResponse)); err != nil {
+		return nil, fmt.Errorf("error received in response: %w", err)
+	}
+
+	createOTCOrderResponse.Result.Raw = createOTCOrderResponse.RawResult
+
+	return &createOTCOrderResponse.Result, nil
+}
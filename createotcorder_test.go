@@ -0,0 +1,161 @@
+package cdcexchange_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/jonboulle/clockwork"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	cdcexchange "github.com/sngyai/go-cryptocom/v2"
+	cdcerrors "github.com/sngyai/go-cryptocom/v2/errors"
+	"github.com/sngyai/go-cryptocom/v2/internal/api"
+	"github.com/sngyai/go-cryptocom/v2/internal/auth"
+	id_mocks "github.com/sngyai/go-cryptocom/v2/internal/mocks/id"
+	signature_mocks "github.com/sngyai/go-cryptocom/v2/internal/mocks/signature"
+)
+
+func TestClient_CreateOTCOrder_Error(t *testing.T) {
+	const (
+		apiKey    = "some api key"
+		secretKey = "some secret key"
+	)
+
+	tests := []struct {
+		name        string
+		req         cdcexchange.CreateOTCOrderRequest
+		expectedErr error
+	}{
+		{
+			name:        "returns error when instrument name is empty",
+			req:         cdcexchange.CreateOTCOrderRequest{Side: cdcexchange.OrderSideBuy, Quantity: cdcexchange.MustParseDecimal("1")},
+			expectedErr: cdcerrors.InvalidParameterError{Parameter: "req.InstrumentName", Reason: "cannot be empty"},
+		},
+		{
+			name:        "returns error when price is less than 0",
+			req:         cdcexchange.CreateOTCOrderRequest{InstrumentName: "BTC_USDT", Side: cdcexchange.OrderSideBuy, Quantity: cdcexchange.MustParseDecimal("1"), Price: cdcexchange.MustParseDecimal("-1")},
+			expectedErr: cdcerrors.InvalidParameterError{Parameter: "req.Price", Reason: "cannot be less than 0"},
+		},
+		{
+			name:        "returns error when neither quantity nor notional is provided",
+			req:         cdcexchange.CreateOTCOrderRequest{InstrumentName: "BTC_USDT", Side: cdcexchange.OrderSideBuy},
+			expectedErr: cdcerrors.InvalidParameterError{Parameter: "req.Quantity", Reason: "or req.Notional must be provided"},
+		},
+		{
+			name:        "returns error when both quantity and notional are provided",
+			req:         cdcexchange.CreateOTCOrderRequest{InstrumentName: "BTC_USDT", Side: cdcexchange.OrderSideBuy, Quantity: cdcexchange.MustParseDecimal("1"), Notional: cdcexchange.MustParseDecimal("1")},
+			expectedErr: cdcerrors.InvalidParameterError{Parameter: "req.Quantity", Reason: "and req.Notional are mutually exclusive"},
+		},
+		{
+			name:        "returns error when side is invalid",
+			req:         cdcexchange.CreateOTCOrderRequest{InstrumentName: "BTC_USDT", Quantity: cdcexchange.MustParseDecimal("1")},
+			expectedErr: cdcerrors.InvalidParameterError{Parameter: "req.Side", Reason: "must be BUY or SELL"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client, err := cdcexchange.New(apiKey, secretKey)
+			require.NoError(t, err)
+
+			res, err := client.CreateOTCOrder(context.Background(), tt.req)
+			require.Error(t, err)
+
+			assert.Nil(t, res)
+			assert.True(t, errors.Is(err, tt.expectedErr))
+		})
+	}
+}
+
+func TestClient_CreateOTCOrder_Success(t *testing.T) {
+	const (
+		apiKey    = "some api key"
+		secretKey = "some secret key"
+		id        = int64(1234)
+		signature = "some signature"
+		clientOID = "some client oid"
+	)
+	now := time.Now()
+
+	ctrl, ctx := gomock.WithContext(context.Background(), t)
+	t.Cleanup(ctrl.Finish)
+
+	var (
+		signatureGenerator = signature_mocks.NewMockSignatureGenerator(ctrl)
+		idGenerator        = id_mocks.NewMockIDGenerator(ctrl)
+		clock              = clockwork.NewFakeClockAt(now)
+	)
+
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Contains(t, r.URL.Path, cdcexchange.MethodCreateOTCOrder)
+		t.Cleanup(func() { require.NoError(t, r.Body.Close()) })
+
+		var body api.Request
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+
+		assert.Equal(t, "BTC_USDT", body.Params["instrument_name"])
+		assert.Equal(t, "SELL", body.Params["side"])
+		assert.Equal(t, "2.5", body.Params["quantity"])
+		assert.NotContains(t, body.Params, "notional")
+		assert.NotContains(t, body.Params, "price")
+
+		_, err := w.Write([]byte(`{
+			"id": 1234,
+			"method": "private/otc/create-order",
+			"code": 0,
+			"result": {
+				"order_id": "337843775021233500",
+				"client_oid": "some client oid"
+			}
+		}`))
+		require.NoError(t, err)
+	}))
+	t.Cleanup(s.Close)
+
+	client, err := cdcexchange.New(apiKey, secretKey,
+		cdcexchange.WithIDGenerator(idGenerator),
+		cdcexchange.WithClock(clock),
+		cdcexchange.WithHTTPClient(s.Client()),
+		cdcexchange.WithBaseURL(fmt.Sprintf("%s/", s.URL)),
+		cdcexchange.WithSignatureGenerator(signatureGenerator),
+	)
+	require.NoError(t, err)
+
+	idGenerator.EXPECT().Generate().Return(id)
+	signatureGenerator.EXPECT().GenerateSignature(auth.SignatureRequest{
+		APIKey:    apiKey,
+		SecretKey: secretKey,
+		ID:        id,
+		Method:    cdcexchange.MethodCreateOTCOrder,
+		Timestamp: now.UnixMilli(),
+		Params: map[string]interface{}{
+			"instrument_name": "BTC_USDT",
+			"side":            cdcexchange.OrderSideSell,
+			"quantity":        cdcexchange.MustParseDecimal("2.5"),
+			"client_oid":      clientOID,
+		},
+	}).Return(signature, nil)
+
+	res, err := client.CreateOTCOrder(ctx, cdcexchange.CreateOTCOrderRequest{
+		InstrumentName: "BTC_USDT",
+		Side:           cdcexchange.OrderSideSell,
+		Quantity:       cdcexchange.MustParseDecimal("2.5"),
+		ClientOID:      clientOID,
+	})
+	require.NoError(t, err)
+
+	require.NotEmpty(t, res.Raw)
+	res.Raw = nil
+
+	assert.Equal(t, &cdcexchange.CreateOTCOrderResult{
+		OrderID:   "337843775021233500",
+		ClientOID: clientOID,
+	}, res)
+}
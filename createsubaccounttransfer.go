@@ -0,0 +1,120 @@
+package cdcexchange
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/sngyai/go-cryptocom/v2/errors"
+	"github.com/sngyai/go-cryptocom/v2/internal/api"
+	"github.com/sngyai/go-cryptocom/v2/internal/auth"
+)
+
+const (
+	methodCreateSubAccountTransfer = "private/create-subaccount-transfer"
+
+	TransferDirectionIn  TransferDirection = "IN"
+	TransferDirectionOut TransferDirection = "OUT"
+)
+
+type (
+	// TransferDirection is the direction of a transfer relative to the master account (IN/OUT).
+	TransferDirection string
+
+	// CreateSubAccountTransferRequest is the request params sent for the private/create-subaccount-transfer API.
+	CreateSubAccountTransferRequest struct {
+		// Direction is the direction of the transfer relative to the master account.
+		// IN moves funds into the master account, OUT moves funds out of it.
+		// Can be left blank, in which case From and To alone determine the transfer.
+		Direction TransferDirection `json:"direction"`
+		// From is the account UUID funds are transferred from.
+		From string `json:"from"`
+		// To is the account UUID funds are transferred to.
+		To string `json:"to"`
+		// Currency represents the currency symbol to transfer (e.g. BTC or CRO).
+		Currency string `json:"currency"`
+		// Amount is the amount to transfer.
+		Amount Decimal `json:"amount"`
+	}
+
+	// CreateSubAccountTransferResponse is the base response returned from the private/create-subaccount-transfer API.
+	CreateSubAccountTransferResponse struct {
+		// BaseResponse is the common response fields.
+		BaseResponse
+	}
+)
+
+// CreateSubAccountTransfer transfers funds between the master account and sub-accounts.
+//
+// Method: private/create-subaccount-transfer
+func (c *Client) CreateSubAccountTransfer(ctx context.Context, req CreateSubAccountTransferRequest) error {
+	switch {
+	case req.From == "":
+		return errors.InvalidParameterError{Parameter: "req.From", Reason: "cannot be empty"}
+	case req.To == "":
+		return errors.InvalidParameterError{Parameter: "req.To", Reason: "cannot be empty"}
+	case req.From == req.To:
+		return errors.InvalidParameterError{Parameter: "req.To", Reason: "cannot be the same as req.From"}
+	case req.Currency == "":
+		return errors.InvalidParameterError{Parameter: "req.Currency", Reason: "cannot be empty"}
+	case req.Amount.Sign() <= 0:
+		return errors.InvalidParameterError{Parameter: "req.Amount", Reason: "must be greater than 0"}
+	}
+
+	switch req.Direction {
+	case "", TransferDirectionIn, TransferDirectionOut:
+	default:
+		return errors.InvalidParameterError{Parameter: "req.Direction", Reason: "must be IN or OUT"}
+	}
+
+	var (
+		id        = c.idGenerator.Generate()
+		timestamp = c.now().UnixMilli()
+		params    = make(map[string]interface{})
+	)
+
+	creds, err := c.credentials(ctx)
+	if err != nil {
+		return err
+	}
+
+	if req.Direction != "" {
+		params["direction"] = req.Direction
+	}
+	params["from"] = req.From
+	params["to"] = req.To
+	params["currency"] = req.Currency
+	params["amount"] = req.Amount
+
+	signature, err := c.signatureGenerator.GenerateSignature(auth.SignatureRequest{
+		APIKey:    creds.APIKey,
+		SecretKey: creds.SecretKey,
+		ID:        id,
+		Method:    methodCreateSubAccountTransfer,
+		Timestamp: timestamp,
+		Params:    params,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create signature: %w", err)
+	}
+
+	body := api.Request{
+		ID:        id,
+		Method:    methodCreateSubAccountTransfer,
+		Nonce:     timestamp,
+		Params:    params,
+		Signature: signature,
+		APIKey:    creds.APIKey,
+	}
+
+	var createSubAccountTransferResponse CreateSubAccountTransferResponse
+	statusCode, err := c.requester.Post(ctx, body, methodCreateSubAccountTransfer, &createSubAccountTransferResponse)
+	if err != nil {
+		return fmt.Errorf("failed to execute post request: %w", err)
+	}
+
+	if err := c.requester.CheckResponse(statusCode, api.BaseResponse(createSubAccountTransferResponse.BaseResponse)); err != nil {
+		return fmt.Errorf("error received in response: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,291 @@
+package cdcexchange_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/jonboulle/clockwork"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	cdcexchange "github.com/sngyai/go-cryptocom/v2"
+	cdcerrors "github.com/sngyai/go-cryptocom/v2/errors"
+	"github.com/sngyai/go-cryptocom/v2/internal/api"
+	"github.com/sngyai/go-cryptocom/v2/internal/auth"
+	id_mocks "github.com/sngyai/go-cryptocom/v2/internal/mocks/id"
+	signature_mocks "github.com/sngyai/go-cryptocom/v2/internal/mocks/signature"
+)
+
+func TestClient_CreateSubAccountTransfer_Error(t *testing.T) {
+	const (
+		apiKey    = "some api key"
+		secretKey = "some secret key"
+		id        = int64(1234)
+		from      = "some from uuid"
+		to        = "some to uuid"
+		currency  = "CRO"
+	)
+	var (
+		amount = cdcexchange.MustParseDecimal("1.5")
+	)
+	testErr := errors.New("some error")
+
+	validReq := cdcexchange.CreateSubAccountTransferRequest{
+		From:     from,
+		To:       to,
+		Currency: currency,
+		Amount:   amount,
+	}
+
+	tests := []struct {
+		name         string
+		req          cdcexchange.CreateSubAccountTransferRequest
+		client       http.Client
+		signatureErr error
+		expectedErr  error
+	}{
+		{
+			name:        "returns error when from is empty",
+			req:         cdcexchange.CreateSubAccountTransferRequest{To: to, Currency: currency, Amount: amount},
+			expectedErr: cdcerrors.InvalidParameterError{Parameter: "req.From", Reason: "cannot be empty"},
+		},
+		{
+			name:        "returns error when to is empty",
+			req:         cdcexchange.CreateSubAccountTransferRequest{From: from, Currency: currency, Amount: amount},
+			expectedErr: cdcerrors.InvalidParameterError{Parameter: "req.To", Reason: "cannot be empty"},
+		},
+		{
+			name:        "returns error when from and to are the same",
+			req:         cdcexchange.CreateSubAccountTransferRequest{From: from, To: from, Currency: currency, Amount: amount},
+			expectedErr: cdcerrors.InvalidParameterError{Parameter: "req.To", Reason: "cannot be the same as req.From"},
+		},
+		{
+			name:        "returns error when currency is empty",
+			req:         cdcexchange.CreateSubAccountTransferRequest{From: from, To: to, Amount: amount},
+			expectedErr: cdcerrors.InvalidParameterError{Parameter: "req.Currency", Reason: "cannot be empty"},
+		},
+		{
+			name:        "returns error when amount is not positive",
+			req:         cdcexchange.CreateSubAccountTransferRequest{From: from, To: to, Currency: currency},
+			expectedErr: cdcerrors.InvalidParameterError{Parameter: "req.Amount", Reason: "must be greater than 0"},
+		},
+		{
+			name: "returns error when direction is invalid",
+			req: cdcexchange.CreateSubAccountTransferRequest{
+				Direction: "SIDEWAYS",
+				From:      from,
+				To:        to,
+				Currency:  currency,
+				Amount:    amount,
+			},
+			expectedErr: cdcerrors.InvalidParameterError{Parameter: "req.Direction", Reason: "must be IN or OUT"},
+		},
+		{
+			name:         "returns error given error generating signature",
+			req:          validReq,
+			signatureErr: testErr,
+			expectedErr:  testErr,
+		},
+		{
+			name: "returns error given error making request",
+			req:  validReq,
+			client: http.Client{
+				Transport: roundTripper{
+					err: testErr,
+				},
+			},
+			expectedErr: testErr,
+		},
+		{
+			name: "returns error given error response",
+			req:  validReq,
+			client: http.Client{
+				Transport: roundTripper{
+					statusCode: http.StatusTeapot,
+					response: api.BaseResponse{
+						Code: "10003",
+					},
+				},
+			},
+			expectedErr: cdcerrors.ResponseError{
+				Code:           10003,
+				HTTPStatusCode: http.StatusTeapot,
+				Err:            cdcerrors.ErrIllegalIP,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl, ctx := gomock.WithContext(context.Background(), t)
+			t.Cleanup(ctrl.Finish)
+
+			var (
+				idGenerator        = id_mocks.NewMockIDGenerator(ctrl)
+				signatureGenerator = signature_mocks.NewMockSignatureGenerator(ctrl)
+				now                = time.Now()
+				clock              = clockwork.NewFakeClockAt(now)
+			)
+
+			client, err := cdcexchange.New(apiKey, secretKey,
+				cdcexchange.WithIDGenerator(idGenerator),
+				cdcexchange.WithClock(clock),
+				cdcexchange.WithHTTPClient(&tt.client),
+				cdcexchange.WithSignatureGenerator(signatureGenerator),
+			)
+			require.NoError(t, err)
+
+			var invalidParameterError cdcerrors.InvalidParameterError
+			if !errors.As(tt.expectedErr, &invalidParameterError) {
+				idGenerator.EXPECT().Generate().Return(id)
+				signatureGenerator.EXPECT().GenerateSignature(auth.SignatureRequest{
+					APIKey:    apiKey,
+					SecretKey: secretKey,
+					ID:        id,
+					Method:    cdcexchange.MethodCreateSubAccountTransfer,
+					Timestamp: now.UnixMilli(),
+					Params: map[string]interface{}{
+						"from":     from,
+						"to":       to,
+						"currency": currency,
+						"amount":   amount,
+					},
+				}).Return("signature", tt.signatureErr)
+			}
+
+			err = client.CreateSubAccountTransfer(ctx, tt.req)
+			require.Error(t, err)
+
+			assert.True(t, errors.Is(err, tt.expectedErr))
+
+			var expectedResponseError cdcerrors.ResponseError
+			if errors.As(tt.expectedErr, &expectedResponseError) {
+				var responseError cdcerrors.ResponseError
+				require.True(t, errors.As(err, &responseError))
+
+				assert.Equal(t, expectedResponseError.Code, responseError.Code)
+				assert.Equal(t, expectedResponseError.HTTPStatusCode, responseError.HTTPStatusCode)
+				assert.Equal(t, expectedResponseError.Err, responseError.Err)
+
+				assert.True(t, errors.Is(err, expectedResponseError.Err))
+			}
+		})
+	}
+}
+
+func TestClient_CreateSubAccountTransfer_Success(t *testing.T) {
+	const (
+		apiKey    = "some api key"
+		secretKey = "some secret key"
+		id        = int64(1234)
+		signature = "some signature"
+
+		from     = "some from uuid"
+		to       = "some to uuid"
+		currency = "CRO"
+	)
+	var (
+		amount = cdcexchange.MustParseDecimal("1.5")
+	)
+	now := time.Now()
+
+	tests := []struct {
+		name           string
+		req            cdcexchange.CreateSubAccountTransferRequest
+		expectedParams map[string]interface{}
+	}{
+		{
+			name: "successfully transfers funds without direction",
+			req: cdcexchange.CreateSubAccountTransferRequest{
+				From:     from,
+				To:       to,
+				Currency: currency,
+				Amount:   amount,
+			},
+			expectedParams: map[string]interface{}{
+				"from":     from,
+				"to":       to,
+				"currency": currency,
+				"amount":   amount,
+			},
+		},
+		{
+			name: "successfully transfers funds with direction",
+			req: cdcexchange.CreateSubAccountTransferRequest{
+				Direction: cdcexchange.TransferDirectionOut,
+				From:      from,
+				To:        to,
+				Currency:  currency,
+				Amount:    amount,
+			},
+			expectedParams: map[string]interface{}{
+				"direction": cdcexchange.TransferDirectionOut,
+				"from":      from,
+				"to":        to,
+				"currency":  currency,
+				"amount":    amount,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl, ctx := gomock.WithContext(context.Background(), t)
+			t.Cleanup(ctrl.Finish)
+
+			var (
+				signatureGenerator = signature_mocks.NewMockSignatureGenerator(ctrl)
+				idGenerator        = id_mocks.NewMockIDGenerator(ctrl)
+				clock              = clockwork.NewFakeClockAt(now)
+			)
+
+			s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Contains(t, r.URL.Path, cdcexchange.MethodCreateSubAccountTransfer)
+				t.Cleanup(func() { require.NoError(t, r.Body.Close()) })
+
+				var body api.Request
+				require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+
+				assert.Equal(t, cdcexchange.MethodCreateSubAccountTransfer, body.Method)
+				assert.Equal(t, id, body.ID)
+				assert.Equal(t, apiKey, body.APIKey)
+				assert.Equal(t, now.UnixMilli(), body.Nonce)
+				assert.Equal(t, signature, body.Signature)
+				assert.Equal(t, from, body.Params["from"])
+				assert.Equal(t, to, body.Params["to"])
+				assert.Equal(t, currency, body.Params["currency"])
+				assert.Equal(t, amount.String(), body.Params["amount"])
+
+				require.NoError(t, json.NewEncoder(w).Encode(cdcexchange.CreateSubAccountTransferResponse{}))
+			}))
+			t.Cleanup(s.Close)
+
+			client, err := cdcexchange.New(apiKey, secretKey,
+				cdcexchange.WithIDGenerator(idGenerator),
+				cdcexchange.WithClock(clock),
+				cdcexchange.WithHTTPClient(s.Client()),
+				cdcexchange.WithBaseURL(fmt.Sprintf("%s/", s.URL)),
+				cdcexchange.WithSignatureGenerator(signatureGenerator),
+			)
+			require.NoError(t, err)
+
+			idGenerator.EXPECT().Generate().Return(id)
+			signatureGenerator.EXPECT().GenerateSignature(auth.SignatureRequest{
+				APIKey:    apiKey,
+				SecretKey: secretKey,
+				ID:        id,
+				Method:    cdcexchange.MethodCreateSubAccountTransfer,
+				Timestamp: now.UnixMilli(),
+				Params:    tt.expectedParams,
+			}).Return(signature, nil)
+
+			err = client.CreateSubAccountTransfer(ctx, tt.req)
+			require.NoError(t, err)
+		})
+	}
+}
@@ -2,10 +2,12 @@ package cdcexchange
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 
-	"github.com/sngyai/go-cryptocom/internal/api"
-	"github.com/sngyai/go-cryptocom/internal/auth"
+	"github.com/sngyai/go-cryptocom/v2/errors"
+	"github.com/sngyai/go-cryptocom/v2/internal/api"
+	"github.com/sngyai/go-cryptocom/v2/internal/auth"
 )
 
 const (
@@ -24,36 +26,71 @@ type (
 	CreateWithdrawalRequest struct {
 		// Currency represents the currency symbol for the withdrawals (e.g. BTC or ETH).
 		// if Currency is omitted, all currencies will be returned.
-		Currency string  `json:"currency"`
-		Amount   float64 `json:"amount"`
-		Address  string  `json:"address"`
-
-		ClientWid  string `json:"client_wid"`
+		Currency string `json:"currency"`
+		// Amount is the amount to withdraw, which must be greater than 0.
+		Amount Decimal `json:"amount"`
+		// Address is the address to withdraw to, which must be whitelisted.
+		Address string `json:"address"`
+		// ClientWID is an optional client withdrawal ID.
+		ClientWID string `json:"client_wid"`
+		// AddressTag is the secondary address identifier for currencies that require one (e.g. the memo of XRP).
 		AddressTag string `json:"address_tag"`
-		NetworkId  string `json:"network_id"`
+		// NetworkID is the network to withdraw on (e.g. ETH), see GetCurrencyNetworks. The default network is used if empty.
+		NetworkID string `json:"network_id"`
 	}
 
 	// CreateWithdrawalResponse is the base response returned from the private/create-withdrawal API.
 	CreateWithdrawalResponse struct {
-		// api.BaseResponse is the common response fields.
-		api.BaseResponse
+		// BaseResponse is the common response fields.
+		BaseResponse
 		// Result is the response attributes of the endpoint.
 		Result CreateWithdrawalResult `json:"result"`
 	}
 
 	// CreateWithdrawalResult is the result returned from the private/create-withdrawal API.
 	CreateWithdrawalResult struct {
-		Id         int64   `json:"id"`
-		Amount     float64 `json:"amount"`
-		Fee        float64 `json:"fee"`
-		Symbol     string  `json:"symbol"`
-		Address    string  `json:"address"`
-		ClientWid  string  `json:"client_wid"`
-		CreateTime int64   `json:"create_time"`
-		NetworkId  string  `json:"network_id"`
+		// ID is the withdrawal ID.
+		ID int64 `json:"id"`
+		// Amount is the amount withdrawn.
+		Amount Decimal `json:"amount"`
+		// Fee is the fee charged for the withdrawal.
+		Fee Decimal `json:"fee"`
+		// Symbol is the currency symbol of the withdrawal (e.g. BTC or ETH).
+		Symbol string `json:"symbol"`
+		// Address is the address the withdrawal is sent to.
+		Address string `json:"address"`
+		// ClientWID is the optional client withdrawal ID.
+		ClientWID string `json:"client_wid"`
+		// CreateTime is the time the withdrawal was created (milliseconds since the Unix epoch).
+		CreateTime int64 `json:"create_time"`
+		// NetworkID is the network the withdrawal is sent on (e.g. ETH), empty if the default network is used.
+		NetworkID string `json:"network_id"`
+		// Raw is the raw JSON of the result, for fields not decoded by this package.
+		Raw json.RawMessage `json:"-"`
 	}
 )
 
+// UnmarshalJSON decodes a withdrawal result, tolerating a null or numeric network_id.
+func (r *CreateWithdrawalResult) UnmarshalJSON(data []byte) error {
+	type createWithdrawalResult CreateWithdrawalResult
+	v := struct {
+		*createWithdrawalResult
+		NetworkID json.RawMessage `json:"network_id"`
+	}{createWithdrawalResult: (*createWithdrawalResult)(r)}
+
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+
+	networkID, err := unmarshalID("network_id", v.NetworkID)
+	if err != nil {
+		return err
+	}
+	r.NetworkID = networkID
+
+	return nil
+}
+
 // CreateWithdrawal gets the withdrawal history for a particular instrument.
 //
 // Pagination is handled using page size (Default: 20, Max: 200) & number (0-based).
@@ -63,21 +100,28 @@ type (
 //
 // Method: private/create-withdrawal
 func (c *Client) CreateWithdrawal(ctx context.Context, req CreateWithdrawalRequest) (*CreateWithdrawalResult, error) {
+	if req.Amount.Sign() <= 0 {
+		return nil, errors.InvalidParameterError{Parameter: "req.Amount", Reason: "must be greater than 0"}
+	}
+
 	var (
 		id        = c.idGenerator.Generate()
-		timestamp = c.clock.Now().UnixMilli()
+		timestamp = c.now().UnixMilli()
 		params    = make(map[string]interface{})
 	)
 
+	creds, err := c.credentials(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	if req.Currency != "" {
 		params["currency"] = req.Currency
 	}
-	if req.ClientWid != "" {
-		params["client_wid"] = req.ClientWid
-	}
-	if req.Amount != 0 {
-		params["amount"] = req.Amount
+	if req.ClientWID != "" {
+		params["client_wid"] = req.ClientWID
 	}
+	params["amount"] = req.Amount
 	if req.Address != "" {
 		params["address"] = req.Address
 	}
@@ -85,13 +129,13 @@ func (c *Client) CreateWithdrawal(ctx context.Context, req CreateWithdrawalReque
 	if req.AddressTag != "" {
 		params["address_tag"] = req.AddressTag
 	}
-	if req.NetworkId != "" {
-		params["network_id"] = req.NetworkId
+	if req.NetworkID != "" {
+		params["network_id"] = req.NetworkID
 	}
 
 	signature, err := c.signatureGenerator.GenerateSignature(auth.SignatureRequest{
-		APIKey:    c.apiKey,
-		SecretKey: c.secretKey,
+		APIKey:    creds.APIKey,
+		SecretKey: creds.SecretKey,
 		ID:        id,
 		Method:    methodCreateWithdrawal,
 		Timestamp: timestamp,
@@ -107,7 +151,7 @@ func (c *Client) CreateWithdrawal(ctx context.Context, req CreateWithdrawalReque
 		Nonce:     timestamp,
 		Params:    params,
 		Signature: signature,
-		APIKey:    c.apiKey,
+		APIKey:    creds.APIKey,
 	}
 
 	var CreateWithdrawalResponse CreateWithdrawalResponse
@@ -116,9 +160,11 @@ func (c *Client) CreateWithdrawal(ctx context.Context, req CreateWithdrawalReque
 		return nil, fmt.Errorf("failed to execute post request: %w", err)
 	}
 
-	if err := c.requester.CheckErrorResponse(statusCode, CreateWithdrawalResponse.Code); err != nil {
+	if err := c.requester.CheckResponse(statusCode, api.BaseResponse(CreateWithdrawalResponse.BaseResponse)); err != nil {
 		return nil, fmt.Errorf("error received in response: %w", err)
 	}
 
+	CreateWithdrawalResponse.Result.Raw = CreateWithdrawalResponse.RawResult
+
 	return &CreateWithdrawalResponse.Result, nil
 }
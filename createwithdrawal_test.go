@@ -0,0 +1,49 @@
+package cdcexchange_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	cdcexchange "github.com/sngyai/go-cryptocom/v2"
+	cdcerrors "github.com/sngyai/go-cryptocom/v2/errors"
+)
+
+func TestClient_CreateWithdrawal_Error(t *testing.T) {
+	const (
+		apiKey    = "some api key"
+		secretKey = "some secret key"
+	)
+
+	tests := []struct {
+		name        string
+		req         cdcexchange.CreateWithdrawalRequest
+		expectedErr error
+	}{
+		{
+			name:        "returns error when amount is 0",
+			req:         cdcexchange.CreateWithdrawalRequest{Currency: "BTC", Address: "some address"},
+			expectedErr: cdcerrors.InvalidParameterError{Parameter: "req.Amount", Reason: "must be greater than 0"},
+		},
+		{
+			name:        "returns error when amount is less than 0",
+			req:         cdcexchange.CreateWithdrawalRequest{Currency: "BTC", Amount: cdcexchange.MustParseDecimal("-1"), Address: "some address"},
+			expectedErr: cdcerrors.InvalidParameterError{Parameter: "req.Amount", Reason: "must be greater than 0"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client, err := cdcexchange.New(apiKey, secretKey)
+			require.NoError(t, err)
+
+			withdrawal, err := client.CreateWithdrawal(context.Background(), tt.req)
+			require.Error(t, err)
+
+			assert.Nil(t, withdrawal)
+			assert.True(t, errors.Is(err, tt.expectedErr))
+		})
+	}
+}
@@ -0,0 +1,285 @@
+package cdcexchange
+
+import (
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+// Decimal is an exact decimal number, used for prices, quantities, amounts, fees and rates,
+// which cannot be represented exactly as float64 (e.g. 0.1, or prices of assets with very small ticks).
+//
+// The zero value is 0. Decimals are decoded from JSON strings or numbers (null and "" are decoded as 0),
+// and encoded as JSON strings, as expected by the Exchange.
+//
+// Code using float64 values can be migrated with NewDecimalFromFloat and Decimal.Float64.
+type Decimal struct {
+	// s is the canonical representation of the decimal: no exponent, no trailing zeros
+	// after the decimal point, and empty for 0 (so that the zero value is 0 and Decimals can be compared with ==).
+	s string
+}
+
+// ParseDecimal parses s as a decimal number (e.g. "1.5", "-0.00000001" or "1e-8").
+func ParseDecimal(s string) (Decimal, error) {
+	if s == "" || strings.ContainsAny(s, "/bBoOxXpP_") {
+		return Decimal{}, fmt.Errorf("invalid decimal %q", s)
+	}
+
+	r, ok := new(big.Rat).SetString(s)
+	if !ok {
+		return Decimal{}, fmt.Errorf("invalid decimal %q", s)
+	}
+
+	return newDecimalFromRat(r), nil
+}
+
+// MustParseDecimal is like ParseDecimal, but panics if s is not a decimal number.
+// It should only be used for constants (e.g. MustParseDecimal("0.0001")).
+func MustParseDecimal(s string) Decimal {
+	d, err := ParseDecimal(s)
+	if err != nil {
+		panic(err)
+	}
+
+	return d
+}
+
+// NewDecimalFromFloat returns the shortest decimal which converts back to f (e.g. 0.1 for 0.1, not 0.1000000000000000055511151231257827).
+//
+// NaN and ±Inf are returned as 0.
+func NewDecimalFromFloat(f float64) Decimal {
+	d, err := ParseDecimal(strconv.FormatFloat(f, 'f', -1, 64))
+	if err != nil {
+		return Decimal{}
+	}
+
+	return d
+}
+
+// NewDecimalFromInt returns i as a Decimal.
+func NewDecimalFromInt(i int64) Decimal {
+	return newDecimalFromRat(new(big.Rat).SetInt64(i))
+}
+
+// newDecimalFromRat returns r as a Decimal.
+// r must be a finite decimal (its denominator only having the prime factors 2 and 5).
+func newDecimalFromRat(r *big.Rat) Decimal {
+	if r.Sign() == 0 {
+		return Decimal{}
+	}
+
+	s := r.FloatString(decimalPlaces(r.Denom()))
+	if strings.Contains(s, ".") {
+		s = strings.TrimRight(strings.TrimRight(s, "0"), ".")
+	}
+
+	return Decimal{s: s}
+}
+
+// newDecimalFromRatRounded returns r as a Decimal, rounded to places decimal places (with halves rounded away from 0).
+func newDecimalFromRatRounded(r *big.Rat, places int) Decimal {
+	rounded, _ := new(big.Rat).SetString(r.FloatString(places))
+
+	return newDecimalFromRat(rounded)
+}
+
+// decimalPlaces returns the number of decimal places needed to represent a fraction with denominator denom exactly,
+// the larger of its number of factors of 2 and of 5.
+func decimalPlaces(denom *big.Int) int {
+	twos := int(denom.TrailingZeroBits())
+
+	var (
+		fives = 0
+		q     = new(big.Int).Rsh(denom, uint(twos))
+		m     = new(big.Int)
+		five  = big.NewInt(5)
+	)
+	for q.Cmp(big.NewInt(1)) > 0 {
+		q.DivMod(q, five, m)
+		if m.Sign() != 0 {
+			break
+		}
+		fives++
+	}
+
+	if fives > twos {
+		return fives
+	}
+	return twos
+}
+
+// String returns d without an exponent (e.g. "0.00000001").
+func (d Decimal) String() string {
+	if d.s == "" {
+		return "0"
+	}
+
+	return d.s
+}
+
+// Float64 returns the float64 nearest to d.
+func (d Decimal) Float64() float64 {
+	f, _ := strconv.ParseFloat(d.String(), 64)
+	return f
+}
+
+// IsZero returns whether d is 0.
+func (d Decimal) IsZero() bool {
+	return d.s == ""
+}
+
+// Sign returns -1 if d < 0, 0 if d is 0 and 1 if d > 0.
+func (d Decimal) Sign() int {
+	switch {
+	case d.s == "":
+		return 0
+	case d.s[0] == '-':
+		return -1
+	default:
+		return 1
+	}
+}
+
+// Cmp returns -1 if d < other, 0 if d == other and 1 if d > other.
+func (d Decimal) Cmp(other Decimal) int {
+	return d.rat().Cmp(other.rat())
+}
+
+// Equal returns whether d and other are the same number.
+func (d Decimal) Equal(other Decimal) bool {
+	return d == other
+}
+
+// Add returns d + other.
+func (d Decimal) Add(other Decimal) Decimal {
+	return newDecimalFromRat(new(big.Rat).Add(d.rat(), other.rat()))
+}
+
+// Sub returns d - other.
+func (d Decimal) Sub(other Decimal) Decimal {
+	return newDecimalFromRat(new(big.Rat).Sub(d.rat(), other.rat()))
+}
+
+// Mul returns d * other.
+func (d Decimal) Mul(other Decimal) Decimal {
+	return newDecimalFromRat(new(big.Rat).Mul(d.rat(), other.rat()))
+}
+
+// Neg returns -d.
+func (d Decimal) Neg() Decimal {
+	return newDecimalFromRat(new(big.Rat).Neg(d.rat()))
+}
+
+// Abs returns the absolute value of d.
+func (d Decimal) Abs() Decimal {
+	if d.Sign() < 0 {
+		return d.Neg()
+	}
+
+	return d
+}
+
+// RoundingMode is the direction a value is rounded in to a multiple of a tick size.
+type RoundingMode int
+
+const (
+	// RoundNearest rounds to the nearest multiple, with halves rounded away from 0.
+	RoundNearest RoundingMode = iota
+	// RoundDown rounds towards negative infinity (floor), e.g. for the price of a buy order
+	// so that it never pays more than intended.
+	RoundDown
+	// RoundUp rounds towards positive infinity (ceiling), e.g. for the price of a sell order
+	// so that it never receives less than intended.
+	RoundUp
+	// RoundTowardZero truncates the value, e.g. for quantities so that they never exceed the available balance.
+	RoundTowardZero
+)
+
+// roundToStep rounds d to a multiple of step in the direction of mode.
+// d is returned unchanged if step is not positive.
+func roundToStep(d Decimal, step Decimal, mode RoundingMode) Decimal {
+	if step.Sign() <= 0 {
+		return d
+	}
+
+	q := new(big.Rat).Quo(d.rat(), step.rat())
+	n, rem := new(big.Int).QuoRem(q.Num(), q.Denom(), new(big.Int))
+	switch {
+	case rem.Sign() == 0:
+	case mode == RoundNearest:
+		rem.Abs(rem).Lsh(rem, 1)
+		if rem.Cmp(q.Denom()) >= 0 {
+			n.Add(n, big.NewInt(int64(q.Sign())))
+		}
+	case mode == RoundDown && q.Sign() < 0:
+		n.Sub(n, big.NewInt(1))
+	case mode == RoundUp && q.Sign() > 0:
+		n.Add(n, big.NewInt(1))
+	}
+
+	return newDecimalFromRat(new(big.Rat).Mul(new(big.Rat).SetInt(n), step.rat()))
+}
+
+// quoToStep returns d divided by n, rounded towards zero to a multiple of step,
+// or to the decimal places of d if step is not positive.
+func quoToStep(d Decimal, n int64, step Decimal) Decimal {
+	if step.Sign() <= 0 {
+		step = newDecimalFromRat(new(big.Rat).SetFrac(big.NewInt(1), new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(d.decimalPlaces())), nil)))
+	}
+
+	q := new(big.Rat).Quo(d.rat(), new(big.Rat).Mul(new(big.Rat).SetInt64(n), step.rat()))
+	steps := new(big.Int).Quo(q.Num(), q.Denom())
+
+	return newDecimalFromRat(new(big.Rat).Mul(new(big.Rat).SetInt(steps), step.rat()))
+}
+
+// isMultiple returns whether d is a multiple of step (always true if step is not positive).
+func isMultiple(d Decimal, step Decimal) bool {
+	return roundToStep(d, step, RoundTowardZero) == d
+}
+
+// decimalPlaces returns the number of digits after the decimal point of d.
+func (d Decimal) decimalPlaces() int {
+	return decimalPlaces(d.rat().Denom())
+}
+
+func (d Decimal) rat() *big.Rat {
+	r, ok := new(big.Rat).SetString(d.String())
+	if !ok {
+		return new(big.Rat)
+	}
+
+	return r
+}
+
+// MarshalJSON encodes d as a JSON string (e.g. "0.00000001").
+func (d Decimal) MarshalJSON() ([]byte, error) {
+	return []byte(strconv.Quote(d.String())), nil
+}
+
+// UnmarshalJSON decodes d from a JSON string or number. null and "" are decoded as 0.
+func (d *Decimal) UnmarshalJSON(data []byte) error {
+	s := string(data)
+	if s == "null" {
+		*d = Decimal{}
+		return nil
+	}
+
+	if unquoted, err := strconv.Unquote(s); err == nil {
+		s = unquoted
+	}
+
+	if s == "" {
+		*d = Decimal{}
+		return nil
+	}
+
+	parsed, err := ParseDecimal(s)
+	if err != nil {
+		return err
+	}
+
+	*d = parsed
+	return nil
+}
@@ -0,0 +1,117 @@
+package cdcexchange_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	cdcexchange "github.com/sngyai/go-cryptocom/v2"
+)
+
+func TestParseDecimal(t *testing.T) {
+	tests := []struct {
+		name     string
+		s        string
+		expected string
+		wantErr  bool
+	}{
+		{name: "integer", s: "42", expected: "42"},
+		{name: "fraction", s: "0.1", expected: "0.1"},
+		{name: "trailing zeros are removed", s: "1.2300", expected: "1.23"},
+		{name: "negative", s: "-0.00000001", expected: "-0.00000001"},
+		{name: "exponent", s: "1e-8", expected: "0.00000001"},
+		{name: "positive exponent", s: "1.5E3", expected: "1500"},
+		{name: "zero", s: "0.000", expected: "0"},
+		{name: "negative zero", s: "-0", expected: "0"},
+		{name: "more digits than float64 can represent", s: "12345678901234567890.123456789", expected: "12345678901234567890.123456789"},
+		{name: "empty", s: "", wantErr: true},
+		{name: "not a number", s: "abc", wantErr: true},
+		{name: "fraction", s: "1/3", wantErr: true},
+		{name: "hex", s: "0x10", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d, err := cdcexchange.ParseDecimal(tt.s)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.expected, d.String())
+		})
+	}
+}
+
+func TestNewDecimalFromFloat(t *testing.T) {
+	assert.Equal(t, "0.1", cdcexchange.NewDecimalFromFloat(0.1).String())
+	assert.Equal(t, "0.00000001", cdcexchange.NewDecimalFromFloat(1e-8).String())
+	assert.Equal(t, "-1234.5", cdcexchange.NewDecimalFromFloat(-1234.5).String())
+	assert.Equal(t, cdcexchange.Decimal{}, cdcexchange.NewDecimalFromFloat(0))
+}
+
+func TestDecimal_Arithmetic(t *testing.T) {
+	var (
+		a = cdcexchange.MustParseDecimal("0.1")
+		b = cdcexchange.MustParseDecimal("0.2")
+	)
+
+	assert.Equal(t, cdcexchange.MustParseDecimal("0.3"), a.Add(b))
+	assert.Equal(t, cdcexchange.MustParseDecimal("-0.1"), a.Sub(b))
+	assert.Equal(t, cdcexchange.MustParseDecimal("0.02"), a.Mul(b))
+	assert.Equal(t, cdcexchange.MustParseDecimal("-0.1"), a.Neg())
+	assert.Equal(t, a, a.Neg().Abs())
+	assert.True(t, a.Sub(a).IsZero())
+	assert.Equal(t, cdcexchange.Decimal{}, a.Sub(a))
+
+	assert.Equal(t, -1, a.Cmp(b))
+	assert.Equal(t, 1, b.Cmp(a))
+	assert.Equal(t, 0, a.Cmp(cdcexchange.MustParseDecimal("0.10")))
+	assert.True(t, a.Equal(cdcexchange.MustParseDecimal("1e-1")))
+
+	assert.Equal(t, 1, a.Sign())
+	assert.Equal(t, -1, a.Neg().Sign())
+	assert.Equal(t, 0, cdcexchange.Decimal{}.Sign())
+
+	assert.Equal(t, 0.1, a.Float64())
+	assert.Equal(t, "7", cdcexchange.NewDecimalFromInt(7).String())
+}
+
+func TestDecimal_JSON(t *testing.T) {
+	t.Run("decodes strings, numbers, null and empty strings", func(t *testing.T) {
+		var v struct {
+			String      cdcexchange.Decimal `json:"string"`
+			Number      cdcexchange.Decimal `json:"number"`
+			Null        cdcexchange.Decimal `json:"null"`
+			EmptyString cdcexchange.Decimal `json:"empty_string"`
+		}
+		require.NoError(t, json.Unmarshal([]byte(`{
+			"string": "0.00000001",
+			"number": 19600.1,
+			"null": null,
+			"empty_string": ""
+		}`), &v))
+
+		assert.Equal(t, "0.00000001", v.String.String())
+		assert.Equal(t, "19600.1", v.Number.String())
+		assert.True(t, v.Null.IsZero())
+		assert.True(t, v.EmptyString.IsZero())
+	})
+
+	t.Run("returns error given an invalid decimal", func(t *testing.T) {
+		var d cdcexchange.Decimal
+		require.Error(t, json.Unmarshal([]byte(`"abc"`), &d))
+	})
+
+	t.Run("encodes as a string", func(t *testing.T) {
+		b, err := json.Marshal(map[string]cdcexchange.Decimal{
+			"price": cdcexchange.MustParseDecimal("0.00001234"),
+			"zero":  {},
+		})
+		require.NoError(t, err)
+
+		assert.JSONEq(t, `{"price": "0.00001234", "zero": "0"}`, string(b))
+	})
+}
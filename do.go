@@ -0,0 +1,90 @@
+package cdcexchange
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/sngyai/go-cryptocom/v2/errors"
+	"github.com/sngyai/go-cryptocom/v2/internal/api"
+	"github.com/sngyai/go-cryptocom/v2/internal/auth"
+)
+
+// DoResponse is the base response returned from a private API called with Do.
+type DoResponse struct {
+	// BaseResponse is the common response fields.
+	BaseResponse
+	// Result is the undecoded result of the response.
+	Result json.RawMessage `json:"result"`
+}
+
+// Do calls the private method with params and decodes the result of the response into result,
+// for methods (or fields) not covered by this package yet, or consumers doing their own decoding.
+//
+// Numbers decoded into interface{} values are float64, or json.Number if WithUseNumber is used:
+//
+//	client, err := cdcexchange.New(apiKey, secretKey, cdcexchange.WithUseNumber())
+//	...
+//	var result map[string]interface{}
+//	err = client.Do(ctx, "private/get-order-detail", map[string]interface{}{"order_id": orderID}, &result)
+//
+// result can be nil to ignore the result.
+func (c *Client) Do(ctx context.Context, method string, params map[string]interface{}, result interface{}) error {
+	if !strings.HasPrefix(method, "private/") {
+		return errors.InvalidParameterError{Parameter: "method", Reason: "must be a private method"}
+	}
+	if params == nil {
+		params = make(map[string]interface{})
+	}
+
+	var (
+		id        = c.idGenerator.Generate()
+		timestamp = c.now().UnixMilli()
+	)
+
+	creds, err := c.credentials(ctx)
+	if err != nil {
+		return err
+	}
+
+	signature, err := c.signatureGenerator.GenerateSignature(auth.SignatureRequest{
+		APIKey:    creds.APIKey,
+		SecretKey: creds.SecretKey,
+		ID:        id,
+		Method:    method,
+		Timestamp: timestamp,
+		Params:    params,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create signature: %w", err)
+	}
+
+	body := api.Request{
+		ID:        id,
+		Method:    method,
+		Nonce:     timestamp,
+		Params:    params,
+		Signature: signature,
+		APIKey:    creds.APIKey,
+	}
+
+	var doResponse DoResponse
+	statusCode, err := c.requester.Post(ctx, body, method, &doResponse)
+	if err != nil {
+		return fmt.Errorf("failed to execute post request: %w", err)
+	}
+
+	if err := c.requester.CheckResponse(statusCode, api.BaseResponse(doResponse.BaseResponse)); err != nil {
+		return fmt.Errorf("error received in response: %w", err)
+	}
+
+	if result == nil || len(doResponse.Result) == 0 {
+		return nil
+	}
+	if err := c.requester.Unmarshal(doResponse.Result, result); err != nil {
+		return fmt.Errorf("failed to unmarshal result: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,145 @@
+package cdcexchange_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/jonboulle/clockwork"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	cdcexchange "github.com/sngyai/go-cryptocom/v2"
+	cdcerrors "github.com/sngyai/go-cryptocom/v2/errors"
+	"github.com/sngyai/go-cryptocom/v2/internal/api"
+	"github.com/sngyai/go-cryptocom/v2/internal/auth"
+	id_mocks "github.com/sngyai/go-cryptocom/v2/internal/mocks/id"
+	signature_mocks "github.com/sngyai/go-cryptocom/v2/internal/mocks/signature"
+)
+
+func TestClient_Do_Error(t *testing.T) {
+	const (
+		apiKey    = "some api key"
+		secretKey = "some secret key"
+	)
+
+	client, err := cdcexchange.New(apiKey, secretKey)
+	require.NoError(t, err)
+
+	var result map[string]interface{}
+	err = client.Do(context.Background(), "public/get-book", nil, &result)
+	require.Error(t, err)
+
+	assert.Empty(t, result)
+	assert.True(t, errors.Is(err, cdcerrors.InvalidParameterError{Parameter: "method", Reason: "must be a private method"}))
+}
+
+func TestClient_Do_Success(t *testing.T) {
+	const (
+		apiKey    = "some api key"
+		secretKey = "some secret key"
+		id        = int64(1234)
+		signature = "some signature"
+		method    = "private/get-order-detail"
+		orderID   = "1234"
+	)
+	now := time.Now()
+
+	tests := []struct {
+		name           string
+		opts           []cdcexchange.ClientOption
+		expectedResult map[string]interface{}
+	}{
+		{
+			name: "decodes numbers as float64 by default",
+			expectedResult: map[string]interface{}{
+				"order_id":            "1234",
+				"quantity":            "0.010000",
+				"limit_price":         float64(9668.44),
+				"create_time":         float64(1613575617173),
+				"cumulative_quantity": float64(0.01),
+			},
+		},
+		{
+			name: "decodes numbers as json.Number with WithUseNumber",
+			opts: []cdcexchange.ClientOption{cdcexchange.WithUseNumber()},
+			expectedResult: map[string]interface{}{
+				"order_id":            "1234",
+				"quantity":            "0.010000",
+				"limit_price":         json.Number("9668.440"),
+				"create_time":         json.Number("1613575617173"),
+				"cumulative_quantity": json.Number("0.010000"),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl, ctx := gomock.WithContext(context.Background(), t)
+			t.Cleanup(ctrl.Finish)
+
+			var (
+				signatureGenerator = signature_mocks.NewMockSignatureGenerator(ctrl)
+				idGenerator        = id_mocks.NewMockIDGenerator(ctrl)
+				clock              = clockwork.NewFakeClockAt(now)
+			)
+
+			s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Contains(t, r.URL.Path, method)
+				t.Cleanup(func() { require.NoError(t, r.Body.Close()) })
+
+				var body api.Request
+				require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+
+				assert.Equal(t, method, body.Method)
+				assert.Equal(t, signature, body.Signature)
+				assert.Equal(t, orderID, body.Params["order_id"])
+
+				_, err := w.Write([]byte(`{
+					"id": 1234,
+					"method": "private/get-order-detail",
+					"code": 0,
+					"result": {
+						"order_id": "1234",
+						"quantity": "0.010000",
+						"limit_price": 9668.440,
+						"create_time": 1613575617173,
+						"cumulative_quantity": 0.010000
+					}
+				}`))
+				require.NoError(t, err)
+			}))
+			t.Cleanup(s.Close)
+
+			client, err := cdcexchange.New(apiKey, secretKey, append([]cdcexchange.ClientOption{
+				cdcexchange.WithIDGenerator(idGenerator),
+				cdcexchange.WithClock(clock),
+				cdcexchange.WithHTTPClient(s.Client()),
+				cdcexchange.WithBaseURL(fmt.Sprintf("%s/", s.URL)),
+				cdcexchange.WithSignatureGenerator(signatureGenerator),
+			}, tt.opts...)...)
+			require.NoError(t, err)
+
+			idGenerator.EXPECT().Generate().Return(id)
+			signatureGenerator.EXPECT().GenerateSignature(auth.SignatureRequest{
+				APIKey:    apiKey,
+				SecretKey: secretKey,
+				ID:        id,
+				Method:    method,
+				Timestamp: now.UnixMilli(),
+				Params:    map[string]interface{}{"order_id": orderID},
+			}).Return(signature, nil)
+
+			var result map[string]interface{}
+			err = client.Do(ctx, method, map[string]interface{}{"order_id": orderID}, &result)
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.expectedResult, result)
+		})
+	}
+}
@@ -0,0 +1,65 @@
+package errors
+
+import (
+	"errors"
+	"net"
+	"net/http"
+)
+
+// IsRateLimited returns whether err was caused by exceeding the rate limits of the Exchange.
+func IsRateLimited(err error) bool {
+	if errors.Is(err, ErrTooManyRequests) {
+		return true
+	}
+
+	var responseErr ResponseError
+	return errors.As(err, &responseErr) && responseErr.HTTPStatusCode == http.StatusTooManyRequests
+}
+
+// IsInsufficientBalance returns whether err was caused by the account having an insufficient balance.
+func IsInsufficientBalance(err error) bool {
+	return errors.Is(err, ErrNegativeBalance) ||
+		errors.Is(err, ErrInsufficientAvailableBalance) ||
+		errors.Is(err, ErrExceedsMaxAvailableBalance)
+}
+
+// IsInvalidNonce returns whether err was caused by the nonce of the request differing too much from the time of the Exchange.
+//
+// The request can be retried with a fresh nonce (see cdcexchange.WithServerTimeSync if it keeps happening).
+func IsInvalidNonce(err error) bool {
+	return errors.Is(err, ErrInvalidNonce)
+}
+
+// IsRetryable returns whether err is transient, so the request may succeed if retried later:
+//   - rate limit errors (see IsRateLimited).
+//   - invalid nonce errors (see IsInvalidNonce).
+//   - system errors, request timeouts and 5xx responses without a known response code.
+//   - maintenance of the Exchange (see MaintenanceError for how long to wait).
+//   - an open circuit breaker.
+//   - network errors.
+//
+// Mutating requests (e.g. create-order) should only be retried if they are idempotent (e.g. have a client oid).
+func IsRetryable(err error) bool {
+	if err == nil {
+		return false
+	}
+
+	switch {
+	case IsRateLimited(err),
+		IsInvalidNonce(err),
+		errors.Is(err, ErrSystemError),
+		errors.Is(err, ErrRequestTimeout),
+		errors.Is(err, ErrExchangeMaintenance),
+		errors.Is(err, ErrCircuitOpen):
+		return true
+	}
+
+	// 5xx responses with a known response code (e.g. 306 INSUFFICIENT_AVAILABLE_BALANCE) are not transient.
+	var responseErr ResponseError
+	if errors.As(err, &responseErr) {
+		return responseErr.HTTPStatusCode >= http.StatusInternalServerError && errors.Is(responseErr.Err, ErrUnexpectedError)
+	}
+
+	var netErr net.Error
+	return errors.As(err, &netErr)
+}
@@ -0,0 +1,114 @@
+package errors
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClassify(t *testing.T) {
+	wrap := func(err error) error {
+		return fmt.Errorf("error received in response: %w", err)
+	}
+
+	tests := []struct {
+		name                        string
+		err                         error
+		expectedRetryable           bool
+		expectedRateLimited         bool
+		expectedInsufficientBalance bool
+		expectedInvalidNonce        bool
+	}{
+		{
+			name: "nil error",
+		},
+		{
+			name:                "rate limited",
+			err:                 wrap(NewResponseError(http.StatusTooManyRequests, 10006)),
+			expectedRetryable:   true,
+			expectedRateLimited: true,
+		},
+		{
+			name:                "rate limited without response code",
+			err:                 wrap(ResponseError{HTTPStatusCode: http.StatusTooManyRequests, Err: ErrUnexpectedError}),
+			expectedRetryable:   true,
+			expectedRateLimited: true,
+		},
+		{
+			name:                        "insufficient balance",
+			err:                         wrap(NewResponseError(http.StatusBadRequest, 20002)),
+			expectedInsufficientBalance: true,
+		},
+		{
+			name:                        "insufficient available balance",
+			err:                         wrap(NewResponseError(http.StatusInternalServerError, 306)),
+			expectedInsufficientBalance: true,
+		},
+		{
+			name:                 "invalid nonce",
+			err:                  wrap(NewResponseError(http.StatusBadRequest, 10007)),
+			expectedRetryable:    true,
+			expectedInvalidNonce: true,
+		},
+		{
+			name:                 "v1 invalid nonce",
+			err:                  wrap(NewResponseError(http.StatusBadRequest, 40102)),
+			expectedRetryable:    true,
+			expectedInvalidNonce: true,
+		},
+		{
+			name:              "request timeout",
+			err:               wrap(NewResponseError(http.StatusRequestTimeout, 40801)),
+			expectedRetryable: true,
+		},
+		{
+			name:              "system error",
+			err:               wrap(NewResponseError(http.StatusInternalServerError, 10001)),
+			expectedRetryable: true,
+		},
+		{
+			name:              "5xx response",
+			err:               wrap(NewResponseError(http.StatusBadGateway, -1)),
+			expectedRetryable: true,
+		},
+		{
+			name: "4xx response",
+			err:  wrap(NewResponseError(http.StatusBadRequest, 30003)),
+		},
+		{
+			name:              "maintenance",
+			err:               wrap(MaintenanceError{HTTPStatusCode: http.StatusServiceUnavailable}),
+			expectedRetryable: true,
+		},
+		{
+			name:              "circuit open",
+			err:               wrap(ErrCircuitOpen),
+			expectedRetryable: true,
+		},
+		{
+			name:              "network error",
+			err:               wrap(&net.OpError{Op: "dial", Err: fmt.Errorf("connection refused")}),
+			expectedRetryable: true,
+		},
+		{
+			name: "cancelled",
+			err:  wrap(context.Canceled),
+		},
+		{
+			name: "invalid parameter",
+			err:  InvalidParameterError{Parameter: "req.Quantity", Reason: "must be greater than 0"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expectedRetryable, IsRetryable(tt.err))
+			assert.Equal(t, tt.expectedRateLimited, IsRateLimited(tt.err))
+			assert.Equal(t, tt.expectedInsufficientBalance, IsInsufficientBalance(tt.err))
+			assert.Equal(t, tt.expectedInvalidNonce, IsInvalidNonce(tt.err))
+		})
+	}
+}
@@ -1,9 +1,11 @@
 package errors
 
 import (
+	"encoding/json"
 	"errors"
 	"fmt"
 	"net/http"
+	"time"
 )
 
 var (
@@ -42,12 +44,106 @@ var (
 	ErrMGBlockedBorrow           = errors.New("borrow has been suspended. please try again later")
 	ErrMGBlockedNewOrder         = errors.New("placing new order has been suspended. please try again later")
 	ErrMGCreditLineNotMaintained = errors.New("please ensure your credit line is maintained and try again later")
+
+	ErrOTCQuoteExpired     = errors.New("otc quote has expired")
+	ErrOTCQuoteAlreadyUsed = errors.New("otc quote has already been used")
+
+	ErrWithdrawalNotFound = errors.New("withdrawal not found")
+
+	ErrTickerNotFound = errors.New("ticker not found")
+
+	ErrEntryNotFilled = errors.New("entry order was not filled")
+
+	ErrClipNotFilled = errors.New("iceberg clip order was not filled")
+
+	ErrCircuitOpen = errors.New("circuit breaker is open after consecutive request failures")
+
+	ErrReadOnlyClient = errors.New("mutating request not allowed by read-only client")
+
+	ErrResponseIDMismatch = errors.New("response id does not match request id")
+
+	ErrExchangeMaintenance = errors.New("exchange is under maintenance")
+
+	// Exchange v1 response codes.
+	ErrNoPosition                          = errors.New("no position")
+	ErrAccountIsSuspended                  = errors.New("account is suspended")
+	ErrAccountsDoNotMatch                  = errors.New("accounts do not match")
+	ErrDuplicateClientOID                  = errors.New("duplicate client order id")
+	ErrDuplicateOrderID                    = errors.New("duplicate order id")
+	ErrInstrumentExpired                   = errors.New("instrument has expired")
+	ErrNoMarkPrice                         = errors.New("no mark price")
+	ErrInstrumentNotTradable               = errors.New("instrument is not tradable")
+	ErrInvalidInstrument                   = errors.New("instrument is invalid")
+	ErrInvalidAccount                      = errors.New("account is invalid")
+	ErrInvalidCurrency                     = errors.New("currency is invalid")
+	ErrInvalidOrderID                      = errors.New("invalid order id")
+	ErrInvalidOrderQuantity                = errors.New("invalid order quantity")
+	ErrInvalidSettleCurrency               = errors.New("invalid settlement currency")
+	ErrInvalidFeeCurrency                  = errors.New("invalid fee currency")
+	ErrInvalidPositionQuantity             = errors.New("invalid position quantity")
+	ErrInvalidOpenQuantity                 = errors.New("invalid open quantity")
+	ErrInvalidOrderType                    = errors.New("invalid order type")
+	ErrInvalidExecInst                     = errors.New("invalid exec inst")
+	ErrInvalidSide                         = errors.New("invalid side")
+	ErrInvalidTimeInForce                  = errors.New("invalid time in force")
+	ErrStaleMarkPrice                      = errors.New("stale mark price")
+	ErrNoClientOID                         = errors.New("no client order id")
+	ErrRejectedByMatchingEngine            = errors.New("rejected by matching engine")
+	ErrExceedMaximumEntryLeverage          = errors.New("exceeds maximum entry leverage")
+	ErrInvalidLeverage                     = errors.New("invalid leverage")
+	ErrInvalidSlippage                     = errors.New("invalid slippage")
+	ErrInvalidFloorPrice                   = errors.New("invalid floor price")
+	ErrInvalidRefPrice                     = errors.New("invalid ref price")
+	ErrInvalidTriggerType                  = errors.New("invalid trigger type")
+	ErrAccountIsInMarginCall               = errors.New("account is in margin call")
+	ErrExceedsAccountRiskLimit             = errors.New("exceeds account risk limit")
+	ErrExceedsPositionRiskLimit            = errors.New("exceeds position risk limit")
+	ErrOrderWillLeadToImmediateLiquidation = errors.New("order will lead to immediate liquidation")
+	ErrOrderWillTriggerMarginCall          = errors.New("order will trigger margin call")
+	ErrInsufficientAvailableBalance        = errors.New("insufficient available balance")
+	ErrInvalidOrderStatus                  = errors.New("invalid order status")
+	ErrInvalidPrice                        = errors.New("invalid price")
+	ErrMarketIsNotOpen                     = errors.New("market is not open")
+	ErrOrderPriceBeyondLiquidationPrice    = errors.New("order price beyond liquidation price")
+	ErrPositionIsInLiquidation             = errors.New("position is in liquidation")
+	ErrOrderPriceGreaterThanLimitUpPrice   = errors.New("order price is greater than the limit up price")
+	ErrOrderPriceLessThanLimitDownPrice    = errors.New("order price is less than the limit down price")
+	ErrExceedsMaxOrderSize                 = errors.New("exceeds max order size")
+	ErrFarAwayLimitPrice                   = errors.New("far away limit price")
+	ErrNoActiveOrder                       = errors.New("no active order")
+	ErrPositionDoesNotExist                = errors.New("position does not exist")
+	ErrExceedsMaxAllowedOrders             = errors.New("exceeds max allowed orders")
+	ErrExceedsMaxPositionSize              = errors.New("exceeds max position size")
+	ErrExceedsInitialMargin                = errors.New("exceeds initial margin")
+	ErrExceedsMaxAvailableBalance          = errors.New("exceeds maximum available balance")
+	ErrAccountDoesNotExist                 = errors.New("account does not exist")
+	ErrAccountIsNotActive                  = errors.New("account is not active")
+	ErrMarginUnitDoesNotExist              = errors.New("margin unit does not exist")
+	ErrMarginUnitIsSuspended               = errors.New("margin unit is suspended")
+	ErrInvalidUser                         = errors.New("invalid user")
+	ErrUserIsNotActive                     = errors.New("user is not active")
+	ErrUserNoDerivAccess                   = errors.New("user does not have derivative access")
+	ErrAccountNoDerivAccess                = errors.New("account does not have derivative access")
+	ErrBelowMinOrderSize                   = errors.New("below min. order size")
+	ErrExceedMaximumEffectiveLeverage      = errors.New("exceeds maximum effective leverage")
+	ErrInvalidCollateralPrice              = errors.New("invalid collateral price")
+	ErrInvalidMarginCalc                   = errors.New("invalid margin calculation")
+	ErrExceedAllowedSlippage               = errors.New("exceed allowed slippage")
+	ErrSubscriptionLimitExceeded           = errors.New("session subscription limit has been exceeded")
+	ErrNotFound                            = errors.New("not found")
+	ErrRequestTimeout                      = errors.New("request has timed out")
+	ErrFillOrKillRejected                  = errors.New("FILL_OR_KILL order rejected as it could not be fully filled")
+	ErrImmediateOrCancelRejected           = errors.New("IMMEDIATE_OR_CANCEL order rejected as it could not be partially filled")
+	ErrPostOnlyRejected                    = errors.New("POST_ONLY order rejected as it would have been filled immediately")
+	ErrSelfTradePrevention                 = errors.New("canceled due to self-trade prevention")
 )
 
 // InvalidParameterError is returned when a required parameter is passed that is invalid.
 type InvalidParameterError struct {
+	// Parameter is the name of the invalid parameter (e.g. req.Quantity).
 	Parameter string
-	Reason    string
+	// Reason is why the parameter is invalid (e.g. "must be greater than 0").
+	Reason string
 }
 
 func (ipe InvalidParameterError) Error() string {
@@ -56,14 +152,28 @@ func (ipe InvalidParameterError) Error() string {
 
 // ResponseError is returned when an error is returned from the API.
 type ResponseError struct {
-	Code           int64
+	// Code is the response code returned by the Exchange.
+	Code int64
+	// HTTPStatusCode is the HTTP status code of the response.
 	HTTPStatusCode int
-	Err            error
+	// Err is the sentinel error matching Code (e.g. ErrUnauthorized).
+	Err error
+	// Message is the reason the request was rejected, from the message (and detail) of the response (if any).
+	Message string
+	// Raw is the raw body of the response.
+	Raw json.RawMessage
 }
 
 // Error will return a string representation of the response error in the following format:
 // 401 Unauthorized: (10003) ip address not whitelisted
+//
+// or if the response has a message:
+// 400 Bad Request: (213) invalid order quantity: quantity is below the minimum
 func (re ResponseError) Error() string {
+	if re.Message != "" {
+		return fmt.Sprintf("%d %s: (%d) %v: %s", re.HTTPStatusCode, http.StatusText(re.HTTPStatusCode), re.Code, re.Err, re.Message)
+	}
+
 	return fmt.Sprintf("%d %s: (%d) %v", re.HTTPStatusCode, http.StatusText(re.HTTPStatusCode), re.Code, re.Err)
 }
 
@@ -71,6 +181,13 @@ func (re ResponseError) Unwrap() error {
 	return re.Err
 }
 
+// Is reports whether target is a ResponseError with the same code, HTTP status code and underlying error,
+// regardless of the Message and Raw body.
+func (re ResponseError) Is(target error) bool {
+	t, ok := target.(ResponseError)
+	return ok && t.Code == re.Code && t.HTTPStatusCode == re.HTTPStatusCode && t.Err == re.Err
+}
+
 // NewResponseError creates a new instance of ResponseError based on the status code and response code
 func NewResponseError(httpStatusCode int, code int64) error {
 	err := ResponseError{
@@ -81,19 +198,147 @@ func NewResponseError(httpStatusCode int, code int64) error {
 	switch code {
 	case 0:
 		return nil
+	case 201:
+		err.Err = ErrNoPosition
+	case 202:
+		err.Err = ErrAccountIsSuspended
+	case 203:
+		err.Err = ErrAccountsDoNotMatch
+	case 204:
+		err.Err = ErrDuplicateClientOID
+	case 205:
+		err.Err = ErrDuplicateOrderID
+	case 206:
+		err.Err = ErrInstrumentExpired
+	case 207:
+		err.Err = ErrNoMarkPrice
+	case 208:
+		err.Err = ErrInstrumentNotTradable
+	case 209:
+		err.Err = ErrInvalidInstrument
+	case 210:
+		err.Err = ErrInvalidAccount
+	case 211:
+		err.Err = ErrInvalidCurrency
+	case 212:
+		err.Err = ErrInvalidOrderID
+	case 213:
+		err.Err = ErrInvalidOrderQuantity
+	case 214:
+		err.Err = ErrInvalidSettleCurrency
+	case 215:
+		err.Err = ErrInvalidFeeCurrency
+	case 216:
+		err.Err = ErrInvalidPositionQuantity
+	case 217:
+		err.Err = ErrInvalidOpenQuantity
+	case 218:
+		err.Err = ErrInvalidOrderType
+	case 219:
+		err.Err = ErrInvalidExecInst
+	case 220:
+		err.Err = ErrInvalidSide
+	case 221:
+		err.Err = ErrInvalidTimeInForce
+	case 222:
+		err.Err = ErrStaleMarkPrice
+	case 223:
+		err.Err = ErrNoClientOID
+	case 224:
+		err.Err = ErrRejectedByMatchingEngine
+	case 225:
+		err.Err = ErrExceedMaximumEntryLeverage
+	case 226:
+		err.Err = ErrInvalidLeverage
+	case 227:
+		err.Err = ErrInvalidSlippage
+	case 228:
+		err.Err = ErrInvalidFloorPrice
+	case 229:
+		err.Err = ErrInvalidRefPrice
+	case 230:
+		err.Err = ErrInvalidTriggerType
+	case 301:
+		err.Err = ErrAccountIsInMarginCall
+	case 302:
+		err.Err = ErrExceedsAccountRiskLimit
+	case 303:
+		err.Err = ErrExceedsPositionRiskLimit
+	case 304:
+		err.Err = ErrOrderWillLeadToImmediateLiquidation
+	case 305:
+		err.Err = ErrOrderWillTriggerMarginCall
+	case 306:
+		err.Err = ErrInsufficientAvailableBalance
+	case 307:
+		err.Err = ErrInvalidOrderStatus
+	case 308:
+		err.Err = ErrInvalidPrice
+	case 309:
+		err.Err = ErrMarketIsNotOpen
+	case 310:
+		err.Err = ErrOrderPriceBeyondLiquidationPrice
+	case 311:
+		err.Err = ErrPositionIsInLiquidation
+	case 312:
+		err.Err = ErrOrderPriceGreaterThanLimitUpPrice
+	case 313:
+		err.Err = ErrOrderPriceLessThanLimitDownPrice
+	case 314:
+		err.Err = ErrExceedsMaxOrderSize
+	case 315:
+		err.Err = ErrFarAwayLimitPrice
+	case 316:
+		err.Err = ErrNoActiveOrder
+	case 317:
+		err.Err = ErrPositionDoesNotExist
+	case 318:
+		err.Err = ErrExceedsMaxAllowedOrders
+	case 319:
+		err.Err = ErrExceedsMaxPositionSize
+	case 320:
+		err.Err = ErrExceedsInitialMargin
+	case 321:
+		err.Err = ErrExceedsMaxAvailableBalance
+	case 401:
+		err.Err = ErrAccountDoesNotExist
+	case 406:
+		err.Err = ErrAccountIsNotActive
+	case 407:
+		err.Err = ErrMarginUnitDoesNotExist
+	case 408:
+		err.Err = ErrMarginUnitIsSuspended
+	case 409:
+		err.Err = ErrInvalidUser
+	case 410:
+		err.Err = ErrUserIsNotActive
+	case 411:
+		err.Err = ErrUserNoDerivAccess
+	case 412:
+		err.Err = ErrAccountNoDerivAccess
+	case 415:
+		err.Err = ErrBelowMinOrderSize
+	case 501:
+		err.Err = ErrExceedMaximumEffectiveLeverage
+	case 604:
+		err.Err = ErrInvalidCollateralPrice
+	case 605:
+		err.Err = ErrInvalidMarginCalc
+	case 606:
+		err.Err = ErrExceedAllowedSlippage
 	case 10001, 100001:
 		err.Err = ErrSystemError
-	case 10002:
+	case 10002, 40101:
 		err.Err = ErrUnauthorized
-	case 10003:
+	case 10003, 40103:
 		err.Err = ErrIllegalIP
 	case 10004:
 		err.Err = ErrBadRequest
-	case 10005:
+	case 10005, 40104:
 		err.Err = ErrUserTierInvalid
-	case 10006:
+	case 10006, 10429, 42901:
 		err.Err = ErrTooManyRequests
-	case 10007:
+	case 10007, 40102:
 		err.Err = ErrInvalidNonce
 	case 10008:
 		err.Err = ErrMethodNotFound
@@ -147,6 +392,20 @@ func NewResponseError(httpStatusCode int, code int64) error {
 		err.Err = ErrMGBlockedBorrow
 	case 40007:
 		err.Err = ErrMGBlockedNewOrder
+	case 40107:
+		err.Err = ErrSubscriptionLimitExceeded
+	case 40401:
+		err.Err = ErrNotFound
+	case 40801:
+		err.Err = ErrRequestTimeout
+	case 43003:
+		err.Err = ErrFillOrKillRejected
+	case 43004:
+		err.Err = ErrImmediateOrCancelRejected
+	case 43005:
+		err.Err = ErrPostOnlyRejected
+	case 43012:
+		err.Err = ErrSelfTradePrevention
 	case 50001:
 		err.Err = ErrMGCreditLineNotMaintained
 	default:
@@ -155,3 +414,110 @@ func NewResponseError(httpStatusCode int, code int64) error {
 
 	return err
 }
+
+// MaintenanceError is returned when the Exchange is under maintenance.
+//
+// RetryAfter is the estimated wait before retrying, from the Retry-After header of the response (if returned).
+type MaintenanceError struct {
+	// HTTPStatusCode is the HTTP status code of the response.
+	HTTPStatusCode int
+	// RetryAfter is the estimated wait before retrying, 0 if unknown.
+	RetryAfter time.Duration
+}
+
+// Error will return a string representation of the maintenance error in the following format:
+// 503 Service Unavailable: exchange is under maintenance, retry after 1m0s
+func (me MaintenanceError) Error() string {
+	return fmt.Sprintf("%d %s: %v, retry after %s", me.HTTPStatusCode, http.StatusText(me.HTTPStatusCode), ErrExchangeMaintenance, me.RetryAfter)
+}
+
+func (me MaintenanceError) Unwrap() error {
+	return ErrExchangeMaintenance
+}
+
+// UnconfirmedOrderError is returned when an order may have been created, but no response was received
+// (e.g. the connection was lost mid-flight).
+//
+// The order can be looked up by its ClientOID (e.g. with GetOrderDetailByClientOID) before retrying, to avoid duplicates.
+type UnconfirmedOrderError struct {
+	// ClientOID is the client order ID of the order.
+	ClientOID string
+	// Err is the error which left the order unconfirmed.
+	Err error
+}
+
+func (uoe UnconfirmedOrderError) Error() string {
+	return fmt.Sprintf("order with client oid %s is unconfirmed: %v", uoe.ClientOID, uoe.Err)
+}
+
+func (uoe UnconfirmedOrderError) Unwrap() error {
+	return uoe.Err
+}
+
+// DateRangeError is returned when the range between the start and end of a history request is invalid,
+// before it is sent to the Exchange (which would reject it with INVALID_DATE_RANGE).
+//
+// DateRangeError matches ErrInvalidDateRange with errors.Is.
+type DateRangeError struct {
+	// Start is the start of the requested range.
+	Start time.Time
+	// End is the end of the requested range (now if it was omitted).
+	End time.Time
+	// MaxRange is the maximum duration between Start and End accepted by the Exchange.
+	MaxRange time.Duration
+}
+
+// Error will return a string representation of the date range error in the following format:
+// invalid date range: 48h0m0s between start and end exceeds the maximum of 24h0m0s
+func (dre DateRangeError) Error() string {
+	if dre.End.Before(dre.Start) {
+		return fmt.Sprintf("%v: end %s is before start %s", ErrInvalidDateRange, dre.End.Format(time.RFC3339), dre.Start.Format(time.RFC3339))
+	}
+
+	return fmt.Sprintf("%v: %s between start and end exceeds the maximum of %s", ErrInvalidDateRange, dre.End.Sub(dre.Start), dre.MaxRange)
+}
+
+func (dre DateRangeError) Unwrap() error {
+	return ErrInvalidDateRange
+}
+
+// TickerNotFoundError is returned when no ticker is returned for an instrument.
+//
+// TickerNotFoundError matches ErrTickerNotFound with errors.Is.
+type TickerNotFoundError struct {
+	// Instrument is the instrument name of the requested ticker.
+	Instrument string
+}
+
+// Error will return a string representation of the ticker not found error in the following format:
+// ticker not found: BTC_USDT
+func (tnfe TickerNotFoundError) Error() string {
+	return fmt.Sprintf("%v: %s", ErrTickerNotFound, tnfe.Instrument)
+}
+
+func (tnfe TickerNotFoundError) Unwrap() error {
+	return ErrTickerNotFound
+}
+
+// ResponseIDMismatchError is returned when the id of a response does not match the id of the request it answers
+// (e.g. a proxy returned the response of another request).
+//
+// ResponseIDMismatchError matches ErrResponseIDMismatch with errors.Is.
+type ResponseIDMismatchError struct {
+	// Method is the method of the request.
+	Method string
+	// RequestID is the id of the request.
+	RequestID int64
+	// ResponseID is the id of the response, empty if it had none.
+	ResponseID string
+}
+
+// Error will return a string representation of the mismatch error in the following format:
+// private/create-order: response id does not match request id: request 1234, response 5678
+func (rime ResponseIDMismatchError) Error() string {
+	return fmt.Sprintf("%s: %v: request %d, response %s", rime.Method, ErrResponseIDMismatch, rime.RequestID, rime.ResponseID)
+}
+
+func (rime ResponseIDMismatchError) Unwrap() error {
+	return ErrResponseIDMismatch
+}
@@ -2,8 +2,10 @@ package errors
 
 import (
 	"errors"
+	"fmt"
 	"net/http"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -102,6 +104,16 @@ func TestNewResponseError_Error(t *testing.T) {
 			expectedCode:           10006,
 			expectedErr:            ErrTooManyRequests,
 		},
+		{
+			name: "returns 10429 TOO_MANY_REQUESTS",
+			args: args{
+				httpStatusCode: http.StatusTooManyRequests,
+				code:           10429,
+			},
+			expectedHTTPStatusCode: http.StatusTooManyRequests,
+			expectedCode:           10429,
+			expectedErr:            ErrTooManyRequests,
+		},
 		{
 			name: "returns 10007 INVALID_NONCE",
 			args: args{
@@ -427,3 +439,137 @@ func TestNewResponseError_Success(t *testing.T) {
 		})
 	}
 }
+
+func TestMaintenanceError_Error(t *testing.T) {
+	err := MaintenanceError{HTTPStatusCode: http.StatusServiceUnavailable, RetryAfter: time.Minute}
+
+	assert.Equal(t, "503 Service Unavailable: exchange is under maintenance, retry after 1m0s", err.Error())
+	assert.True(t, errors.Is(err, ErrExchangeMaintenance))
+}
+
+func TestDateRangeError_Error(t *testing.T) {
+	start := time.Date(2023, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+	err := DateRangeError{Start: start, End: start.Add(48 * time.Hour), MaxRange: 24 * time.Hour}
+	assert.Equal(t, "invalid date range: 48h0m0s between start and end exceeds the maximum of 24h0m0s", err.Error())
+	assert.True(t, errors.Is(err, ErrInvalidDateRange))
+
+	err = DateRangeError{Start: start, End: start.Add(-time.Hour), MaxRange: 24 * time.Hour}
+	assert.Equal(t, "invalid date range: end 2022-12-31T23:00:00Z is before start 2023-01-01T00:00:00Z", err.Error())
+}
+
+func TestTickerNotFoundError_Error(t *testing.T) {
+	err := TickerNotFoundError{Instrument: "BTC_USDT"}
+	assert.Equal(t, "ticker not found: BTC_USDT", err.Error())
+	assert.True(t, errors.Is(err, ErrTickerNotFound))
+}
+
+func TestNewResponseError_V1Codes(t *testing.T) {
+	tests := []struct {
+		code        int64
+		expectedErr error
+	}{
+		{code: 201, expectedErr: ErrNoPosition},
+		{code: 202, expectedErr: ErrAccountIsSuspended},
+		{code: 203, expectedErr: ErrAccountsDoNotMatch},
+		{code: 204, expectedErr: ErrDuplicateClientOID},
+		{code: 205, expectedErr: ErrDuplicateOrderID},
+		{code: 206, expectedErr: ErrInstrumentExpired},
+		{code: 207, expectedErr: ErrNoMarkPrice},
+		{code: 208, expectedErr: ErrInstrumentNotTradable},
+		{code: 209, expectedErr: ErrInvalidInstrument},
+		{code: 210, expectedErr: ErrInvalidAccount},
+		{code: 211, expectedErr: ErrInvalidCurrency},
+		{code: 212, expectedErr: ErrInvalidOrderID},
+		{code: 213, expectedErr: ErrInvalidOrderQuantity},
+		{code: 214, expectedErr: ErrInvalidSettleCurrency},
+		{code: 215, expectedErr: ErrInvalidFeeCurrency},
+		{code: 216, expectedErr: ErrInvalidPositionQuantity},
+		{code: 217, expectedErr: ErrInvalidOpenQuantity},
+		{code: 218, expectedErr: ErrInvalidOrderType},
+		{code: 219, expectedErr: ErrInvalidExecInst},
+		{code: 220, expectedErr: ErrInvalidSide},
+		{code: 221, expectedErr: ErrInvalidTimeInForce},
+		{code: 222, expectedErr: ErrStaleMarkPrice},
+		{code: 223, expectedErr: ErrNoClientOID},
+		{code: 224, expectedErr: ErrRejectedByMatchingEngine},
+		{code: 225, expectedErr: ErrExceedMaximumEntryLeverage},
+		{code: 226, expectedErr: ErrInvalidLeverage},
+		{code: 227, expectedErr: ErrInvalidSlippage},
+		{code: 228, expectedErr: ErrInvalidFloorPrice},
+		{code: 229, expectedErr: ErrInvalidRefPrice},
+		{code: 230, expectedErr: ErrInvalidTriggerType},
+		{code: 301, expectedErr: ErrAccountIsInMarginCall},
+		{code: 302, expectedErr: ErrExceedsAccountRiskLimit},
+		{code: 303, expectedErr: ErrExceedsPositionRiskLimit},
+		{code: 304, expectedErr: ErrOrderWillLeadToImmediateLiquidation},
+		{code: 305, expectedErr: ErrOrderWillTriggerMarginCall},
+		{code: 306, expectedErr: ErrInsufficientAvailableBalance},
+		{code: 307, expectedErr: ErrInvalidOrderStatus},
+		{code: 308, expectedErr: ErrInvalidPrice},
+		{code: 309, expectedErr: ErrMarketIsNotOpen},
+		{code: 310, expectedErr: ErrOrderPriceBeyondLiquidationPrice},
+		{code: 311, expectedErr: ErrPositionIsInLiquidation},
+		{code: 312, expectedErr: ErrOrderPriceGreaterThanLimitUpPrice},
+		{code: 313, expectedErr: ErrOrderPriceLessThanLimitDownPrice},
+		{code: 314, expectedErr: ErrExceedsMaxOrderSize},
+		{code: 315, expectedErr: ErrFarAwayLimitPrice},
+		{code: 316, expectedErr: ErrNoActiveOrder},
+		{code: 317, expectedErr: ErrPositionDoesNotExist},
+		{code: 318, expectedErr: ErrExceedsMaxAllowedOrders},
+		{code: 319, expectedErr: ErrExceedsMaxPositionSize},
+		{code: 320, expectedErr: ErrExceedsInitialMargin},
+		{code: 321, expectedErr: ErrExceedsMaxAvailableBalance},
+		{code: 401, expectedErr: ErrAccountDoesNotExist},
+		{code: 406, expectedErr: ErrAccountIsNotActive},
+		{code: 407, expectedErr: ErrMarginUnitDoesNotExist},
+		{code: 408, expectedErr: ErrMarginUnitIsSuspended},
+		{code: 409, expectedErr: ErrInvalidUser},
+		{code: 410, expectedErr: ErrUserIsNotActive},
+		{code: 411, expectedErr: ErrUserNoDerivAccess},
+		{code: 412, expectedErr: ErrAccountNoDerivAccess},
+		{code: 415, expectedErr: ErrBelowMinOrderSize},
+		{code: 501, expectedErr: ErrExceedMaximumEffectiveLeverage},
+		{code: 604, expectedErr: ErrInvalidCollateralPrice},
+		{code: 605, expectedErr: ErrInvalidMarginCalc},
+		{code: 606, expectedErr: ErrExceedAllowedSlippage},
+		{code: 40101, expectedErr: ErrUnauthorized},
+		{code: 40102, expectedErr: ErrInvalidNonce},
+		{code: 40103, expectedErr: ErrIllegalIP},
+		{code: 40104, expectedErr: ErrUserTierInvalid},
+		{code: 40107, expectedErr: ErrSubscriptionLimitExceeded},
+		{code: 40401, expectedErr: ErrNotFound},
+		{code: 40801, expectedErr: ErrRequestTimeout},
+		{code: 42901, expectedErr: ErrTooManyRequests},
+		{code: 43003, expectedErr: ErrFillOrKillRejected},
+		{code: 43004, expectedErr: ErrImmediateOrCancelRejected},
+		{code: 43005, expectedErr: ErrPostOnlyRejected},
+		{code: 43012, expectedErr: ErrSelfTradePrevention},
+	}
+	for _, tt := range tests {
+		t.Run(fmt.Sprintf("returns %d", tt.code), func(t *testing.T) {
+			err := NewResponseError(http.StatusBadRequest, tt.code)
+			require.Error(t, err)
+
+			var responseErr ResponseError
+			require.True(t, errors.As(err, &responseErr))
+			assert.Equal(t, tt.code, responseErr.Code)
+			assert.True(t, errors.Is(err, tt.expectedErr))
+		})
+	}
+}
+
+func TestResponseError_Error(t *testing.T) {
+	err := ResponseError{
+		Code:           213,
+		HTTPStatusCode: http.StatusBadRequest,
+		Err:            ErrInvalidOrderQuantity,
+		Message:        "some message",
+		Raw:            []byte(`{"code": 213, "message": "some message"}`),
+	}
+
+	assert.Equal(t, "400 Bad Request: (213) invalid order quantity: some message", err.Error())
+	assert.True(t, errors.Is(err, ErrInvalidOrderQuantity))
+	assert.True(t, errors.Is(err, ResponseError{Code: 213, HTTPStatusCode: http.StatusBadRequest, Err: ErrInvalidOrderQuantity}))
+	assert.False(t, errors.Is(err, ResponseError{Code: 213, HTTPStatusCode: http.StatusInternalServerError, Err: ErrInvalidOrderQuantity}))
+}
@@ -0,0 +1,24 @@
+package cdcexchange
+
+// ExecutionResult is the result of executing a parent order as child orders (e.g. with ExecuteTWAP or ExecuteIceberg).
+type ExecutionResult struct {
+	// Orders is the final state of the child orders, in the order they were placed.
+	Orders []Order
+	// FilledQuantity is the total quantity filled by the child orders.
+	FilledQuantity Decimal
+	// FilledValue is the total value filled by the child orders.
+	FilledValue Decimal
+	// FilledFee is the total fee charged for the quantity filled by the child orders.
+	FilledFee Decimal
+	// RemainingQuantity is the quantity of the parent order not filled by the child orders.
+	RemainingQuantity Decimal
+}
+
+// add adds the fills of the child order to r.
+func (r *ExecutionResult) add(order Order) {
+	r.Orders = append(r.Orders, order)
+	r.FilledQuantity = r.FilledQuantity.Add(order.CumulativeQuantity)
+	r.FilledValue = r.FilledValue.Add(order.CumulativeValue)
+	r.FilledFee = r.FilledFee.Add(order.CumulativeFee)
+	r.RemainingQuantity = r.RemainingQuantity.Sub(order.CumulativeQuantity)
+}
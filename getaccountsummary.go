@@ -4,8 +4,8 @@ import (
 	"context"
 	"fmt"
 
-	"github.com/sngyai/go-cryptocom/internal/api"
-	"github.com/sngyai/go-cryptocom/internal/auth"
+	"github.com/sngyai/go-cryptocom/v2/internal/api"
+	"github.com/sngyai/go-cryptocom/v2/internal/auth"
 )
 
 const (
@@ -15,8 +15,8 @@ const (
 type (
 	// AccountSummaryResponse is the base response returned from the private/get-account-summary API.
 	AccountSummaryResponse struct {
-		// api.BaseResponse is the common response fields.
-		api.BaseResponse
+		// BaseResponse is the common response fields.
+		BaseResponse
 		// Result is the response attributes of the endpoint.
 		Result AccountSummaryResult `json:"result"`
 	}
@@ -30,13 +30,13 @@ type (
 	// Account represents balance details of a specific token.
 	Account struct {
 		// Balance is the total balance (Available + Order + Stake).
-		Balance float64 `json:"balance"`
+		Balance Decimal `json:"balance"`
 		// Available is the available balance (e.g. not in orders, or locked, etc.).
-		Available float64 `json:"available"`
+		Available Decimal `json:"available"`
 		// Order is the balance locked in orders.
-		Order float64 `json:"order"`
+		Order Decimal `json:"order"`
 		// Stake is the balance locked for staking (typically only used for CRO).
-		Stake float64 `json:"stake"`
+		Stake Decimal `json:"stake"`
 		// Currency is the symbol for the currency (e.g. CRO).
 		Currency string `json:"currency"`
 	}
@@ -50,18 +50,23 @@ type (
 func (c *Client) GetAccountSummary(ctx context.Context, currency string) ([]Account, error) {
 	var (
 		id        = c.idGenerator.Generate()
-		timestamp = c.clock.Now().UnixMilli()
+		timestamp = c.now().UnixMilli()
 		params    = make(map[string]interface{})
 	)
 
+	creds, err := c.credentials(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	// if currency is omitted, ALL currencies are returned.
 	if currency != "" {
 		params["currency"] = currency
 	}
 
 	signature, err := c.signatureGenerator.GenerateSignature(auth.SignatureRequest{
-		APIKey:    c.apiKey,
-		SecretKey: c.secretKey,
+		APIKey:    creds.APIKey,
+		SecretKey: creds.SecretKey,
 		ID:        id,
 		Method:    methodGetAccountSummary,
 		Timestamp: timestamp,
@@ -77,7 +82,7 @@ func (c *Client) GetAccountSummary(ctx context.Context, currency string) ([]Acco
 		Nonce:     timestamp,
 		Params:    params,
 		Signature: signature,
-		APIKey:    c.apiKey,
+		APIKey:    creds.APIKey,
 	}
 
 	var accountSummaryResponse AccountSummaryResponse
@@ -86,7 +91,7 @@ func (c *Client) GetAccountSummary(ctx context.Context, currency string) ([]Acco
 		return nil, fmt.Errorf("failed to execute post request: %w", err)
 	}
 
-	if err := c.requester.CheckErrorResponse(statusCode, accountSummaryResponse.Code); err != nil {
+	if err := c.requester.CheckResponse(statusCode, api.BaseResponse(accountSummaryResponse.BaseResponse)); err != nil {
 		return nil, fmt.Errorf("error received in response: %w", err)
 	}
 
@@ -15,12 +15,12 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
-	"github.com/sngyai/go-cryptocom/internal/api"
-	"github.com/sngyai/go-cryptocom/internal/auth"
-	cdcexchange "github.com/sngyai/go-cryptocom"
-	cdcerrors "github.com/sngyai/go-cryptocom/errors"
-	id_mocks "github.com/sngyai/go-cryptocom/internal/mocks/id"
-	signature_mocks "github.com/sngyai/go-cryptocom/internal/mocks/signature"
+	"github.com/sngyai/go-cryptocom/v2/internal/api"
+	"github.com/sngyai/go-cryptocom/v2/internal/auth"
+	cdcexchange "github.com/sngyai/go-cryptocom/v2"
+	cdcerrors "github.com/sngyai/go-cryptocom/v2/errors"
+	id_mocks "github.com/sngyai/go-cryptocom/v2/internal/mocks/id"
+	signature_mocks "github.com/sngyai/go-cryptocom/v2/internal/mocks/signature"
 )
 
 func TestClient_GetAccountSummary_Error(t *testing.T) {
@@ -176,7 +176,7 @@ func TestClient_GetAccountSummary_Success(t *testing.T) {
 				assert.Equal(t, map[string]interface{}{}, body.Params)
 
 				res := cdcexchange.AccountSummaryResponse{
-					BaseResponse: api.BaseResponse{},
+					BaseResponse: cdcexchange.BaseResponse{},
 					Result: cdcexchange.AccountSummaryResult{
 						Accounts: []cdcexchange.Account{{Currency: currency}},
 					},
@@ -207,7 +207,7 @@ func TestClient_GetAccountSummary_Success(t *testing.T) {
 				assert.Equal(t, map[string]interface{}{"currency": currency}, body.Params)
 
 				res := cdcexchange.AccountSummaryResponse{
-					BaseResponse: api.BaseResponse{},
+					BaseResponse: cdcexchange.BaseResponse{},
 					Result: cdcexchange.AccountSummaryResult{
 						Accounts: []cdcexchange.Account{{Currency: currency}},
 					},
@@ -0,0 +1,153 @@
+package cdcexchange
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/sngyai/go-cryptocom/v2/errors"
+	"github.com/sngyai/go-cryptocom/v2/internal/api"
+)
+
+const (
+	methodGetAnnouncements = "public/get-announcements"
+
+	AnnouncementCategorySystem  AnnouncementCategory = "system"
+	AnnouncementCategoryList    AnnouncementCategory = "list"
+	AnnouncementCategoryDelist  AnnouncementCategory = "delist"
+	AnnouncementCategoryEvent   AnnouncementCategory = "event"
+	AnnouncementCategoryProduct AnnouncementCategory = "product"
+	AnnouncementCategoryOther   AnnouncementCategory = "other"
+
+	AnnouncementProductTypeSpot         AnnouncementProductType = "Spot"
+	AnnouncementProductTypeDerivative   AnnouncementProductType = "Derivative"
+	AnnouncementProductTypeTradingArena AnnouncementProductType = "TradingArena"
+)
+
+type (
+	// AnnouncementCategory is the category of an announcement (e.g. system for maintenance, delist for delistings).
+	AnnouncementCategory string
+	// AnnouncementProductType is the product an announcement applies to (e.g. Spot, Derivative).
+	AnnouncementProductType string
+
+	// GetAnnouncementsRequest is the request params sent for the public/get-announcements API.
+	GetAnnouncementsRequest struct {
+		// Category filters the announcements by category.
+		// if Category is omitted, announcements of all categories will be returned.
+		Category AnnouncementCategory `json:"category"`
+		// ProductType filters the announcements by product type.
+		// if ProductType is omitted, announcements for all products will be returned.
+		ProductType AnnouncementProductType `json:"product_type"`
+	}
+
+	// AnnouncementsResponse is the base response returned from the public/get-announcements API.
+	AnnouncementsResponse struct {
+		// BaseResponse is the common response fields.
+		BaseResponse
+		// Result is the response attributes of the endpoint.
+		Result AnnouncementsResult `json:"result"`
+	}
+
+	// AnnouncementsResult is the result returned from the public/get-announcements API.
+	AnnouncementsResult struct {
+		// Data is the list of announcements.
+		Data []Announcement `json:"data"`
+	}
+
+	// Announcement represents a single exchange announcement (e.g. a maintenance window or delisting).
+	Announcement struct {
+		// ID is the unique identifier of the announcement.
+		ID string `json:"id"`
+		// Category is the category of the announcement.
+		Category AnnouncementCategory `json:"category"`
+		// ProductType is the product the announcement applies to.
+		ProductType AnnouncementProductType `json:"product_type"`
+		// AnnouncedAt is the time the announcement was made.
+		AnnouncedAt Timestamp `json:"announced_at"`
+		// Title is the title of the announcement.
+		Title string `json:"title"`
+		// Content is the body of the announcement.
+		Content string `json:"content"`
+		// InstrumentName is the instrument affected by the announcement, empty if not instrument specific.
+		InstrumentName string `json:"instrument_name"`
+		// ImpactedParams describes which activities are affected during the announced window.
+		ImpactedParams AnnouncementImpactedParams `json:"impacted_params"`
+		// StartTime is the start of the announced window (e.g. when maintenance begins).
+		StartTime Timestamp `json:"start_time"`
+		// EndTime is the end of the announced window (e.g. when maintenance ends).
+		EndTime Timestamp `json:"end_time"`
+	}
+
+	// AnnouncementImpactedParams describes which activities are affected by an announcement.
+	AnnouncementImpactedParams struct {
+		// SpotTradingImpacted describes whether spot trading is affected.
+		SpotTradingImpacted string `json:"spot_trading_impacted"`
+		// DerivativeTradingImpacted describes whether derivatives trading is affected.
+		DerivativeTradingImpacted string `json:"derivative_trading_impacted"`
+		// MarginTradingImpacted describes whether margin trading is affected.
+		MarginTradingImpacted string `json:"margin_trading_impacted"`
+		// OTCTradingImpacted describes whether OTC trading is affected.
+		OTCTradingImpacted string `json:"otc_trading_impacted"`
+		// ConvertImpacted describes whether convert is affected.
+		ConvertImpacted string `json:"convert_impacted"`
+		// StakingImpacted describes whether staking is affected.
+		StakingImpacted string `json:"staking_impacted"`
+		// TradingBotImpacted describes whether trading bots are affected.
+		TradingBotImpacted string `json:"trading_bot_impacted"`
+		// CryptoWalletImpacted describes whether deposits & withdrawals are affected.
+		CryptoWalletImpacted bool `json:"crypto_wallet_impacted"`
+		// FiatWalletImpacted describes whether fiat deposits & withdrawals are affected.
+		FiatWalletImpacted bool `json:"fiat_wallet_impacted"`
+		// LoginImpacted describes whether logging in is affected.
+		LoginImpacted bool `json:"login_impacted"`
+	}
+)
+
+// GetAnnouncements fetches exchange announcements, such as maintenance windows and delistings.
+//
+// req.Category and req.ProductType can be left blank to get all announcements.
+//
+// No authentication is required.
+//
+// Method: public/get-announcements
+func (c *Client) GetAnnouncements(ctx context.Context, req GetAnnouncementsRequest) ([]Announcement, error) {
+	switch req.Category {
+	case "", AnnouncementCategorySystem, AnnouncementCategoryList, AnnouncementCategoryDelist,
+		AnnouncementCategoryEvent, AnnouncementCategoryProduct, AnnouncementCategoryOther:
+	default:
+		return nil, errors.InvalidParameterError{Parameter: "req.Category", Reason: "must be system, list, delist, event, product or other"}
+	}
+
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodGet, c.requester.URL(methodGetAnnouncements, api.V1), nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	httpReq.Header.Set("Content-Type", "application/json")
+
+	q := httpReq.URL.Query()
+	if req.Category != "" {
+		q.Add("category", string(req.Category))
+	}
+	if req.ProductType != "" {
+		q.Add("product_type", string(req.ProductType))
+	}
+	httpReq.URL.RawQuery = q.Encode()
+
+	statusCode, resBytes, err := c.requester.Do(ctx, httpReq, methodGetAnnouncements)
+	if err != nil {
+		return nil, err
+	}
+
+	var announcementsResponse AnnouncementsResponse
+	if err := json.Unmarshal(resBytes, &announcementsResponse); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response body: %w", err)
+	}
+	announcementsResponse.SetRaw(resBytes)
+
+	if err := c.requester.CheckResponse(statusCode, api.BaseResponse(announcementsResponse.BaseResponse)); err != nil {
+		return nil, fmt.Errorf("error received in response: %w", err)
+	}
+
+	return announcementsResponse.Result.Data, nil
+}
@@ -0,0 +1,149 @@
+package cdcexchange_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	cdcexchange "github.com/sngyai/go-cryptocom/v2"
+	cdcerrors "github.com/sngyai/go-cryptocom/v2/errors"
+	"github.com/sngyai/go-cryptocom/v2/internal/api"
+)
+
+func TestClient_GetAnnouncements_Error(t *testing.T) {
+	const (
+		apiKey    = "some api key"
+		secretKey = "some secret key"
+	)
+	testErr := errors.New("some error")
+
+	tests := []struct {
+		name        string
+		req         cdcexchange.GetAnnouncementsRequest
+		client      http.Client
+		expectedErr error
+	}{
+		{
+			name:        "returns error when category is invalid",
+			req:         cdcexchange.GetAnnouncementsRequest{Category: "maintenance"},
+			expectedErr: cdcerrors.InvalidParameterError{Parameter: "req.Category", Reason: "must be system, list, delist, event, product or other"},
+		},
+		{
+			name: "returns error given error making request",
+			client: http.Client{
+				Transport: roundTripper{
+					err: testErr,
+				},
+			},
+			expectedErr: testErr,
+		},
+		{
+			name: "returns error given error response",
+			client: http.Client{
+				Transport: roundTripper{
+					statusCode: http.StatusTeapot,
+					response: api.BaseResponse{
+						Code: "10003",
+					},
+				},
+			},
+			expectedErr: cdcerrors.ResponseError{
+				Code:           10003,
+				HTTPStatusCode: http.StatusTeapot,
+				Err:            cdcerrors.ErrIllegalIP,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client, err := cdcexchange.New(apiKey, secretKey,
+				cdcexchange.WithHTTPClient(&tt.client),
+			)
+			require.NoError(t, err)
+
+			announcements, err := client.GetAnnouncements(context.Background(), tt.req)
+			require.Error(t, err)
+
+			assert.Empty(t, announcements)
+			assert.True(t, errors.Is(err, tt.expectedErr))
+		})
+	}
+}
+
+func TestClient_GetAnnouncements_Success(t *testing.T) {
+	const (
+		apiKey    = "some api key"
+		secretKey = "some secret key"
+	)
+
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Contains(t, r.URL.Path, api.V1+cdcexchange.MethodGetAnnouncements)
+		assert.Equal(t, string(cdcexchange.AnnouncementCategorySystem), r.URL.Query().Get("category"))
+		assert.Equal(t, string(cdcexchange.AnnouncementProductTypeSpot), r.URL.Query().Get("product_type"))
+
+		_, err := w.Write([]byte(`{
+			"id": 1,
+			"method": "public/get-announcements",
+			"code": 0,
+			"result": {
+				"data": [
+					{
+						"id": "1701152014934-4cf5",
+						"category": "system",
+						"product_type": "Spot",
+						"announced_at": 1701152014934,
+						"title": "Scheduled System Upgrade",
+						"content": "The exchange will be unavailable during the upgrade.",
+						"instrument_name": "",
+						"impacted_params": {
+							"spot_trading_impacted": "HALT",
+							"crypto_wallet_impacted": true,
+							"login_impacted": false
+						},
+						"start_time": 1701244800000,
+						"end_time": 1701252000000
+					}
+				]
+			}
+		}`))
+		require.NoError(t, err)
+	}))
+	t.Cleanup(s.Close)
+
+	client, err := cdcexchange.New(apiKey, secretKey,
+		cdcexchange.WithHTTPClient(s.Client()),
+		cdcexchange.WithBaseURL(fmt.Sprintf("%s/", s.URL)),
+	)
+	require.NoError(t, err)
+
+	announcements, err := client.GetAnnouncements(context.Background(), cdcexchange.GetAnnouncementsRequest{
+		Category:    cdcexchange.AnnouncementCategorySystem,
+		ProductType: cdcexchange.AnnouncementProductTypeSpot,
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, []cdcexchange.Announcement{
+		{
+			ID:          "1701152014934-4cf5",
+			Category:    cdcexchange.AnnouncementCategorySystem,
+			ProductType: cdcexchange.AnnouncementProductTypeSpot,
+			AnnouncedAt: cdcexchange.Timestamp(time.UnixMilli(1701152014934)),
+			Title:       "Scheduled System Upgrade",
+			Content:     "The exchange will be unavailable during the upgrade.",
+			ImpactedParams: cdcexchange.AnnouncementImpactedParams{
+				SpotTradingImpacted:  "HALT",
+				CryptoWalletImpacted: true,
+			},
+			StartTime: cdcexchange.Timestamp(time.UnixMilli(1701244800000)),
+			EndTime:   cdcexchange.Timestamp(time.UnixMilli(1701252000000)),
+		},
+	}, announcements)
+}
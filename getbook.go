@@ -4,51 +4,137 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
-	"io/ioutil"
 	"net/http"
+	"strconv"
 
-	"github.com/sngyai/go-cryptocom/internal/api"
-	"github.com/sngyai/go-cryptocom/internal/time"
+	"github.com/sngyai/go-cryptocom/v2/errors"
+	"github.com/sngyai/go-cryptocom/v2/internal/api"
 )
 
 const (
 	methodGetBook = "public/get-book"
+
+	// maxBookDepth is the maximum number of bids and asks which can be requested from the public/get-book API.
+	maxBookDepth = 50
 )
 
 type (
 	// BookResponse is the base response returned from the public/get-book API
 	// when no instrument is specified.
 	BookResponse struct {
-		// api.BaseResponse is the common response fields.
-		api.BaseResponse
+		// BaseResponse is the common response fields.
+		BaseResponse
 		// Result is the response attributes of the endpoint.
 		Result BookResult `json:"result"`
 	}
 
+	// BookResult is the result returned from the public/get-book API.
 	BookResult struct {
-		Depth          int        `json:"depth"`
-		Data           []BookData `json:"data"`
-		InstrumentName string     `json:"instrument_name"`
+		// Depth is the number of bids and asks returned.
+		Depth int `json:"depth"`
+		// Data is the order book data.
+		Data []BookData `json:"data"`
+		// InstrumentName is the instrument name (e.g. BTC_USDT, ETH_CRO, etc).
+		InstrumentName string `json:"instrument_name"`
+		// Raw is the raw JSON of the result, for fields not decoded by this package.
+		Raw json.RawMessage `json:"-"`
 	}
 
-	// BookData is the result returned from the public/get-book API.
+	// BookData is the order book of an instrument at a point in time.
 	BookData struct {
-		// Bids is an array of bids.
-		// [0] = Price, [1] = Quantity, [2] = Number of Orders.
-		Bids [][]string `json:"bids"`
-		// Asks is an array of asks.
-		// [0] = Price, [1] = Quantity, [2] = Number of Orders.
-		Asks [][]string `json:"asks"`
+		// Bids is an array of bids, best (highest) price first.
+		Bids []PriceLevel `json:"bids"`
+		// Asks is an array of asks, best (lowest) price first.
+		Asks []PriceLevel `json:"asks"`
 		// Timestamp is the timestamp of the data.
-		Timestamp time.Time `json:"t"`
+		Timestamp Timestamp `json:"t"`
+	}
+
+	// BestBidAsk is the top of the order book of an instrument.
+	BestBidAsk struct {
+		// InstrumentName is the instrument name (e.g. BTC_USDT, ETH_CRO, etc).
+		InstrumentName string
+		// Bid is the best (highest) bid, the zero PriceLevel if there aren't any bids.
+		Bid PriceLevel
+		// Ask is the best (lowest) ask, the zero PriceLevel if there aren't any asks.
+		Ask PriceLevel
+		// Timestamp is the timestamp of the order book.
+		Timestamp Timestamp
+	}
+
+	// PriceLevel is a single level of the order book.
+	PriceLevel struct {
+		// Price is the price of the level.
+		Price Decimal
+		// Quantity is the total quantity available at the level.
+		Quantity Decimal
+		// NumOrders is the number of orders at the level.
+		NumOrders int64
+		// Raw is the level as sent by the Exchange: [price, quantity, number of orders].
+		Raw []string
 	}
 )
 
+// UnmarshalJSON decodes a level sent as [price, quantity, number of orders].
+//
+// Each value is parsed from its original decimal representation, so levels sent as either
+// strings (e.g. "9668.44") or numbers (e.g. 9668.44) are supported.
+func (l *PriceLevel) UnmarshalJSON(data []byte) error {
+	var level []json.Number
+	if err := json.Unmarshal(data, &level); err != nil {
+		return err
+	}
+	if len(level) != 3 {
+		return fmt.Errorf("invalid price level %s: expected 3 values, got %d", string(data), len(level))
+	}
+
+	price, err := ParseDecimal(level[0].String())
+	if err != nil {
+		return fmt.Errorf("invalid price level price %q: %w", level[0], err)
+	}
+	quantity, err := ParseDecimal(level[1].String())
+	if err != nil {
+		return fmt.Errorf("invalid price level quantity %q: %w", level[1], err)
+	}
+	// the number of orders is sometimes sent with a fractional part (e.g. 1.0).
+	numOrders, err := strconv.ParseFloat(level[2].String(), 64)
+	if err != nil {
+		return fmt.Errorf("invalid price level number of orders %q: %w", level[2], err)
+	}
+
+	*l = PriceLevel{
+		Price:     price,
+		Quantity:  quantity,
+		NumOrders: int64(numOrders),
+		Raw:       []string{level[0].String(), level[1].String(), level[2].String()},
+	}
+
+	return nil
+}
+
+// MarshalJSON encodes a level as [price, quantity, number of orders], as sent by the Exchange.
+func (l PriceLevel) MarshalJSON() ([]byte, error) {
+	if l.Raw != nil {
+		return json.Marshal(l.Raw)
+	}
+
+	return json.Marshal([]string{l.Price.String(), l.Quantity.String(), strconv.FormatInt(l.NumOrders, 10)})
+}
+
 // GetBook fetches the public order book for a particular instrument and depth.
 //
+// depth can be left as 0 to use the default depth (Max: 50).
+//
 // Method: public/get-book
 func (c *Client) GetBook(ctx context.Context, instrument string, depth int) (*BookResult, error) {
-	req, err := http.NewRequestWithContext(ctx, http.MethodGet, fmt.Sprintf("%s%s%s", c.requester.BaseURL, api.V1, methodGetBook), nil)
+	if depth < 0 {
+		return nil, errors.InvalidParameterError{Parameter: "depth", Reason: "cannot be less than 0"}
+	}
+	if depth > maxBookDepth {
+		return nil, errors.InvalidParameterError{Parameter: "depth", Reason: "cannot be greater than 50"}
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.requester.URL(methodGetBook, ""), nil)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create request: %w", err)
 	}
@@ -64,25 +150,50 @@ func (c *Client) GetBook(ctx context.Context, instrument string, depth int) (*Bo
 
 	req.URL.RawQuery = q.Encode()
 
-	res, err := c.requester.Client.Do(req)
+	statusCode, resBytes, err := c.requester.Do(ctx, req, methodGetBook)
 	if err != nil {
-		return nil, fmt.Errorf("failed to do request: %w", err)
-	}
-	defer res.Body.Close()
-
-	resBytes, err := ioutil.ReadAll(res.Body)
-	if err != nil {
-		return nil, fmt.Errorf("failed to read response body: %w", err)
+		return nil, err
 	}
 
 	var bookResponse BookResponse
 	if err := json.Unmarshal(resBytes, &bookResponse); err != nil {
 		return nil, fmt.Errorf("failed to unmarshal response body: %w", err)
 	}
+	bookResponse.SetRaw(resBytes)
 
-	if err := c.requester.CheckErrorResponse(res.StatusCode, bookResponse.Code); err != nil {
+	if err := c.requester.CheckResponse(statusCode, api.BaseResponse(bookResponse.BaseResponse)); err != nil {
 		return nil, fmt.Errorf("error received in response: %w", err)
 	}
 
+	bookResponse.Result.Raw = bookResponse.RawResult
+
 	return &bookResponse.Result, nil
 }
+
+// GetBestBidAsk fetches the best bid and ask of an instrument (e.g. BTC_USDT) from an order book of depth 1.
+//
+// Method: public/get-book
+func (c *Client) GetBestBidAsk(ctx context.Context, instrument string) (*BestBidAsk, error) {
+	if instrument == "" {
+		return nil, errors.InvalidParameterError{Parameter: "instrument", Reason: "cannot be empty"}
+	}
+
+	book, err := c.GetBook(ctx, instrument, 1)
+	if err != nil {
+		return nil, err
+	}
+
+	bestBidAsk := BestBidAsk{InstrumentName: instrument}
+	if len(book.Data) > 0 {
+		data := book.Data[0]
+		if len(data.Bids) > 0 {
+			bestBidAsk.Bid = data.Bids[0]
+		}
+		if len(data.Asks) > 0 {
+			bestBidAsk.Ask = data.Asks[0]
+		}
+		bestBidAsk.Timestamp = data.Timestamp
+	}
+
+	return &bestBidAsk, nil
+}
@@ -2,6 +2,7 @@ package cdcexchange_test
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"net/http"
@@ -14,9 +15,9 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
-	cdcexchange "github.com/sngyai/go-cryptocom"
-	cdcerrors "github.com/sngyai/go-cryptocom/errors"
-	"github.com/sngyai/go-cryptocom/internal/api"
+	cdcexchange "github.com/sngyai/go-cryptocom/v2"
+	cdcerrors "github.com/sngyai/go-cryptocom/v2/errors"
+	"github.com/sngyai/go-cryptocom/v2/internal/api"
 )
 
 func TestClient_GetBook_Error(t *testing.T) {
@@ -96,12 +97,48 @@ func TestClient_GetBook_Error(t *testing.T) {
 	}
 }
 
+func TestClient_GetBook_InvalidDepth(t *testing.T) {
+	const (
+		apiKey    = "some api key"
+		secretKey = "some secret key"
+	)
+
+	tests := []struct {
+		name        string
+		depth       int
+		expectedErr error
+	}{
+		{
+			name:        "returns error when depth is less than 0",
+			depth:       -1,
+			expectedErr: cdcerrors.InvalidParameterError{Parameter: "depth", Reason: "cannot be less than 0"},
+		},
+		{
+			name:        "returns error when depth is greater than 50",
+			depth:       51,
+			expectedErr: cdcerrors.InvalidParameterError{Parameter: "depth", Reason: "cannot be greater than 50"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client, err := cdcexchange.New(apiKey, secretKey)
+			require.NoError(t, err)
+
+			res, err := client.GetBook(context.Background(), "some instrument", tt.depth)
+			require.Error(t, err)
+
+			assert.Nil(t, res)
+			assert.True(t, errors.Is(err, tt.expectedErr))
+		})
+	}
+}
+
 func TestClient_GetBook_Success(t *testing.T) {
 	const (
 		apiKey     = "some api key"
 		secretKey  = "some secret key"
 		instrument = "some instrument"
-		depth      = 100
+		depth      = 10
 	)
 	now := time.Now().Round(time.Second)
 
@@ -139,19 +176,27 @@ func TestClient_GetBook_Success(t *testing.T) {
 							"method":"",
 							"code":0,
 							"result":{
-								"bids":[[9668.44,0.006325,1.0]],
-								"asks":[[9697.0,0.68251,1.0]],
-								"t": %d
+								"depth": %d,
+								"instrument_name": "%s",
+								"data": [{
+									"bids":[["9668.44","0.006325","1"]],
+									"asks":[[9697.0,0.68251,1.0]],
+									"t": %d
+								}]
 							}
-						}`, now.UnixMilli())
+						}`, depth, instrument, now.UnixMilli())
 
 				_, err := w.Write([]byte(res))
 				require.NoError(t, err)
 			},
 			expectedResult: cdcexchange.BookResult{
-				//Bids:      [][]float64{{9668.44, 0.006325, 1.0}},
-				//Asks:      [][]float64{{9697.0, 0.68251, 1.0}},
-				//Timestamp: cdctime.Time(now),
+				Depth:          depth,
+				InstrumentName: instrument,
+				Data: []cdcexchange.BookData{{
+					Bids:      []cdcexchange.PriceLevel{{Price: cdcexchange.MustParseDecimal("9668.44"), Quantity: cdcexchange.MustParseDecimal("0.006325"), NumOrders: 1, Raw: []string{"9668.44", "0.006325", "1"}}},
+					Asks:      []cdcexchange.PriceLevel{{Price: cdcexchange.MustParseDecimal("9697.0"), Quantity: cdcexchange.MustParseDecimal("0.68251"), NumOrders: 1, Raw: []string{"9697.0", "0.68251", "1.0"}}},
+					Timestamp: cdcexchange.Timestamp(now),
+				}},
 			},
 		},
 	}
@@ -177,7 +222,110 @@ func TestClient_GetBook_Success(t *testing.T) {
 			res, err := client.GetBook(ctx, tt.instrument, tt.depth)
 			require.NoError(t, err)
 
+			require.NotEmpty(t, res.Raw)
+			res.Raw = nil
+
 			assert.Equal(t, &tt.expectedResult, res)
 		})
 	}
 }
+
+func TestPriceLevel_JSON(t *testing.T) {
+	t.Run("returns error given a level without 3 values", func(t *testing.T) {
+		var level cdcexchange.PriceLevel
+		require.Error(t, json.Unmarshal([]byte(`["9668.44","0.006325"]`), &level))
+	})
+
+	t.Run("encodes a decoded level as sent", func(t *testing.T) {
+		var level cdcexchange.PriceLevel
+		require.NoError(t, json.Unmarshal([]byte(`[9697.0,0.68251,1.0]`), &level))
+
+		b, err := json.Marshal(level)
+		require.NoError(t, err)
+		assert.JSONEq(t, `["9697.0","0.68251","1.0"]`, string(b))
+	})
+
+	t.Run("encodes a level without raw values", func(t *testing.T) {
+		b, err := json.Marshal(cdcexchange.PriceLevel{
+			Price:     cdcexchange.MustParseDecimal("9668.44"),
+			Quantity:  cdcexchange.MustParseDecimal("0.006325"),
+			NumOrders: 2,
+		})
+		require.NoError(t, err)
+		assert.JSONEq(t, `["9668.44","0.006325","2"]`, string(b))
+	})
+}
+
+func TestClient_GetBestBidAsk(t *testing.T) {
+	tests := []struct {
+		name     string
+		data     string
+		expected *cdcexchange.BestBidAsk
+	}{
+		{
+			name: "returns the best bid and ask",
+			data: `{"bids":[["9668.44","0.006325","1"]],"asks":[["9697.0","0.68251","2"]],"t":1591704180270}`,
+			expected: &cdcexchange.BestBidAsk{
+				InstrumentName: "BTC_USDT",
+				Bid: cdcexchange.PriceLevel{
+					Price:     cdcexchange.MustParseDecimal("9668.44"),
+					Quantity:  cdcexchange.MustParseDecimal("0.006325"),
+					NumOrders: 1,
+					Raw:       []string{"9668.44", "0.006325", "1"},
+				},
+				Ask: cdcexchange.PriceLevel{
+					Price:     cdcexchange.MustParseDecimal("9697"),
+					Quantity:  cdcexchange.MustParseDecimal("0.68251"),
+					NumOrders: 2,
+					Raw:       []string{"9697.0", "0.68251", "2"},
+				},
+				Timestamp: cdcexchange.Timestamp(time.UnixMilli(1591704180270)),
+			},
+		},
+		{
+			name: "returns zero levels for an empty side",
+			data: `{"bids":[],"asks":[["9697.0","0.68251","2"]],"t":1591704180270}`,
+			expected: &cdcexchange.BestBidAsk{
+				InstrumentName: "BTC_USDT",
+				Ask: cdcexchange.PriceLevel{
+					Price:     cdcexchange.MustParseDecimal("9697"),
+					Quantity:  cdcexchange.MustParseDecimal("0.68251"),
+					NumOrders: 2,
+					Raw:       []string{"9697.0", "0.68251", "2"},
+				},
+				Timestamp: cdcexchange.Timestamp(time.UnixMilli(1591704180270)),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Contains(t, r.URL.Path, cdcexchange.MethodGetBook)
+				assert.Equal(t, "BTC_USDT", r.URL.Query().Get("instrument_name"))
+				assert.Equal(t, "1", r.URL.Query().Get("depth"))
+
+				_, err := fmt.Fprintf(w, `{"id":-1,"method":"public/get-book","code":0,"result":{"instrument_name":"BTC_USDT","depth":1,"data":[%s]}}`, tt.data)
+				require.NoError(t, err)
+			}))
+			t.Cleanup(s.Close)
+
+			client, err := cdcexchange.New("api key", "secret key",
+				cdcexchange.WithHTTPClient(s.Client()),
+				cdcexchange.WithBaseURL(fmt.Sprintf("%s/", s.URL)),
+			)
+			require.NoError(t, err)
+
+			bestBidAsk, err := client.GetBestBidAsk(context.Background(), "BTC_USDT")
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, bestBidAsk)
+		})
+	}
+
+	t.Run("returns error when instrument is empty", func(t *testing.T) {
+		client, err := cdcexchange.New("api key", "secret key")
+		require.NoError(t, err)
+
+		_, err = client.GetBestBidAsk(context.Background(), "")
+		assert.Equal(t, cdcerrors.InvalidParameterError{Parameter: "instrument", Reason: "cannot be empty"}, err)
+	})
+}
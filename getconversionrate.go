@@ -0,0 +1,75 @@
+package cdcexchange
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/sngyai/go-cryptocom/v2/errors"
+	"github.com/sngyai/go-cryptocom/v2/internal/api"
+)
+
+const (
+	methodGetConversionRate = "public/staking/get-conversion-rate"
+)
+
+type (
+	// ConversionRateResponse is the base response returned from the public/staking/get-conversion-rate API.
+	ConversionRateResponse struct {
+		// BaseResponse is the common response fields.
+		BaseResponse
+		// Result is the response attributes of the endpoint.
+		Result ConversionRate `json:"result"`
+	}
+
+	// ConversionRate is the result returned from the public/staking/get-conversion-rate API.
+	ConversionRate struct {
+		// InstrumentName is the liquid staking instrument name (e.g. CDCETH).
+		InstrumentName string `json:"instrument_name"`
+		// ConversionRate is the rate between the staked token and the liquid staking token.
+		ConversionRate Decimal `json:"conversion_rate"`
+		// Raw is the raw JSON of the result, for fields not decoded by this package.
+		Raw json.RawMessage `json:"-"`
+	}
+)
+
+// GetConversionRate fetches the public conversion rate of a liquid staking instrument (e.g. CDCETH).
+//
+// No authentication is required.
+//
+// Method: public/staking/get-conversion-rate
+func (c *Client) GetConversionRate(ctx context.Context, instrumentName string) (*ConversionRate, error) {
+	if instrumentName == "" {
+		return nil, errors.InvalidParameterError{Parameter: "instrumentName", Reason: "cannot be empty"}
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.requester.URL(methodGetConversionRate, api.V1), nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	q := req.URL.Query()
+	q.Add("instrument_name", instrumentName)
+	req.URL.RawQuery = q.Encode()
+
+	statusCode, resBytes, err := c.requester.Do(ctx, req, methodGetConversionRate)
+	if err != nil {
+		return nil, err
+	}
+
+	var conversionRateResponse ConversionRateResponse
+	if err := json.Unmarshal(resBytes, &conversionRateResponse); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response body: %w", err)
+	}
+	conversionRateResponse.SetRaw(resBytes)
+
+	if err := c.requester.CheckResponse(statusCode, api.BaseResponse(conversionRateResponse.BaseResponse)); err != nil {
+		return nil, fmt.Errorf("error received in response: %w", err)
+	}
+
+	conversionRateResponse.Result.Raw = conversionRateResponse.RawResult
+
+	return &conversionRateResponse.Result, nil
+}
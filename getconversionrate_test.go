@@ -0,0 +1,66 @@
+package cdcexchange_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	cdcexchange "github.com/sngyai/go-cryptocom/v2"
+	cdcerrors "github.com/sngyai/go-cryptocom/v2/errors"
+)
+
+func TestClient_GetConversionRate_Error(t *testing.T) {
+	client, err := cdcexchange.New("some api key", "some secret key")
+	require.NoError(t, err)
+
+	rate, err := client.GetConversionRate(context.Background(), "")
+	require.Error(t, err)
+
+	assert.Nil(t, rate)
+	assert.True(t, errors.Is(err, cdcerrors.InvalidParameterError{Parameter: "instrumentName", Reason: "cannot be empty"}))
+}
+
+func TestClient_GetConversionRate_Success(t *testing.T) {
+	const instrumentName = "CDCETH"
+
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Contains(t, r.URL.Path, cdcexchange.MethodGetConversionRate)
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, instrumentName, r.URL.Query().Get("instrument_name"))
+
+		_, err := w.Write([]byte(`{
+			"id": 1,
+			"method": "public/staking/get-conversion-rate",
+			"code": 0,
+			"result": {
+				"instrument_name": "CDCETH",
+				"conversion_rate": "1.0203"
+			}
+		}`))
+		require.NoError(t, err)
+	}))
+	t.Cleanup(s.Close)
+
+	client, err := cdcexchange.New("some api key", "some secret key",
+		cdcexchange.WithHTTPClient(s.Client()),
+		cdcexchange.WithBaseURL(fmt.Sprintf("%s/", s.URL)),
+	)
+	require.NoError(t, err)
+
+	rate, err := client.GetConversionRate(context.Background(), instrumentName)
+	require.NoError(t, err)
+
+	require.NotEmpty(t, rate.Raw)
+	rate.Raw = nil
+
+	assert.Equal(t, &cdcexchange.ConversionRate{
+		InstrumentName: instrumentName,
+		ConversionRate: cdcexchange.MustParseDecimal("1.0203"),
+	}, rate)
+}
@@ -0,0 +1,118 @@
+package cdcexchange
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/sngyai/go-cryptocom/v2/errors"
+	"github.com/sngyai/go-cryptocom/v2/internal/api"
+	"github.com/sngyai/go-cryptocom/v2/internal/auth"
+)
+
+const (
+	methodGetConvertHistory = "private/staking/get-convert-history"
+)
+
+type (
+	// GetConvertHistoryRequest is the request params sent for the private/staking/get-convert-history API.
+	//
+	// For users looking to pull longer histories, users can create a loop to make a request
+	// for each page, moving End back to the CreateTime of the oldest request returned.
+	GetConvertHistoryRequest struct {
+		// Start is the start timestamp (milliseconds since the Unix epoch)
+		// (Default: 30 days ago)
+		Start time.Time `json:"start_time"`
+		// End is the end timestamp (milliseconds since the Unix epoch)
+		// (Default: now)
+		End time.Time `json:"end_time"`
+		// Limit represents maximum number of requests returned (for pagination)
+		// (Default: 20, Max: 500)
+		// if Limit is not set, it will be set as 20 by default.
+		Limit Optional[int] `json:"limit"`
+	}
+
+	// GetConvertHistoryResponse is the base response returned from the private/staking/get-convert-history API.
+	GetConvertHistoryResponse struct {
+		// BaseResponse is the common response fields.
+		BaseResponse
+		// Result is the response attributes of the endpoint.
+		Result GetConvertHistoryResult `json:"result"`
+	}
+
+	// GetConvertHistoryResult is the result returned from the private/staking/get-convert-history API.
+	GetConvertHistoryResult struct {
+		// Data is the list of completed conversion requests.
+		Data []ConvertRequestDetail `json:"data"`
+	}
+)
+
+// GetConvertHistory gets the conversion request history.
+//
+// Pagination is handled using Limit (Default: 20, Max: 500) and moving the End timestamp
+// back to the CreateTime of the oldest returned request.
+//
+// Method: private/staking/get-convert-history
+func (c *Client) GetConvertHistory(ctx context.Context, req GetConvertHistoryRequest) ([]ConvertRequestDetail, error) {
+	limit, hasLimit := req.Limit.Get()
+	if limit < 0 {
+		return nil, errors.InvalidParameterError{Parameter: "req.Limit", Reason: "cannot be less than 0"}
+	}
+	if limit > 500 {
+		return nil, errors.InvalidParameterError{Parameter: "req.Limit", Reason: "cannot be greater than 500"}
+	}
+
+	var (
+		id        = c.idGenerator.Generate()
+		timestamp = c.now().UnixMilli()
+		params    = make(map[string]interface{})
+	)
+
+	creds, err := c.credentials(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if !req.Start.IsZero() {
+		params["start_time"] = req.Start.UnixMilli()
+	}
+	if !req.End.IsZero() {
+		params["end_time"] = req.End.UnixMilli()
+	}
+	if hasLimit {
+		params["limit"] = limit
+	}
+
+	signature, err := c.signatureGenerator.GenerateSignature(auth.SignatureRequest{
+		APIKey:    creds.APIKey,
+		SecretKey: creds.SecretKey,
+		ID:        id,
+		Method:    methodGetConvertHistory,
+		Timestamp: timestamp,
+		Params:    params,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create signature: %w", err)
+	}
+
+	body := api.Request{
+		ID:        id,
+		Method:    methodGetConvertHistory,
+		Nonce:     timestamp,
+		Params:    params,
+		Signature: signature,
+		APIKey:    creds.APIKey,
+	}
+
+	var getConvertHistoryResponse GetConvertHistoryResponse
+	statusCode, err := c.requester.Post(ctx, body, methodGetConvertHistory, &getConvertHistoryResponse)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute post request: %w", err)
+	}
+
+	if err := c.requester.CheckResponse(statusCode, api.BaseResponse(getConvertHistoryResponse.BaseResponse)); err != nil {
+		return nil, fmt.Errorf("error received in response: %w", err)
+	}
+
+	return getConvertHistoryResponse.Result.Data, nil
+}
@@ -0,0 +1,196 @@
+package cdcexchange_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/jonboulle/clockwork"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	cdcexchange "github.com/sngyai/go-cryptocom/v2"
+	cdcerrors "github.com/sngyai/go-cryptocom/v2/errors"
+	"github.com/sngyai/go-cryptocom/v2/internal/api"
+	"github.com/sngyai/go-cryptocom/v2/internal/auth"
+	id_mocks "github.com/sngyai/go-cryptocom/v2/internal/mocks/id"
+	signature_mocks "github.com/sngyai/go-cryptocom/v2/internal/mocks/signature"
+)
+
+func TestClient_GetConvertHistory_Error(t *testing.T) {
+	const (
+		apiKey    = "some api key"
+		secretKey = "some secret key"
+	)
+
+	tests := []struct {
+		name        string
+		req         cdcexchange.GetConvertHistoryRequest
+		expectedErr error
+	}{
+		{
+			name:        "returns error when limit is less than 0",
+			req:         cdcexchange.GetConvertHistoryRequest{Limit: cdcexchange.Some(-1)},
+			expectedErr: cdcerrors.InvalidParameterError{Parameter: "req.Limit", Reason: "cannot be less than 0"},
+		},
+		{
+			name:        "returns error when limit is greater than 500",
+			req:         cdcexchange.GetConvertHistoryRequest{Limit: cdcexchange.Some(501)},
+			expectedErr: cdcerrors.InvalidParameterError{Parameter: "req.Limit", Reason: "cannot be greater than 500"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client, err := cdcexchange.New(apiKey, secretKey)
+			require.NoError(t, err)
+
+			requests, err := client.GetConvertHistory(context.Background(), tt.req)
+			require.Error(t, err)
+
+			assert.Empty(t, requests)
+			assert.True(t, errors.Is(err, tt.expectedErr))
+		})
+	}
+}
+
+func TestClient_GetConvertHistory_Success(t *testing.T) {
+	const (
+		apiKey    = "some api key"
+		secretKey = "some secret key"
+		id        = int64(1234)
+		signature = "some signature"
+	)
+	var (
+		now   = time.Now()
+		start = now.Add(-time.Hour)
+	)
+
+	tests := []struct {
+		name           string
+		req            cdcexchange.GetConvertHistoryRequest
+		response       string
+		expectedParams map[string]interface{}
+		expectedResult []cdcexchange.ConvertRequestDetail
+	}{
+		{
+			name: "gets the conversion history of a time range",
+			req: cdcexchange.GetConvertHistoryRequest{
+				Start: start,
+				End:   now,
+				Limit: cdcexchange.Some(50),
+			},
+			response: `{
+				"id": 1234,
+				"method": "private/staking/get-convert-history",
+				"code": 0,
+				"result": {
+					"data": [
+						{
+							"from_instrument_name": "ETH.staked",
+							"to_instrument_name": "CDCETH",
+							"expected_rate": "1.0203",
+							"from_quantity": "3.14159265",
+							"slippage_tolerance_bps": "3",
+							"actual_rate": "1.0204",
+							"to_quantity": "3.20571",
+							"convert_id": 1,
+							"status": "COMPLETED",
+							"create_timestamp_ms": "1688140984005"
+						}
+					]
+				}
+			}`,
+			expectedParams: map[string]interface{}{
+				"start_time": start.UnixMilli(),
+				"end_time":   now.UnixMilli(),
+				"limit":      50,
+			},
+			expectedResult: []cdcexchange.ConvertRequestDetail{
+				{
+					ConvertID:            1,
+					FromInstrumentName:   "ETH.staked",
+					ToInstrumentName:     "CDCETH",
+					ExpectedRate:         cdcexchange.MustParseDecimal("1.0203"),
+					FromQuantity:         cdcexchange.MustParseDecimal("3.14159265"),
+					SlippageToleranceBps: cdcexchange.MustParseDecimal("3"),
+					ActualRate:           cdcexchange.MustParseDecimal("1.0204"),
+					ToQuantity:           cdcexchange.MustParseDecimal("3.20571"),
+					Status:               cdcexchange.ConvertStatusCompleted,
+					CreateTime:           cdcexchange.Timestamp(time.UnixMilli(1688140984005)),
+				},
+			},
+		},
+		{
+			name:           "gets the conversion history with the default params",
+			req:            cdcexchange.GetConvertHistoryRequest{},
+			response:       `{"id": 1234, "method": "private/staking/get-convert-history", "code": 0, "result": {"data": []}}`,
+			expectedParams: map[string]interface{}{},
+			expectedResult: []cdcexchange.ConvertRequestDetail{},
+		},
+		{
+			name:           "sends a limit explicitly set to 0",
+			req:            cdcexchange.GetConvertHistoryRequest{Limit: cdcexchange.Some(0)},
+			response:       `{"id": 1234, "method": "private/staking/get-convert-history", "code": 0, "result": {"data": []}}`,
+			expectedParams: map[string]interface{}{"limit": 0},
+			expectedResult: []cdcexchange.ConvertRequestDetail{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl, ctx := gomock.WithContext(context.Background(), t)
+			t.Cleanup(ctrl.Finish)
+
+			var (
+				signatureGenerator = signature_mocks.NewMockSignatureGenerator(ctrl)
+				idGenerator        = id_mocks.NewMockIDGenerator(ctrl)
+				clock              = clockwork.NewFakeClockAt(now)
+			)
+
+			s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Contains(t, r.URL.Path, cdcexchange.MethodGetConvertHistory)
+				t.Cleanup(func() { require.NoError(t, r.Body.Close()) })
+
+				var body api.Request
+				require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+
+				assert.Equal(t, cdcexchange.MethodGetConvertHistory, body.Method)
+				assert.Equal(t, signature, body.Signature)
+				assert.Len(t, body.Params, len(tt.expectedParams))
+
+				_, err := w.Write([]byte(tt.response))
+				require.NoError(t, err)
+			}))
+			t.Cleanup(s.Close)
+
+			client, err := cdcexchange.New(apiKey, secretKey,
+				cdcexchange.WithIDGenerator(idGenerator),
+				cdcexchange.WithClock(clock),
+				cdcexchange.WithHTTPClient(s.Client()),
+				cdcexchange.WithBaseURL(fmt.Sprintf("%s/", s.URL)),
+				cdcexchange.WithSignatureGenerator(signatureGenerator),
+			)
+			require.NoError(t, err)
+
+			idGenerator.EXPECT().Generate().Return(id)
+			signatureGenerator.EXPECT().GenerateSignature(auth.SignatureRequest{
+				APIKey:    apiKey,
+				SecretKey: secretKey,
+				ID:        id,
+				Method:    cdcexchange.MethodGetConvertHistory,
+				Timestamp: now.UnixMilli(),
+				Params:    tt.expectedParams,
+			}).Return(signature, nil)
+
+			requests, err := client.GetConvertHistory(ctx, tt.req)
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.expectedResult, requests)
+		})
+	}
+}
@@ -0,0 +1,112 @@
+package cdcexchange
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/sngyai/go-cryptocom/v2/internal/api"
+	"github.com/sngyai/go-cryptocom/v2/internal/auth"
+)
+
+const (
+	methodGetCurrencyNetworks = "private/get-currency-networks"
+)
+
+type (
+	// GetCurrencyNetworksResponse is the base response returned from the private/get-currency-networks API.
+	GetCurrencyNetworksResponse struct {
+		// BaseResponse is the common response fields.
+		BaseResponse
+		// Result is the response attributes of the endpoint.
+		Result CurrencyNetworks `json:"result"`
+	}
+
+	// CurrencyNetworks is the result returned from the private/get-currency-networks API.
+	CurrencyNetworks struct {
+		// UpdateTime is the time the network map was last updated.
+		UpdateTime Timestamp `json:"update_time"`
+		// CurrencyMap is the network details of each currency, keyed by currency symbol (e.g. BTC).
+		CurrencyMap map[string]CurrencyNetwork `json:"currency_map"`
+		// Raw is the raw JSON of the result, for fields not decoded by this package.
+		Raw json.RawMessage `json:"-"`
+	}
+
+	// CurrencyNetwork represents the networks a currency can be deposited and withdrawn on.
+	CurrencyNetwork struct {
+		// FullName is the full name of the currency (e.g. Bitcoin).
+		FullName string `json:"full_name"`
+		// DefaultNetwork is the network used when no network is specified, empty if there isn't one.
+		DefaultNetwork string `json:"default_network"`
+		// NetworkList is the list of networks supported for the currency.
+		NetworkList []Network `json:"network_list"`
+	}
+
+	// Network represents the deposit and withdrawal details of a currency on a specific network.
+	Network struct {
+		// NetworkID is the ID of the network, used as CreateWithdrawalRequest.NetworkID (e.g. ETH, BSC).
+		NetworkID string `json:"network_id"`
+		// WithdrawalFee is the fee charged for withdrawing on the network, 0 if not provided.
+		WithdrawalFee Decimal `json:"withdrawal_fee"`
+		// WithdrawEnabled is true when withdrawals are enabled on the network.
+		WithdrawEnabled bool `json:"withdraw_enabled"`
+		// MinWithdrawalAmount is the minimum amount which can be withdrawn on the network.
+		MinWithdrawalAmount Decimal `json:"min_withdrawal_amount"`
+		// DepositEnabled is true when deposits are enabled on the network.
+		DepositEnabled bool `json:"deposit_enabled"`
+		// ConfirmationRequired is the number of confirmations required before a deposit is credited.
+		ConfirmationRequired int `json:"confirmation_required"`
+	}
+)
+
+// GetCurrencyNetworks gets the networks each currency can be deposited and withdrawn on,
+// along with their withdrawal fees, minimum withdrawal amounts and required confirmations.
+//
+// Method: private/get-currency-networks
+func (c *Client) GetCurrencyNetworks(ctx context.Context) (*CurrencyNetworks, error) {
+	var (
+		id        = c.idGenerator.Generate()
+		timestamp = c.now().UnixMilli()
+		params    = make(map[string]interface{})
+	)
+
+	creds, err := c.credentials(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	signature, err := c.signatureGenerator.GenerateSignature(auth.SignatureRequest{
+		APIKey:    creds.APIKey,
+		SecretKey: creds.SecretKey,
+		ID:        id,
+		Method:    methodGetCurrencyNetworks,
+		Timestamp: timestamp,
+		Params:    params,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create signature: %w", err)
+	}
+
+	body := api.Request{
+		ID:        id,
+		Method:    methodGetCurrencyNetworks,
+		Nonce:     timestamp,
+		Params:    params,
+		Signature: signature,
+		APIKey:    creds.APIKey,
+	}
+
+	var getCurrencyNetworksResponse GetCurrencyNetworksResponse
+	statusCode, err := c.requester.Post(ctx, body, methodGetCurrencyNetworks, &getCurrencyNetworksResponse)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute post request: %w", err)
+	}
+
+	if err := c.requester.CheckResponse(statusCode, api.BaseResponse(getCurrencyNetworksResponse.BaseResponse)); err != nil {
+		return nil, fmt.Errorf("error received in response: %w", err)
+	}
+
+	getCurrencyNetworksResponse.Result.Raw = getCurrencyNetworksResponse.RawResult
+
+	return &getCurrencyNetworksResponse.Result, nil
+}
@@ -4,8 +4,8 @@ import (
 	"context"
 	"fmt"
 
-	"github.com/sngyai/go-cryptocom/internal/api"
-	"github.com/sngyai/go-cryptocom/internal/auth"
+	"github.com/sngyai/go-cryptocom/v2/internal/api"
+	"github.com/sngyai/go-cryptocom/v2/internal/auth"
 )
 
 const (
@@ -29,8 +29,8 @@ type (
 
 	// GetDepositAddressResponse is the base response returned from the private/get-deposit-address API.
 	GetDepositAddressResponse struct {
-		// api.BaseResponse is the common response fields.
-		api.BaseResponse
+		// BaseResponse is the common response fields.
+		BaseResponse
 		// Result is the response attributes of the endpoint.
 		Result GetDepositAddressResult `json:"result"`
 	}
@@ -41,13 +41,20 @@ type (
 		DepositAddressList []DepositAddress `json:"deposit_address_list"`
 	}
 
+	// DepositAddress is a deposit address of a currency.
 	DepositAddress struct {
-		Currency   string `json:"currency"`
-		CreateTime int64  `json:"create_time"`
-		Id         string `json:"id"`
-		Address    string `json:"address"`
-		Status     string `json:"status"`
-		Network    string `json:"network"`
+		// Currency is the currency of the deposit address (e.g. CRO).
+		Currency string `json:"currency"`
+		// CreateTime is when the deposit address was created (milliseconds since the Unix epoch).
+		CreateTime int64 `json:"create_time"`
+		// ID is the ID of the deposit address.
+		ID string `json:"id"`
+		// Address is the address to deposit to, followed by ?<tag> for currencies with an address tag.
+		Address string `json:"address"`
+		// Status is the status of the deposit address (0 - Inactive, 1 - Active).
+		Status string `json:"status"`
+		// Network is the network of the deposit address (e.g. ETH).
+		Network string `json:"network"`
 	}
 )
 
@@ -62,17 +69,22 @@ type (
 func (c *Client) GetDepositAddress(ctx context.Context, req GetDepositAddressRequest) ([]DepositAddress, error) {
 	var (
 		id        = c.idGenerator.Generate()
-		timestamp = c.clock.Now().UnixMilli()
+		timestamp = c.now().UnixMilli()
 		params    = make(map[string]interface{})
 	)
 
+	creds, err := c.credentials(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	if req.Currency != "" {
 		params["currency"] = req.Currency
 	}
 
 	signature, err := c.signatureGenerator.GenerateSignature(auth.SignatureRequest{
-		APIKey:    c.apiKey,
-		SecretKey: c.secretKey,
+		APIKey:    creds.APIKey,
+		SecretKey: creds.SecretKey,
 		ID:        id,
 		Method:    methodGetDepositAddress,
 		Timestamp: timestamp,
@@ -88,7 +100,7 @@ func (c *Client) GetDepositAddress(ctx context.Context, req GetDepositAddressReq
 		Nonce:     timestamp,
 		Params:    params,
 		Signature: signature,
-		APIKey:    c.apiKey,
+		APIKey:    creds.APIKey,
 	}
 
 	var GetDepositAddressResponse GetDepositAddressResponse
@@ -97,7 +109,7 @@ func (c *Client) GetDepositAddress(ctx context.Context, req GetDepositAddressReq
 		return nil, fmt.Errorf("failed to execute post request: %w", err)
 	}
 
-	if err := c.requester.CheckErrorResponse(statusCode, GetDepositAddressResponse.Code); err != nil {
+	if err := c.requester.CheckResponse(statusCode, api.BaseResponse(GetDepositAddressResponse.BaseResponse)); err != nil {
 		return nil, fmt.Errorf("error received in response: %w", err)
 	}
 
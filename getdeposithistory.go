@@ -5,9 +5,9 @@ import (
 	"fmt"
 	"time"
 
-	"github.com/sngyai/go-cryptocom/errors"
-	"github.com/sngyai/go-cryptocom/internal/api"
-	"github.com/sngyai/go-cryptocom/internal/auth"
+	"github.com/sngyai/go-cryptocom/v2/errors"
+	"github.com/sngyai/go-cryptocom/v2/internal/api"
+	"github.com/sngyai/go-cryptocom/v2/internal/auth"
 )
 
 const (
@@ -19,10 +19,11 @@ type (
 	//
 	// The maximum duration between Start and End is 24 hours.
 	//
-	// You will receive an INVALID_DATE_RANGE error if the difference exceeds the maximum duration.
+	// An errors.DateRangeError is returned without making a request if the difference exceeds the maximum duration
+	// (End defaults to now if only Start is set), which matches errors.ErrInvalidDateRange.
 	//
-	// For users looking to pull longer historical deposit data, users can create a loop to make a request
-	// for each 24-period from the desired start to end time.
+	// Longer historical deposit data can be pulled with GetDepositHistoryRange, which makes a request
+	// for each 24 hour period from the desired start to end time.
 	GetDepositHistoryRequest struct {
 		// Currency represents the currency symbol for the deposits (e.g. BTC or ETH).
 		// if Currency is omitted, all currencies will be returned.
@@ -33,21 +34,16 @@ type (
 		// End is the end timestamp (milliseconds since the Unix epoch)
 		// (Default: now)
 		End time.Time `json:"end_ts"`
-		// PageSize represents maximum number of deposits returned (for pagination)
-		// (Default: 20, Max: 200)
-		// if PageSize is 0, it will be set as 20 by default.
-		PageSize int `json:"page_size"`
-		// Page represents the page number (for pagination)
-		// (0-based)
-		Page int `json:"page"`
-
+		// PageRequest is the page size (Default: 20, Max: 200) and page number (0-based) for pagination.
+		PageRequest
+		// Status filters by status (0 - Not Arrived, 1 - Arrived, 2 - Failed, 3 - Pending), all statuses are returned if empty.
 		Status string `json:"status"`
 	}
 
 	// GetDepositHistoryResponse is the base response returned from the private/get-deposit-history API.
 	GetDepositHistoryResponse struct {
-		// api.BaseResponse is the common response fields.
-		api.BaseResponse
+		// BaseResponse is the common response fields.
+		BaseResponse
 		// Result is the response attributes of the endpoint.
 		Result GetDepositHistoryResult `json:"result"`
 	}
@@ -58,15 +54,24 @@ type (
 		DepositList []Deposit `json:"deposit_list"`
 	}
 
+	// Deposit is a deposit into the account.
 	Deposit struct {
-		Currency   string  `json:"currency"`
-		Fee        float64 `json:"fee"`
-		CreateTime int64   `json:"create_time"`
-		Id         string  `json:"id"`
-		UpdateTime int64   `json:"update_time"`
-		Amount     float64 `json:"amount"`
-		Address    string  `json:"address"`
-		Status     string  `json:"status"`
+		// Currency is the currency symbol of the deposit (e.g. BTC or ETH).
+		Currency string `json:"currency"`
+		// Fee is the fee charged for the deposit.
+		Fee Decimal `json:"fee"`
+		// CreateTime is the time the deposit was created (milliseconds since the Unix epoch).
+		CreateTime int64 `json:"create_time"`
+		// ID is the deposit ID.
+		ID string `json:"id"`
+		// UpdateTime is the time the deposit was last updated (milliseconds since the Unix epoch).
+		UpdateTime int64 `json:"update_time"`
+		// Amount is the amount deposited, exactly as reported by the Exchange (fractional amounts are not truncated).
+		Amount Decimal `json:"amount"`
+		// Address is the address the deposit was sent to.
+		Address string `json:"address"`
+		// Status is the status of the deposit.
+		Status string `json:"status"`
 	}
 )
 
@@ -79,39 +84,77 @@ type (
 //
 // Method: private/get-deposit-history
 func (c *Client) GetDepositHistory(ctx context.Context, req GetDepositHistoryRequest) ([]Deposit, error) {
-	if req.PageSize < 0 {
-		return nil, errors.InvalidParameterError{Parameter: "req.Limit", Reason: "cannot be less than 0"}
+	if err := req.PageRequest.validate(); err != nil {
+		return nil, err
+	}
+	if err := validateTimeRange(req.Start, req.End, c.now(), MaxHistoryWindow); err != nil {
+		return nil, err
+	}
+
+	return c.getDepositHistory(ctx, req)
+}
+
+// GetDepositHistoryRange returns an Iterator over the deposits matching req between req.Start and req.End,
+// which can be any range: it is split into 24 hour windows (newest first), and every page of each window is fetched.
+//
+// req.End defaults to now if only req.Start is set. Pages of req.PageSize deposits are fetched (Default: 200),
+// and req.Page is ignored.
+func (c *Client) GetDepositHistoryRange(req GetDepositHistoryRequest) (*Iterator[Deposit], error) {
+	if err := req.PageRequest.validate(); err != nil {
+		return nil, err
+	}
+	if !req.Start.IsZero() && req.End.IsZero() {
+		req.End = c.now()
 	}
-	if req.PageSize > 200 {
-		return nil, errors.InvalidParameterError{Parameter: "req.Limit", Reason: "cannot be greater than 200"}
+	if req.End.Before(req.Start) {
+		return nil, errors.DateRangeError{Start: req.Start, End: req.End, MaxRange: MaxHistoryWindow}
 	}
 
+	pageSize, _ := req.PageSize.Get()
+	if pageSize == 0 {
+		pageSize = maxPageSize
+	}
+
+	return newIterator(historyWindows(req.Start, req.End), pageSize, func(ctx context.Context, window TimeWindow, page PageRequest) ([]Deposit, error) {
+		windowReq := req
+		windowReq.Start = window.Start
+		windowReq.End = window.End
+		windowReq.PageRequest = page
+
+		return c.getDepositHistory(ctx, windowReq)
+	}), nil
+}
+
+// getDepositHistory makes a single private/get-deposit-history request for the timeframe of req.
+func (c *Client) getDepositHistory(ctx context.Context, req GetDepositHistoryRequest) ([]Deposit, error) {
 	var (
 		id        = c.idGenerator.Generate()
-		timestamp = c.clock.Now().UnixMilli()
+		timestamp = c.now().UnixMilli()
 		params    = make(map[string]interface{})
 	)
 
+	creds, err := c.credentials(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	if req.Currency != "" {
 		params["currency"] = req.Currency
 	}
-	if req.PageSize != 0 {
-		params["page_size"] = req.PageSize
-	}
+	req.PageRequest.setParams(params)
 	if !req.Start.IsZero() {
 		params["start_ts"] = req.Start.UnixMilli()
 	}
 	if !req.End.IsZero() {
 		params["end_ts"] = req.End.UnixMilli()
 	}
-	params["page"] = req.Page
 	if req.Status != "" {
 		params["status"] = req.Status
 	}
 
 	signature, err := c.signatureGenerator.GenerateSignature(auth.SignatureRequest{
-		APIKey:    c.apiKey,
-		SecretKey: c.secretKey,
+		APIKey:    creds.APIKey,
+		SecretKey: creds.SecretKey,
 		ID:        id,
 		Method:    methodGetDepositHistory,
 		Timestamp: timestamp,
@@ -127,7 +170,7 @@ func (c *Client) GetDepositHistory(ctx context.Context, req GetDepositHistoryReq
 		Nonce:     timestamp,
 		Params:    params,
 		Signature: signature,
-		APIKey:    c.apiKey,
+		APIKey:    creds.APIKey,
 	}
 
 	var getDepositHistoryResponse GetDepositHistoryResponse
@@ -136,7 +179,7 @@ func (c *Client) GetDepositHistory(ctx context.Context, req GetDepositHistoryReq
 		return nil, fmt.Errorf("failed to execute post request: %w", err)
 	}
 
-	if err := c.requester.CheckErrorResponse(statusCode, getDepositHistoryResponse.Code); err != nil {
+	if err := c.requester.CheckResponse(statusCode, api.BaseResponse(getDepositHistoryResponse.BaseResponse)); err != nil {
 		return nil, fmt.Errorf("error received in response: %w", err)
 	}
 
@@ -0,0 +1,224 @@
+package cdcexchange_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/jonboulle/clockwork"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	cdcexchange "github.com/sngyai/go-cryptocom/v2"
+	cdcerrors "github.com/sngyai/go-cryptocom/v2/errors"
+	"github.com/sngyai/go-cryptocom/v2/internal/api"
+)
+
+func TestClient_GetDepositHistory_Error(t *testing.T) {
+	start := time.Date(2023, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name        string
+		req         cdcexchange.GetDepositHistoryRequest
+		expectedErr error
+	}{
+		{
+			name:        "returns error when page size is less than 0",
+			req:         cdcexchange.GetDepositHistoryRequest{PageRequest: cdcexchange.PageRequest{PageSize: cdcexchange.Some(-1)}},
+			expectedErr: cdcerrors.InvalidParameterError{Parameter: "req.PageSize", Reason: "cannot be less than 0"},
+		},
+		{
+			name:        "returns error when page size is greater than 200",
+			req:         cdcexchange.GetDepositHistoryRequest{PageRequest: cdcexchange.PageRequest{PageSize: cdcexchange.Some(201)}},
+			expectedErr: cdcerrors.InvalidParameterError{Parameter: "req.PageSize", Reason: "cannot be greater than 200"},
+		},
+		{
+			name:        "returns error when the range between start and end is longer than 24 hours",
+			req:         cdcexchange.GetDepositHistoryRequest{Start: start, End: start.Add(24*time.Hour + time.Millisecond)},
+			expectedErr: cdcerrors.DateRangeError{Start: start, End: start.Add(24*time.Hour + time.Millisecond), MaxRange: 24 * time.Hour},
+		},
+		{
+			name:        "returns error when end is before start",
+			req:         cdcexchange.GetDepositHistoryRequest{Start: start, End: start.Add(-time.Hour)},
+			expectedErr: cdcerrors.DateRangeError{Start: start, End: start.Add(-time.Hour), MaxRange: 24 * time.Hour},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client, err := cdcexchange.New("some api key", "some secret key")
+			require.NoError(t, err)
+
+			deposits, err := client.GetDepositHistory(context.Background(), tt.req)
+			require.Error(t, err)
+
+			assert.Nil(t, deposits)
+			assert.Equal(t, tt.expectedErr, err)
+		})
+	}
+}
+
+func TestClient_GetDepositHistory_DateRangeDefaultsEndToNow(t *testing.T) {
+	now := time.Now()
+
+	client, err := cdcexchange.New("some api key", "some secret key", cdcexchange.WithClock(clockwork.NewFakeClockAt(now)))
+	require.NoError(t, err)
+
+	deposits, err := client.GetDepositHistory(context.Background(), cdcexchange.GetDepositHistoryRequest{Start: now.Add(-25 * time.Hour)})
+	require.Error(t, err)
+
+	assert.Nil(t, deposits)
+	assert.True(t, errors.Is(err, cdcerrors.ErrInvalidDateRange))
+}
+
+func TestClient_GetDepositHistory_Success(t *testing.T) {
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Contains(t, r.URL.Path, cdcexchange.MethodGetDepositHistory)
+		t.Cleanup(func() { require.NoError(t, r.Body.Close()) })
+
+		var body api.Request
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+
+		assert.Equal(t, "BTC", body.Params["currency"])
+
+		_, err := w.Write([]byte(`{
+			"id": 11,
+			"method": "private/get-deposit-history",
+			"code": 0,
+			"result": {
+				"deposit_list": [
+					{
+						"currency": "BTC",
+						"fee": 0,
+						"create_time": 1607063412000,
+						"id": "2220",
+						"update_time": 1607063460000,
+						"amount": 0.00012345,
+						"address": "2NBqqD5GRJ8wHy1PYyCXTe9ke5226FhavBf?1234567890",
+						"status": "1"
+					},
+					{
+						"currency": "BTC",
+						"fee": "0.0000001",
+						"create_time": 1607063413000,
+						"id": "2221",
+						"update_time": 1607063461000,
+						"amount": "1.99999999",
+						"address": "2NBqqD5GRJ8wHy1PYyCXTe9ke5226FhavBf?1234567890",
+						"status": "1"
+					}
+				]
+			}
+		}`))
+		require.NoError(t, err)
+	}))
+	t.Cleanup(s.Close)
+
+	client, err := cdcexchange.New("some api key", "some secret key",
+		cdcexchange.WithHTTPClient(s.Client()),
+		cdcexchange.WithBaseURL(fmt.Sprintf("%s/", s.URL)),
+	)
+	require.NoError(t, err)
+
+	deposits, err := client.GetDepositHistory(context.Background(), cdcexchange.GetDepositHistoryRequest{Currency: "BTC"})
+	require.NoError(t, err)
+
+	assert.Equal(t, []cdcexchange.Deposit{
+		{
+			Currency:   "BTC",
+			CreateTime: 1607063412000,
+			ID:         "2220",
+			UpdateTime: 1607063460000,
+			Amount:     cdcexchange.MustParseDecimal("0.00012345"),
+			Address:    "2NBqqD5GRJ8wHy1PYyCXTe9ke5226FhavBf?1234567890",
+			Status:     "1",
+		},
+		{
+			Currency:   "BTC",
+			Fee:        cdcexchange.MustParseDecimal("0.0000001"),
+			CreateTime: 1607063413000,
+			ID:         "2221",
+			UpdateTime: 1607063461000,
+			Amount:     cdcexchange.MustParseDecimal("1.99999999"),
+			Address:    "2NBqqD5GRJ8wHy1PYyCXTe9ke5226FhavBf?1234567890",
+			Status:     "1",
+		},
+	}, deposits)
+}
+
+func TestClient_GetDepositHistoryRange(t *testing.T) {
+	var (
+		end   = time.Now().Round(time.Second)
+		start = end.Add(-72 * time.Hour)
+
+		requests [][2]int64
+	)
+
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body api.Request
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+
+		assert.Equal(t, "BTC", body.Params["currency"])
+		assert.Equal(t, float64(1), body.Params["page_size"])
+
+		windowEnd, page := int64(body.Params["end_ts"].(float64)), int64(body.Params["page"].(float64))
+		requests = append(requests, [2]int64{windowEnd, page})
+
+		// only the newest and oldest windows have a deposit.
+		list := ""
+		if page == 0 && (windowEnd == end.UnixMilli() || int64(body.Params["start_ts"].(float64)) == start.UnixMilli()) {
+			list = fmt.Sprintf(`{"id": "%d"}`, len(requests))
+		}
+
+		_, err := fmt.Fprintf(w, `{"id": 0, "code": 0, "result": {"deposit_list": [%s]}}`, list)
+		require.NoError(t, err)
+	}))
+	t.Cleanup(s.Close)
+
+	client, err := cdcexchange.New("some api key", "some secret key",
+		cdcexchange.WithHTTPClient(s.Client()),
+		cdcexchange.WithBaseURL(fmt.Sprintf("%s/", s.URL)),
+		cdcexchange.WithoutRateLimiter(),
+	)
+	require.NoError(t, err)
+
+	it, err := client.GetDepositHistoryRange(cdcexchange.GetDepositHistoryRequest{
+		Currency:    "BTC",
+		Start:       start,
+		End:         end,
+		PageRequest: cdcexchange.PageRequest{PageSize: cdcexchange.Some(1)},
+	})
+	require.NoError(t, err)
+
+	var ids []string
+	for it.Next(context.Background()) {
+		ids = append(ids, it.Value().ID)
+	}
+	require.NoError(t, it.Err())
+
+	assert.Equal(t, []string{"1", "4"}, ids)
+	assert.Equal(t, [][2]int64{
+		{end.UnixMilli(), 0},
+		{end.UnixMilli(), 1},
+		{end.Add(-24*time.Hour).UnixMilli() - 1, 0},
+		{end.Add(-48*time.Hour).UnixMilli() - 2, 0},
+		{end.Add(-48*time.Hour).UnixMilli() - 2, 1},
+	}, requests)
+}
+
+func TestClient_GetDepositHistoryRange_Error(t *testing.T) {
+	start := time.Date(2023, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+	client, err := cdcexchange.New("some api key", "some secret key")
+	require.NoError(t, err)
+
+	it, err := client.GetDepositHistoryRange(cdcexchange.GetDepositHistoryRequest{Start: start, End: start.Add(-time.Hour)})
+	require.Error(t, err)
+
+	assert.Nil(t, it)
+	assert.True(t, errors.Is(err, cdcerrors.ErrInvalidDateRange))
+}
@@ -2,9 +2,11 @@ package cdcexchange
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"strconv"
 
-	"github.com/sngyai/go-cryptocom/internal/api"
+	"github.com/sngyai/go-cryptocom/v2/internal/api"
 )
 
 const (
@@ -14,8 +16,8 @@ const (
 type (
 	// InstrumentsResponse is the base response returned from the public/get-instruments API.
 	InstrumentsResponse struct {
-		// api.BaseResponse is the common response fields.
-		api.BaseResponse
+		// BaseResponse is the common response fields.
+		BaseResponse
 		// Result is the response attributes of the endpoint.
 		Result InstrumentResult `json:"result"`
 	}
@@ -28,26 +30,127 @@ type (
 
 	// Instrument represents details of a specific currency pair
 	Instrument struct {
-		Symbol            string `json:"symbol"`
-		InstType          string `json:"inst_type"`
-		DisplayName       string `json:"display_name"`
-		BaseCcy           string `json:"base_ccy"`
-		QuoteCcy          string `json:"quote_ccy"`
-		QuoteDecimals     int    `json:"quote_decimals"`
-		QuantityDecimals  int    `json:"quantity_decimals"`
-		PriceTickSize     string `json:"price_tick_size"`
-		QtyTickSize       string `json:"qty_tick_size"`
-		MaxLeverage       string `json:"max_leverage"`
-		Tradable          bool   `json:"tradable"`
-		ExpiryTimestampMs int    `json:"expiry_timestamp_ms"`
-		BetaProduct       bool   `json:"beta_product"`
-		UnderlyingSymbol  string `json:"underlying_symbol"`
-		ContractSize      string `json:"contract_size"`
-		MarginBuyEnabled  bool   `json:"margin_buy_enabled"`
-		MarginSellEnabled bool   `json:"margin_sell_enabled"`
+		// Symbol is the name of the instrument (e.g. BTC_USDT).
+		Symbol string `json:"symbol"`
+		// Type is the type of the instrument (e.g. CCY_PAIR or PERPETUAL_SWAP).
+		Type InstrumentType `json:"inst_type"`
+		// DisplayName is the name of the instrument shown in the Exchange UI (e.g. BTC/USDT).
+		DisplayName string `json:"display_name"`
+		// BaseCurrency is the base currency of the instrument (e.g. BTC).
+		BaseCurrency string `json:"base_ccy"`
+		// QuoteCurrency is the quote currency of the instrument (e.g. USDT).
+		QuoteCurrency string `json:"quote_ccy"`
+		// QuoteDecimals is the maximum number of decimal places of a price.
+		QuoteDecimals int `json:"quote_decimals"`
+		// QuantityDecimals is the maximum number of decimal places of a quantity.
+		QuantityDecimals int `json:"quantity_decimals"`
+		// PriceTickSize is the minimum price increment (e.g. 0.01).
+		PriceTickSize Decimal `json:"price_tick_size"`
+		// QtyTickSize is the minimum quantity increment (e.g. 0.0001).
+		QtyTickSize Decimal `json:"qty_tick_size"`
+		// MaxLeverage is the maximum leverage of the instrument (e.g. 50), 0 if it cannot be traded with leverage.
+		MaxLeverage Decimal `json:"max_leverage"`
+		// Tradable is whether orders can currently be placed for the instrument.
+		Tradable bool `json:"tradable"`
+		// ExpiryTimestampMs is the expiry time of a future (milliseconds since the Unix epoch), 0 for other instrument types.
+		ExpiryTimestampMs int64 `json:"expiry_timestamp_ms"`
+		// BetaProduct is whether the instrument is a beta product.
+		BetaProduct bool `json:"beta_product"`
+		// UnderlyingSymbol is the symbol of the underlying index of a derivative (e.g. BTCUSD-INDEX).
+		UnderlyingSymbol string `json:"underlying_symbol"`
+		// ContractSize is the size of one contract of a derivative (e.g. 1).
+		ContractSize Decimal `json:"contract_size"`
+		// MarginBuyEnabled is whether the instrument can be bought on margin.
+		MarginBuyEnabled bool `json:"margin_buy_enabled"`
+		// MarginSellEnabled is whether the instrument can be sold on margin.
+		MarginSellEnabled bool `json:"margin_sell_enabled"`
+		// Raw holds the numeric fields of the instrument exactly as returned by the Exchange.
+		Raw InstrumentRaw `json:"-"`
+	}
+
+	// InstrumentRaw holds the numeric fields of an Instrument exactly as returned by the Exchange (e.g. "0.010000").
+	InstrumentRaw struct {
+		// PriceTickSize is the raw Instrument.PriceTickSize.
+		PriceTickSize string
+		// QtyTickSize is the raw Instrument.QtyTickSize.
+		QtyTickSize string
+		// MaxLeverage is the raw Instrument.MaxLeverage.
+		MaxLeverage string
+		// ContractSize is the raw Instrument.ContractSize.
+		ContractSize string
 	}
 )
 
+// UnmarshalJSON decodes an instrument, keeping its numeric fields as returned in Raw.
+func (i *Instrument) UnmarshalJSON(data []byte) error {
+	type instrument Instrument
+	v := struct {
+		*instrument
+		PriceTickSize json.RawMessage `json:"price_tick_size"`
+		QtyTickSize   json.RawMessage `json:"qty_tick_size"`
+		MaxLeverage   json.RawMessage `json:"max_leverage"`
+		ContractSize  json.RawMessage `json:"contract_size"`
+	}{instrument: (*instrument)(i)}
+
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+
+	for _, field := range []struct {
+		name  string
+		data  json.RawMessage
+		value *Decimal
+		raw   *string
+	}{
+		{name: "price_tick_size", data: v.PriceTickSize, value: &i.PriceTickSize, raw: &i.Raw.PriceTickSize},
+		{name: "qty_tick_size", data: v.QtyTickSize, value: &i.QtyTickSize, raw: &i.Raw.QtyTickSize},
+		{name: "max_leverage", data: v.MaxLeverage, value: &i.MaxLeverage, raw: &i.Raw.MaxLeverage},
+		{name: "contract_size", data: v.ContractSize, value: &i.ContractSize, raw: &i.Raw.ContractSize},
+	} {
+		if len(field.data) == 0 {
+			continue
+		}
+
+		if err := field.value.UnmarshalJSON(field.data); err != nil {
+			return fmt.Errorf("invalid %s: %w", field.name, err)
+		}
+
+		raw := string(field.data)
+		if unquoted, err := strconv.Unquote(raw); err == nil {
+			raw = unquoted
+		}
+		if raw != "null" {
+			*field.raw = raw
+		}
+	}
+
+	return nil
+}
+
+// RoundPrice rounds price to the nearest multiple of the price tick size of the instrument
+// (price is returned unchanged if the tick size is unknown).
+func (i Instrument) RoundPrice(price Decimal) Decimal {
+	return roundToStep(price, i.PriceTickSize, RoundNearest)
+}
+
+// RoundQuantity rounds quantity towards 0 to a multiple of the quantity tick size of the instrument,
+// so that the rounded quantity never exceeds quantity (quantity is returned unchanged if the tick size is unknown).
+func (i Instrument) RoundQuantity(quantity Decimal) Decimal {
+	return roundToStep(quantity, i.QtyTickSize, RoundTowardZero)
+}
+
+// RoundToTick rounds price to a multiple of the price tick size of the instrument in the direction of mode
+// (price is returned unchanged if the tick size is unknown).
+func (i Instrument) RoundToTick(price Decimal, mode RoundingMode) Decimal {
+	return roundToStep(price, i.PriceTickSize, mode)
+}
+
+// RoundToLot rounds quantity to a multiple of the quantity tick size of the instrument in the direction of mode
+// (quantity is returned unchanged if the tick size is unknown).
+func (i Instrument) RoundToLot(quantity Decimal, mode RoundingMode) Decimal {
+	return roundToStep(quantity, i.QtyTickSize, mode)
+}
+
 // GetInstruments provides information on all supported instruments (e.g. BTC_USDT).
 //
 // Method: public/get-instruments
@@ -55,7 +158,7 @@ func (c *Client) GetInstruments(ctx context.Context) ([]Instrument, error) {
 	body := api.Request{
 		ID:     c.idGenerator.Generate(),
 		Method: methodGetInstruments,
-		Nonce:  c.clock.Now().UnixMilli(),
+		Nonce:  c.now().UnixMilli(),
 	}
 
 	var instrumentsResponse InstrumentsResponse
@@ -64,7 +167,7 @@ func (c *Client) GetInstruments(ctx context.Context) ([]Instrument, error) {
 		return nil, fmt.Errorf("failed to execute post request: %w", err)
 	}
 
-	if err := c.requester.CheckErrorResponse(statusCode, instrumentsResponse.Code); err != nil {
+	if err := c.requester.CheckResponse(statusCode, api.BaseResponse(instrumentsResponse.BaseResponse)); err != nil {
 		return nil, fmt.Errorf("error received in response: %w", err)
 	}
 
@@ -15,10 +15,10 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
-	cdcexchange "github.com/sngyai/go-cryptocom"
-	cdcerrors "github.com/sngyai/go-cryptocom/errors"
-	"github.com/sngyai/go-cryptocom/internal/api"
-	id_mocks "github.com/sngyai/go-cryptocom/internal/mocks/id"
+	cdcexchange "github.com/sngyai/go-cryptocom/v2"
+	cdcerrors "github.com/sngyai/go-cryptocom/v2/errors"
+	"github.com/sngyai/go-cryptocom/v2/internal/api"
+	id_mocks "github.com/sngyai/go-cryptocom/v2/internal/mocks/id"
 )
 
 func TestClient_GetInstruments_Error(t *testing.T) {
@@ -135,15 +135,76 @@ func TestClient_GetIstruments_Success(t *testing.T) {
 				assert.Empty(t, body.Signature)
 				assert.Empty(t, map[string]interface{}{}, body.Params)
 
-				res := cdcexchange.InstrumentsResponse{
-					Result: cdcexchange.InstrumentResult{
-						Instruments: []cdcexchange.Instrument{{InstrumentName: instrument}},
+				_, err := w.Write([]byte(`{
+					"id": 1234,
+					"method": "public/get-instruments",
+					"code": 0,
+					"result": {
+						"data": [
+							{
+								"symbol": "` + instrument + `",
+								"inst_type": "PERPETUAL_SWAP",
+								"display_name": "BTCUSD Perpetual",
+								"base_ccy": "BTC",
+								"quote_ccy": "USD",
+								"quote_decimals": 1,
+								"quantity_decimals": 4,
+								"price_tick_size": "0.1",
+								"qty_tick_size": "0.0001",
+								"max_leverage": "50",
+								"tradable": true,
+								"expiry_timestamp_ms": 0,
+								"beta_product": false,
+								"underlying_symbol": "BTCUSD-INDEX",
+								"contract_size": "1.000000",
+								"margin_buy_enabled": false,
+								"margin_sell_enabled": false
+							},
+							{
+								"symbol": "CRO_USD",
+								"inst_type": "CCY_PAIR",
+								"price_tick_size": 0.00001,
+								"qty_tick_size": "1",
+								"max_leverage": null
+							}
+						]
+					}
+				}`))
+				require.NoError(t, err)
+			},
+			expectedResult: []cdcexchange.Instrument{
+				{
+					Symbol:           instrument,
+					Type:             "PERPETUAL_SWAP",
+					DisplayName:      "BTCUSD Perpetual",
+					BaseCurrency:     "BTC",
+					QuoteCurrency:    "USD",
+					QuoteDecimals:    1,
+					QuantityDecimals: 4,
+					PriceTickSize:    cdcexchange.MustParseDecimal("0.1"),
+					QtyTickSize:      cdcexchange.MustParseDecimal("0.0001"),
+					MaxLeverage:      cdcexchange.MustParseDecimal("50"),
+					Tradable:         true,
+					UnderlyingSymbol: "BTCUSD-INDEX",
+					ContractSize:     cdcexchange.MustParseDecimal("1"),
+					Raw: cdcexchange.InstrumentRaw{
+						PriceTickSize: "0.1",
+						QtyTickSize:   "0.0001",
+						MaxLeverage:   "50",
+						ContractSize:  "1.000000",
 					},
-				}
-
-				require.NoError(t, json.NewEncoder(w).Encode(res))
+				},
+				{
+					Symbol:        "CRO_USD",
+					Type:          "CCY_PAIR",
+					PriceTickSize: cdcexchange.MustParseDecimal("0.00001"),
+					QtyTickSize:   cdcexchange.MustParseDecimal("1"),
+					Raw: cdcexchange.InstrumentRaw{
+						PriceTickSize: "0.00001",
+						QtyTickSize:   "1",
+					},
+				},
 			},
-			expectedResult: []cdcexchange.Instrument{{InstrumentName: instrument}},
 		},
 	}
 	for _, tt := range tests {
@@ -177,17 +238,131 @@ func TestClient_GetIstruments_Success(t *testing.T) {
 	}
 }
 
-func TestClient_GetInstruments(t *testing.T) {
-	c, err := cdcexchange.New("apiKey", "secretKey", []cdcexchange.ClientOption{}...)
-	if err != nil {
-		t.Fatal(err)
+func TestInstrument_UnmarshalJSON_Error(t *testing.T) {
+	var instrument cdcexchange.Instrument
+	err := json.Unmarshal([]byte(`{"symbol": "BTC_USD", "price_tick_size": "abc"}`), &instrument)
+	require.Error(t, err)
+
+	assert.Contains(t, err.Error(), "price_tick_size")
+}
+
+func TestInstrument_Round(t *testing.T) {
+	instrument := cdcexchange.Instrument{
+		Symbol:        "BTC_USD",
+		PriceTickSize: cdcexchange.MustParseDecimal("0.5"),
+		QtyTickSize:   cdcexchange.MustParseDecimal("0.0001"),
 	}
-	got, err := c.GetInstruments(context.Background())
-	if err != nil {
-		t.Fatal(err)
+
+	tests := []struct {
+		name             string
+		value            string
+		expectedPrice    string
+		expectedQuantity string
+	}{
+		{name: "already a multiple", value: "100.5", expectedPrice: "100.5", expectedQuantity: "100.5"},
+		{name: "rounds price down and quantity down", value: "100.24999", expectedPrice: "100", expectedQuantity: "100.2499"},
+		{name: "rounds price up and quantity down", value: "100.3", expectedPrice: "100.5", expectedQuantity: "100.3"},
+		{name: "rounds price half up", value: "100.25", expectedPrice: "100.5", expectedQuantity: "100.25"},
+		{name: "rounds negative values away from 0 for price and towards 0 for quantity", value: "-0.00019", expectedPrice: "0", expectedQuantity: "-0.0001"},
+		{name: "zero", value: "0", expectedPrice: "0", expectedQuantity: "0"},
 	}
-	if len(got) == 0 {
-		t.Fatal("no instruments")
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			value := cdcexchange.MustParseDecimal(tt.value)
+
+			assert.Equal(t, tt.expectedPrice, instrument.RoundPrice(value).String())
+			assert.Equal(t, tt.expectedQuantity, instrument.RoundQuantity(value).String())
+		})
 	}
-	t.Logf("got instruments: %v ", got)
+
+	t.Run("returns value unchanged when tick size is unknown", func(t *testing.T) {
+		value := cdcexchange.MustParseDecimal("1.23456789")
+
+		assert.Equal(t, value, cdcexchange.Instrument{}.RoundPrice(value))
+		assert.Equal(t, value, cdcexchange.Instrument{}.RoundQuantity(value))
+	})
+}
+
+func TestInstrument_RoundToTickAndLot(t *testing.T) {
+	instrument := cdcexchange.Instrument{
+		Symbol:        "BTC_USD",
+		PriceTickSize: cdcexchange.MustParseDecimal("0.5"),
+		QtyTickSize:   cdcexchange.MustParseDecimal("0.0001"),
+	}
+
+	tests := []struct {
+		name             string
+		value            string
+		mode             cdcexchange.RoundingMode
+		expectedPrice    string
+		expectedQuantity string
+	}{
+		{name: "nearest", value: "100.25", mode: cdcexchange.RoundNearest, expectedPrice: "100.5", expectedQuantity: "100.25"},
+		{name: "down", value: "100.49999", mode: cdcexchange.RoundDown, expectedPrice: "100", expectedQuantity: "100.4999"},
+		{name: "up", value: "100.00001", mode: cdcexchange.RoundUp, expectedPrice: "100.5", expectedQuantity: "100.0001"},
+		{name: "down negative", value: "-0.00001", mode: cdcexchange.RoundDown, expectedPrice: "-0.5", expectedQuantity: "-0.0001"},
+		{name: "up negative", value: "-0.00019", mode: cdcexchange.RoundUp, expectedPrice: "0", expectedQuantity: "-0.0001"},
+		{name: "toward zero negative", value: "-0.00019", mode: cdcexchange.RoundTowardZero, expectedPrice: "0", expectedQuantity: "-0.0001"},
+		{name: "already a multiple", value: "100.5", mode: cdcexchange.RoundUp, expectedPrice: "100.5", expectedQuantity: "100.5"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			value := cdcexchange.MustParseDecimal(tt.value)
+
+			assert.Equal(t, tt.expectedPrice, instrument.RoundToTick(value, tt.mode).String())
+			assert.Equal(t, tt.expectedQuantity, instrument.RoundToLot(value, tt.mode).String())
+		})
+	}
+}
+
+func TestClient_GetInstruments(t *testing.T) {
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Contains(t, r.URL.Path, cdcexchange.MethodGetInstruments)
+
+		_, err := w.Write([]byte(`{
+			"id": 1,
+			"method": "public/get-instruments",
+			"code": 0,
+			"result": {
+				"data": [
+					{
+						"symbol": "BTC_USDT",
+						"inst_type": "CCY_PAIR",
+						"display_name": "BTC/USDT",
+						"base_ccy": "BTC",
+						"quote_ccy": "USDT",
+						"quote_decimals": 2,
+						"quantity_decimals": 5,
+						"price_tick_size": "0.01",
+						"qty_tick_size": "0.00001",
+						"max_leverage": "10",
+						"tradable": true,
+						"expiry_timestamp_ms": 0,
+						"beta_product": false,
+						"margin_buy_enabled": true,
+						"margin_sell_enabled": true
+					}
+				]
+			}
+		}`))
+		require.NoError(t, err)
+	}))
+	t.Cleanup(s.Close)
+
+	client, err := cdcexchange.New("api key", "secret key",
+		cdcexchange.WithHTTPClient(s.Client()),
+		cdcexchange.WithBaseURL(fmt.Sprintf("%s/", s.URL)),
+	)
+	require.NoError(t, err)
+
+	instruments, err := client.GetInstruments(context.Background())
+	require.NoError(t, err)
+
+	require.Len(t, instruments, 1)
+	assert.Equal(t, "BTC_USDT", instruments[0].Symbol)
+	assert.Equal(t, cdcexchange.InstrumentType("CCY_PAIR"), instruments[0].Type)
+	assert.Equal(t, "0.01", instruments[0].PriceTickSize.String())
+	assert.Equal(t, "0.00001", instruments[0].QtyTickSize.String())
+	assert.True(t, instruments[0].Tradable)
+	assert.True(t, instruments[0].MarginBuyEnabled)
 }
@@ -0,0 +1,131 @@
+package cdcexchange
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/sngyai/go-cryptocom/v2/internal/api"
+	"github.com/sngyai/go-cryptocom/v2/internal/auth"
+)
+
+const (
+	methodGetInterestHistory = "private/margin/get-interest-history"
+)
+
+type (
+	// GetInterestHistoryRequest is the request params sent for the private/margin/get-interest-history API.
+	GetInterestHistoryRequest struct {
+		// Currency represents the currency the interest was charged on (e.g. USDT).
+		// if Currency is omitted, interest for all currencies will be returned.
+		Currency string `json:"currency"`
+		// Start is the start timestamp (milliseconds since the Unix epoch)
+		// (Default: 24 hours ago)
+		Start time.Time `json:"start_ts"`
+		// End is the end timestamp (milliseconds since the Unix epoch)
+		// (Default: now)
+		End time.Time `json:"end_ts"`
+		// PageRequest is the page size (Default: 20, Max: 200) and page number (0-based) for pagination.
+		PageRequest
+	}
+
+	// GetInterestHistoryResponse is the base response returned from the private/margin/get-interest-history API.
+	GetInterestHistoryResponse struct {
+		// BaseResponse is the common response fields.
+		BaseResponse
+		// Result is the response attributes of the endpoint.
+		Result GetInterestHistoryResult `json:"result"`
+	}
+
+	// GetInterestHistoryResult is the result returned from the private/margin/get-interest-history API.
+	GetInterestHistoryResult struct {
+		// List is the array of interest records.
+		List []MarginInterest `json:"list"`
+	}
+
+	// MarginInterest represents a single accrual of interest on borrowed funds.
+	MarginInterest struct {
+		// LoanID is the ID of the loan the interest was charged on.
+		LoanID string `json:"loan_id"`
+		// Currency is the currency the interest was charged in (e.g. USDT).
+		Currency string `json:"currency"`
+		// Interest is the amount of interest charged.
+		Interest Decimal `json:"interest"`
+		// Borrowed is the amount borrowed when the interest was charged.
+		Borrowed Decimal `json:"borrowed"`
+		// HourlyInterestRate is the hourly interest rate used to calculate Interest.
+		HourlyInterestRate Decimal `json:"hourly_interest_rate"`
+		// Time is the time the interest was charged.
+		Time Timestamp `json:"time"`
+	}
+)
+
+// GetInterestHistory gets the history of interest accrued on borrowed funds in the margin account.
+//
+// Pagination is handled using page size (Default: 20, Max: 200) & number (0-based).
+// If paging is used, enumerate each page (starting with 0) until an empty list array appears in the response.
+//
+// req.Currency can be left blank to get interest for all currencies.
+//
+// Method: private/margin/get-interest-history
+func (c *Client) GetInterestHistory(ctx context.Context, req GetInterestHistoryRequest) ([]MarginInterest, error) {
+	if err := req.PageRequest.validate(); err != nil {
+		return nil, err
+	}
+
+	var (
+		id        = c.idGenerator.Generate()
+		timestamp = c.now().UnixMilli()
+		params    = make(map[string]interface{})
+	)
+
+	creds, err := c.credentials(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Currency != "" {
+		params["currency"] = req.Currency
+	}
+	if !req.Start.IsZero() {
+		params["start_ts"] = req.Start.UnixMilli()
+	}
+	if !req.End.IsZero() {
+		params["end_ts"] = req.End.UnixMilli()
+	}
+	req.PageRequest.setParams(params)
+
+	signature, err := c.signatureGenerator.GenerateSignature(auth.SignatureRequest{
+		APIKey:    creds.APIKey,
+		SecretKey: creds.SecretKey,
+		ID:        id,
+		Method:    methodGetInterestHistory,
+		Timestamp: timestamp,
+		Params:    params,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create signature: %w", err)
+	}
+
+	body := api.Request{
+		ID:        id,
+		Method:    methodGetInterestHistory,
+		Nonce:     timestamp,
+		Params:    params,
+		Signature: signature,
+		APIKey:    creds.APIKey,
+		Version:   api.V2,
+	}
+
+	var getInterestHistoryResponse GetInterestHistoryResponse
+	statusCode, err := c.requester.Post(ctx, body, methodGetInterestHistory, &getInterestHistoryResponse)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute post request: %w", err)
+	}
+
+	if err := c.requester.CheckResponse(statusCode, api.BaseResponse(getInterestHistoryResponse.BaseResponse)); err != nil {
+		return nil, fmt.Errorf("error received in response: %w", err)
+	}
+
+	return getInterestHistoryResponse.Result.List, nil
+}
@@ -0,0 +1,107 @@
+package cdcexchange_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/jonboulle/clockwork"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	cdcexchange "github.com/sngyai/go-cryptocom/v2"
+	"github.com/sngyai/go-cryptocom/v2/internal/api"
+	"github.com/sngyai/go-cryptocom/v2/internal/auth"
+	id_mocks "github.com/sngyai/go-cryptocom/v2/internal/mocks/id"
+	signature_mocks "github.com/sngyai/go-cryptocom/v2/internal/mocks/signature"
+)
+
+func TestClient_GetInterestHistory_Success(t *testing.T) {
+	const (
+		apiKey    = "some api key"
+		secretKey = "some secret key"
+		id        = int64(1234)
+		signature = "some signature"
+		currency  = "USDT"
+	)
+	now := time.Now()
+
+	ctrl, ctx := gomock.WithContext(context.Background(), t)
+	t.Cleanup(ctrl.Finish)
+
+	var (
+		signatureGenerator = signature_mocks.NewMockSignatureGenerator(ctrl)
+		idGenerator        = id_mocks.NewMockIDGenerator(ctrl)
+		clock              = clockwork.NewFakeClockAt(now)
+	)
+
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Contains(t, r.URL.Path, cdcexchange.MethodGetInterestHistory)
+		t.Cleanup(func() { require.NoError(t, r.Body.Close()) })
+
+		var body api.Request
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+
+		assert.Equal(t, cdcexchange.MethodGetInterestHistory, body.Method)
+		assert.Equal(t, currency, body.Params["currency"])
+		assert.NotContains(t, body.Params, "page")
+
+		_, err := w.Write([]byte(`{
+			"id": 1234,
+			"method": "private/margin/get-interest-history",
+			"code": 0,
+			"result": {
+				"list": [
+					{
+						"loan_id": "1234567",
+						"currency": "USDT",
+						"interest": 0.0025,
+						"borrowed": 250,
+						"hourly_interest_rate": 0.00001,
+						"time": 1635410001000
+					}
+				]
+			}
+		}`))
+		require.NoError(t, err)
+	}))
+	t.Cleanup(s.Close)
+
+	client, err := cdcexchange.New(apiKey, secretKey,
+		cdcexchange.WithIDGenerator(idGenerator),
+		cdcexchange.WithClock(clock),
+		cdcexchange.WithHTTPClient(s.Client()),
+		cdcexchange.WithBaseURL(fmt.Sprintf("%s/", s.URL)),
+		cdcexchange.WithSignatureGenerator(signatureGenerator),
+	)
+	require.NoError(t, err)
+
+	idGenerator.EXPECT().Generate().Return(id)
+	signatureGenerator.EXPECT().GenerateSignature(auth.SignatureRequest{
+		APIKey:    apiKey,
+		SecretKey: secretKey,
+		ID:        id,
+		Method:    cdcexchange.MethodGetInterestHistory,
+		Timestamp: now.UnixMilli(),
+		Params:    map[string]interface{}{"currency": currency},
+	}).Return(signature, nil)
+
+	interest, err := client.GetInterestHistory(ctx, cdcexchange.GetInterestHistoryRequest{Currency: currency})
+	require.NoError(t, err)
+
+	assert.Equal(t, []cdcexchange.MarginInterest{
+		{
+			LoanID:             "1234567",
+			Currency:           currency,
+			Interest:           cdcexchange.MustParseDecimal("0.0025"),
+			Borrowed:           cdcexchange.MustParseDecimal("250"),
+			HourlyInterestRate: cdcexchange.MustParseDecimal("0.00001"),
+			Time:               cdcexchange.Timestamp(time.UnixMilli(1635410001000)),
+		},
+	}, interest)
+}
@@ -0,0 +1,54 @@
+package cdcexchange
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/sngyai/go-cryptocom/v2/internal/api"
+)
+
+const (
+	methodGetLoanCurrencies = "public/margin/get-loan-currencies"
+)
+
+type (
+	// LoanCurrenciesResponse is the base response returned from the public/margin/get-loan-currencies API.
+	LoanCurrenciesResponse struct {
+		// BaseResponse is the common response fields.
+		BaseResponse
+		// Result is the response attributes of the endpoint.
+		Result []string `json:"result"`
+	}
+)
+
+// GetLoanCurrencies fetches the list of currencies which can be borrowed in the margin account.
+//
+// No authentication is required.
+//
+// Method: public/margin/get-loan-currencies
+func (c *Client) GetLoanCurrencies(ctx context.Context) ([]string, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.requester.URL(methodGetLoanCurrencies, api.V2), nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	statusCode, resBytes, err := c.requester.Do(ctx, req, methodGetLoanCurrencies)
+	if err != nil {
+		return nil, err
+	}
+
+	var loanCurrenciesResponse LoanCurrenciesResponse
+	if err := json.Unmarshal(resBytes, &loanCurrenciesResponse); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response body: %w", err)
+	}
+	loanCurrenciesResponse.SetRaw(resBytes)
+
+	if err := c.requester.CheckResponse(statusCode, api.BaseResponse(loanCurrenciesResponse.BaseResponse)); err != nil {
+		return nil, fmt.Errorf("error received in response: %w", err)
+	}
+
+	return loanCurrenciesResponse.Result, nil
+}
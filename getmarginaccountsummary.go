@@ -0,0 +1,121 @@
+package cdcexchange
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/sngyai/go-cryptocom/v2/internal/api"
+	"github.com/sngyai/go-cryptocom/v2/internal/auth"
+)
+
+const (
+	methodGetMarginAccountSummary = "private/margin/get-account-summary"
+)
+
+type (
+	// MarginAccountSummaryResponse is the base response returned from the private/margin/get-account-summary API.
+	MarginAccountSummaryResponse struct {
+		// BaseResponse is the common response fields.
+		BaseResponse
+		// Result is the response attributes of the endpoint.
+		Result MarginAccountSummary `json:"result"`
+	}
+
+	// MarginAccountSummary is the result returned from the private/margin/get-account-summary API.
+	MarginAccountSummary struct {
+		// Currency is the currency the totals are valued in (e.g. USDT).
+		Currency string `json:"currency"`
+		// TotalBalance is the total balance of the margin account.
+		TotalBalance Decimal `json:"total_balance"`
+		// TotalBorrowed is the total amount borrowed.
+		TotalBorrowed Decimal `json:"total_borrowed"`
+		// TotalAccruedInterest is the total interest accrued on borrowed amounts.
+		TotalAccruedInterest Decimal `json:"total_accrued_interest"`
+		// EquityValue is the collateral value of the margin account (TotalBalance - TotalBorrowed - TotalAccruedInterest).
+		EquityValue Decimal `json:"equity_value"`
+		// MarginRatio is the ratio between the equity value and the total borrowed.
+		MarginRatio Decimal `json:"margin_score"`
+		// LiquidationThreshold is the margin ratio at which the account will be liquidated.
+		LiquidationThreshold Decimal `json:"liquidation_threshold"`
+		// IsLiquidating is true when the account is currently being liquidated.
+		IsLiquidating bool `json:"is_liquidating"`
+		// Accounts is the per-currency balance details of the margin account.
+		Accounts []MarginAccount `json:"accounts"`
+		// Raw is the raw JSON of the result, for fields not decoded by this package.
+		Raw json.RawMessage `json:"-"`
+	}
+
+	// MarginAccount represents margin balance details of a specific token.
+	MarginAccount struct {
+		// Currency is the symbol for the currency (e.g. CRO).
+		Currency string `json:"currency"`
+		// Balance is the total balance (Available + Order).
+		Balance Decimal `json:"balance"`
+		// Available is the available balance (e.g. not in orders, or locked, etc.).
+		Available Decimal `json:"available"`
+		// Order is the balance locked in orders.
+		Order Decimal `json:"order"`
+		// Borrowed is the amount of the currency borrowed.
+		Borrowed Decimal `json:"borrowed"`
+		// Position is the net position of the currency (Balance - Borrowed).
+		Position Decimal `json:"position"`
+		// AccruedInterest is the interest accrued on the borrowed amount.
+		AccruedInterest Decimal `json:"accrued_interest"`
+		// LiquidationPrice is the price at which the position will be liquidated, 0 if not applicable.
+		LiquidationPrice Decimal `json:"liquidation_price"`
+	}
+)
+
+// GetMarginAccountSummary returns the margin account summary of the user,
+// including its collateral value, margin ratio, liquidation threshold and per-currency balances.
+//
+// Method: private/margin/get-account-summary
+func (c *Client) GetMarginAccountSummary(ctx context.Context) (*MarginAccountSummary, error) {
+	var (
+		id        = c.idGenerator.Generate()
+		timestamp = c.now().UnixMilli()
+		params    = make(map[string]interface{})
+	)
+
+	creds, err := c.credentials(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	signature, err := c.signatureGenerator.GenerateSignature(auth.SignatureRequest{
+		APIKey:    creds.APIKey,
+		SecretKey: creds.SecretKey,
+		ID:        id,
+		Method:    methodGetMarginAccountSummary,
+		Timestamp: timestamp,
+		Params:    params,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create signature: %w", err)
+	}
+
+	body := api.Request{
+		ID:        id,
+		Method:    methodGetMarginAccountSummary,
+		Nonce:     timestamp,
+		Params:    params,
+		Signature: signature,
+		APIKey:    creds.APIKey,
+		Version:   api.V2,
+	}
+
+	var marginAccountSummaryResponse MarginAccountSummaryResponse
+	statusCode, err := c.requester.Post(ctx, body, methodGetMarginAccountSummary, &marginAccountSummaryResponse)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute post request: %w", err)
+	}
+
+	if err := c.requester.CheckResponse(statusCode, api.BaseResponse(marginAccountSummaryResponse.BaseResponse)); err != nil {
+		return nil, fmt.Errorf("error received in response: %w", err)
+	}
+
+	marginAccountSummaryResponse.Result.Raw = marginAccountSummaryResponse.RawResult
+
+	return &marginAccountSummaryResponse.Result, nil
+}
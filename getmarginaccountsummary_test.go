@@ -0,0 +1,232 @@
+package cdcexchange_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/jonboulle/clockwork"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	cdcexchange "github.com/sngyai/go-cryptocom/v2"
+	cdcerrors "github.com/sngyai/go-cryptocom/v2/errors"
+	"github.com/sngyai/go-cryptocom/v2/internal/api"
+	"github.com/sngyai/go-cryptocom/v2/internal/auth"
+	id_mocks "github.com/sngyai/go-cryptocom/v2/internal/mocks/id"
+	signature_mocks "github.com/sngyai/go-cryptocom/v2/internal/mocks/signature"
+)
+
+func TestClient_GetMarginAccountSummary_Error(t *testing.T) {
+	const (
+		apiKey    = "some api key"
+		secretKey = "some secret key"
+		id        = int64(1234)
+	)
+	testErr := errors.New("some error")
+
+	tests := []struct {
+		name         string
+		client       http.Client
+		signatureErr error
+		expectedErr  error
+	}{
+		{
+			name:         "returns error given error generating signature",
+			signatureErr: testErr,
+			expectedErr:  testErr,
+		},
+		{
+			name: "returns error given error making request",
+			client: http.Client{
+				Transport: roundTripper{
+					err: testErr,
+				},
+			},
+			expectedErr: testErr,
+		},
+		{
+			name: "returns error given error response",
+			client: http.Client{
+				Transport: roundTripper{
+					statusCode: http.StatusTeapot,
+					response: api.BaseResponse{
+						Code: "10002",
+					},
+				},
+			},
+			expectedErr: cdcerrors.ResponseError{
+				Code:           10002,
+				HTTPStatusCode: http.StatusTeapot,
+				Err:            cdcerrors.ErrUnauthorized,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl, ctx := gomock.WithContext(context.Background(), t)
+			t.Cleanup(ctrl.Finish)
+
+			var (
+				idGenerator        = id_mocks.NewMockIDGenerator(ctrl)
+				signatureGenerator = signature_mocks.NewMockSignatureGenerator(ctrl)
+				now                = time.Now()
+				clock              = clockwork.NewFakeClockAt(now)
+			)
+
+			client, err := cdcexchange.New(apiKey, secretKey,
+				cdcexchange.WithIDGenerator(idGenerator),
+				cdcexchange.WithClock(clock),
+				cdcexchange.WithHTTPClient(&tt.client),
+				cdcexchange.WithSignatureGenerator(signatureGenerator),
+			)
+			require.NoError(t, err)
+
+			idGenerator.EXPECT().Generate().Return(id)
+			signatureGenerator.EXPECT().GenerateSignature(auth.SignatureRequest{
+				APIKey:    apiKey,
+				SecretKey: secretKey,
+				ID:        id,
+				Method:    cdcexchange.MethodGetMarginAccountSummary,
+				Timestamp: now.UnixMilli(),
+				Params:    map[string]interface{}{},
+			}).Return("signature", tt.signatureErr)
+
+			summary, err := client.GetMarginAccountSummary(ctx)
+			require.Error(t, err)
+
+			assert.Nil(t, summary)
+			assert.True(t, errors.Is(err, tt.expectedErr))
+		})
+	}
+}
+
+func TestClient_GetMarginAccountSummary_Success(t *testing.T) {
+	const (
+		apiKey    = "some api key"
+		secretKey = "some secret key"
+		id        = int64(1234)
+		signature = "some signature"
+	)
+	now := time.Now()
+
+	ctrl, ctx := gomock.WithContext(context.Background(), t)
+	t.Cleanup(ctrl.Finish)
+
+	var (
+		signatureGenerator = signature_mocks.NewMockSignatureGenerator(ctrl)
+		idGenerator        = id_mocks.NewMockIDGenerator(ctrl)
+		clock              = clockwork.NewFakeClockAt(now)
+	)
+
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Contains(t, r.URL.Path, cdcexchange.MethodGetMarginAccountSummary)
+		t.Cleanup(func() { require.NoError(t, r.Body.Close()) })
+
+		var body api.Request
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+
+		assert.Equal(t, cdcexchange.MethodGetMarginAccountSummary, body.Method)
+		assert.Equal(t, id, body.ID)
+		assert.Equal(t, apiKey, body.APIKey)
+		assert.Equal(t, now.UnixMilli(), body.Nonce)
+		assert.Equal(t, signature, body.Signature)
+
+		_, err := w.Write([]byte(`{
+			"id": 1234,
+			"method": "private/margin/get-account-summary",
+			"code": 0,
+			"result": {
+				"currency": "USDT",
+				"total_balance": 1500.5,
+				"total_borrowed": 500,
+				"total_accrued_interest": 0.5,
+				"equity_value": 1000,
+				"margin_score": 3.001,
+				"liquidation_threshold": 1.1,
+				"is_liquidating": false,
+				"accounts": [
+					{
+						"currency": "BTC",
+						"balance": 0.05,
+						"available": 0.04,
+						"order": 0.01,
+						"borrowed": 0,
+						"position": 0.05,
+						"accrued_interest": 0,
+						"liquidation_price": 0
+					},
+					{
+						"currency": "USDT",
+						"balance": 0,
+						"available": 0,
+						"order": 0,
+						"borrowed": 500,
+						"position": -500,
+						"accrued_interest": 0.5,
+						"liquidation_price": 9000
+					}
+				]
+			}
+		}`))
+		require.NoError(t, err)
+	}))
+	t.Cleanup(s.Close)
+
+	client, err := cdcexchange.New(apiKey, secretKey,
+		cdcexchange.WithIDGenerator(idGenerator),
+		cdcexchange.WithClock(clock),
+		cdcexchange.WithHTTPClient(s.Client()),
+		cdcexchange.WithBaseURL(fmt.Sprintf("%s/", s.URL)),
+		cdcexchange.WithSignatureGenerator(signatureGenerator),
+	)
+	require.NoError(t, err)
+
+	idGenerator.EXPECT().Generate().Return(id)
+	signatureGenerator.EXPECT().GenerateSignature(auth.SignatureRequest{
+		APIKey:    apiKey,
+		SecretKey: secretKey,
+		ID:        id,
+		Method:    cdcexchange.MethodGetMarginAccountSummary,
+		Timestamp: now.UnixMilli(),
+		Params:    map[string]interface{}{},
+	}).Return(signature, nil)
+
+	summary, err := client.GetMarginAccountSummary(ctx)
+	require.NoError(t, err)
+
+	require.NotEmpty(t, summary.Raw)
+	summary.Raw = nil
+
+	assert.Equal(t, &cdcexchange.MarginAccountSummary{
+		Currency:             "USDT",
+		TotalBalance:         cdcexchange.MustParseDecimal("1500.5"),
+		TotalBorrowed:        cdcexchange.MustParseDecimal("500"),
+		TotalAccruedInterest: cdcexchange.MustParseDecimal("0.5"),
+		EquityValue:          cdcexchange.MustParseDecimal("1000"),
+		MarginRatio:          cdcexchange.MustParseDecimal("3.001"),
+		LiquidationThreshold: cdcexchange.MustParseDecimal("1.1"),
+		Accounts: []cdcexchange.MarginAccount{
+			{
+				Currency:  "BTC",
+				Balance:   cdcexchange.MustParseDecimal("0.05"),
+				Available: cdcexchange.MustParseDecimal("0.04"),
+				Order:     cdcexchange.MustParseDecimal("0.01"),
+				Position:  cdcexchange.MustParseDecimal("0.05"),
+			},
+			{
+				Currency:         "USDT",
+				Borrowed:         cdcexchange.MustParseDecimal("500"),
+				Position:         cdcexchange.MustParseDecimal("-500"),
+				AccruedInterest:  cdcexchange.MustParseDecimal("0.5"),
+				LiquidationPrice: cdcexchange.MustParseDecimal("9000"),
+			},
+		},
+	}, summary)
+}
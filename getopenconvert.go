@@ -0,0 +1,147 @@
+package cdcexchange
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/sngyai/go-cryptocom/v2/errors"
+	"github.com/sngyai/go-cryptocom/v2/internal/api"
+	"github.com/sngyai/go-cryptocom/v2/internal/auth"
+)
+
+const (
+	methodGetOpenConvert = "private/staking/get-open-convert"
+
+	ConvertStatusNew       ConvertStatus = "NEW"
+	ConvertStatusPending   ConvertStatus = "PENDING"
+	ConvertStatusCompleted ConvertStatus = "COMPLETED"
+	ConvertStatusRejected  ConvertStatus = "REJECTED"
+)
+
+type (
+	// ConvertStatus is the status of a conversion request.
+	ConvertStatus string
+
+	// GetOpenConvertRequest is the request params sent for the private/staking/get-open-convert API.
+	GetOpenConvertRequest struct {
+		// Start is the start timestamp (milliseconds since the Unix epoch)
+		// (Default: 30 days ago)
+		Start time.Time `json:"start_time"`
+		// End is the end timestamp (milliseconds since the Unix epoch)
+		// (Default: now)
+		End time.Time `json:"end_time"`
+		// Limit represents maximum number of requests returned (for pagination)
+		// (Default: 20, Max: 500)
+		// if Limit is not set, it will be set as 20 by default.
+		Limit Optional[int] `json:"limit"`
+	}
+
+	// GetOpenConvertResponse is the base response returned from the private/staking/get-open-convert API.
+	GetOpenConvertResponse struct {
+		// BaseResponse is the common response fields.
+		BaseResponse
+		// Result is the response attributes of the endpoint.
+		Result GetOpenConvertResult `json:"result"`
+	}
+
+	// GetOpenConvertResult is the result returned from the private/staking/get-open-convert API.
+	GetOpenConvertResult struct {
+		// Data is the list of open conversion requests.
+		Data []ConvertRequestDetail `json:"data"`
+	}
+
+	// ConvertRequestDetail represents the details of a conversion request.
+	ConvertRequestDetail struct {
+		// ConvertID is the unique identifier of the conversion request.
+		ConvertID int64 `json:"convert_id"`
+		// FromInstrumentName is the instrument converted from.
+		FromInstrumentName string `json:"from_instrument_name"`
+		// ToInstrumentName is the instrument converted to.
+		ToInstrumentName string `json:"to_instrument_name"`
+		// ExpectedRate is the expected conversion rate.
+		ExpectedRate Decimal `json:"expected_rate"`
+		// FromQuantity is the quantity requested to be converted.
+		FromQuantity Decimal `json:"from_quantity"`
+		// SlippageToleranceBps is the accepted slippage, in basis points.
+		SlippageToleranceBps Decimal `json:"slippage_tolerance_bps"`
+		// ActualRate is the rate the conversion was executed at.
+		ActualRate Decimal `json:"actual_rate"`
+		// ToQuantity is the quantity received from the conversion.
+		ToQuantity Decimal `json:"to_quantity"`
+		// Status is the status of the conversion request.
+		Status ConvertStatus `json:"status"`
+		// CreateTime is the request creation time.
+		CreateTime Timestamp `json:"create_timestamp_ms"`
+	}
+)
+
+// GetOpenConvert gets the conversion requests which are still being processed.
+//
+// Pagination is handled using Limit (Default: 20, Max: 500) and moving the End timestamp
+// back to the CreateTime of the oldest returned request.
+//
+// Method: private/staking/get-open-convert
+func (c *Client) GetOpenConvert(ctx context.Context, req GetOpenConvertRequest) ([]ConvertRequestDetail, error) {
+	limit, hasLimit := req.Limit.Get()
+	if limit < 0 {
+		return nil, errors.InvalidParameterError{Parameter: "req.Limit", Reason: "cannot be less than 0"}
+	}
+	if limit > 500 {
+		return nil, errors.InvalidParameterError{Parameter: "req.Limit", Reason: "cannot be greater than 500"}
+	}
+
+	var (
+		id        = c.idGenerator.Generate()
+		timestamp = c.now().UnixMilli()
+		params    = make(map[string]interface{})
+	)
+
+	creds, err := c.credentials(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if !req.Start.IsZero() {
+		params["start_time"] = req.Start.UnixMilli()
+	}
+	if !req.End.IsZero() {
+		params["end_time"] = req.End.UnixMilli()
+	}
+	if hasLimit {
+		params["limit"] = limit
+	}
+
+	signature, err := c.signatureGenerator.GenerateSignature(auth.SignatureRequest{
+		APIKey:    creds.APIKey,
+		SecretKey: creds.SecretKey,
+		ID:        id,
+		Method:    methodGetOpenConvert,
+		Timestamp: timestamp,
+		Params:    params,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create signature: %w", err)
+	}
+
+	body := api.Request{
+		ID:        id,
+		Method:    methodGetOpenConvert,
+		Nonce:     timestamp,
+		Params:    params,
+		Signature: signature,
+		APIKey:    creds.APIKey,
+	}
+
+	var getOpenConvertResponse GetOpenConvertResponse
+	statusCode, err := c.requester.Post(ctx, body, methodGetOpenConvert, &getOpenConvertResponse)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute post request: %w", err)
+	}
+
+	if err := c.requester.CheckResponse(statusCode, api.BaseResponse(getOpenConvertResponse.BaseResponse)); err != nil {
+		return nil, fmt.Errorf("error received in response: %w", err)
+	}
+
+	return getOpenConvertResponse.Result.Data, nil
+}
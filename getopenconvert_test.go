@@ -0,0 +1,114 @@
+package cdcexchange_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/jonboulle/clockwork"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	cdcexchange "github.com/sngyai/go-cryptocom/v2"
+	"github.com/sngyai/go-cryptocom/v2/internal/api"
+	"github.com/sngyai/go-cryptocom/v2/internal/auth"
+	id_mocks "github.com/sngyai/go-cryptocom/v2/internal/mocks/id"
+	signature_mocks "github.com/sngyai/go-cryptocom/v2/internal/mocks/signature"
+)
+
+func TestClient_GetOpenConvert_Success(t *testing.T) {
+	const (
+		apiKey    = "some api key"
+		secretKey = "some secret key"
+		id        = int64(1234)
+		signature = "some signature"
+		limit     = 10
+	)
+	now := time.Now()
+
+	ctrl, ctx := gomock.WithContext(context.Background(), t)
+	t.Cleanup(ctrl.Finish)
+
+	var (
+		signatureGenerator = signature_mocks.NewMockSignatureGenerator(ctrl)
+		idGenerator        = id_mocks.NewMockIDGenerator(ctrl)
+		clock              = clockwork.NewFakeClockAt(now)
+	)
+
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Contains(t, r.URL.Path, cdcexchange.MethodGetOpenConvert)
+		t.Cleanup(func() { require.NoError(t, r.Body.Close()) })
+
+		var body api.Request
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+
+		assert.Equal(t, cdcexchange.MethodGetOpenConvert, body.Method)
+		assert.Equal(t, float64(limit), body.Params["limit"])
+
+		_, err := w.Write([]byte(`{
+			"id": 1234,
+			"method": "private/staking/get-open-convert",
+			"code": 0,
+			"result": {
+				"data": [
+					{
+						"from_instrument_name": "ETH.staked",
+						"to_instrument_name": "CDCETH",
+						"expected_rate": "1.0203",
+						"from_quantity": "3.14159265",
+						"slippage_tolerance_bps": "3",
+						"actual_rate": "1.0203",
+						"to_quantity": "3.14159265",
+						"convert_id": 1,
+						"status": "NEW",
+						"create_timestamp_ms": "1688140984005"
+					}
+				]
+			}
+		}`))
+		require.NoError(t, err)
+	}))
+	t.Cleanup(s.Close)
+
+	client, err := cdcexchange.New(apiKey, secretKey,
+		cdcexchange.WithIDGenerator(idGenerator),
+		cdcexchange.WithClock(clock),
+		cdcexchange.WithHTTPClient(s.Client()),
+		cdcexchange.WithBaseURL(fmt.Sprintf("%s/", s.URL)),
+		cdcexchange.WithSignatureGenerator(signatureGenerator),
+	)
+	require.NoError(t, err)
+
+	idGenerator.EXPECT().Generate().Return(id)
+	signatureGenerator.EXPECT().GenerateSignature(auth.SignatureRequest{
+		APIKey:    apiKey,
+		SecretKey: secretKey,
+		ID:        id,
+		Method:    cdcexchange.MethodGetOpenConvert,
+		Timestamp: now.UnixMilli(),
+		Params:    map[string]interface{}{"limit": limit},
+	}).Return(signature, nil)
+
+	requests, err := client.GetOpenConvert(ctx, cdcexchange.GetOpenConvertRequest{Limit: cdcexchange.Some(limit)})
+	require.NoError(t, err)
+
+	assert.Equal(t, []cdcexchange.ConvertRequestDetail{
+		{
+			ConvertID:            1,
+			FromInstrumentName:   "ETH.staked",
+			ToInstrumentName:     "CDCETH",
+			ExpectedRate:         cdcexchange.MustParseDecimal("1.0203"),
+			FromQuantity:         cdcexchange.MustParseDecimal("3.14159265"),
+			SlippageToleranceBps: cdcexchange.MustParseDecimal("3"),
+			ActualRate:           cdcexchange.MustParseDecimal("1.0203"),
+			ToQuantity:           cdcexchange.MustParseDecimal("3.14159265"),
+			Status:               cdcexchange.ConvertStatusNew,
+			CreateTime:           cdcexchange.Timestamp(time.UnixMilli(1688140984005)),
+		},
+	}, requests)
+}
@@ -2,17 +2,19 @@ package cdcexchange
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"strconv"
 
-	"github.com/sngyai/go-cryptocom/errors"
-	"github.com/sngyai/go-cryptocom/internal/api"
-	"github.com/sngyai/go-cryptocom/internal/auth"
-	"github.com/sngyai/go-cryptocom/internal/time"
+	"github.com/sngyai/go-cryptocom/v2/internal/api"
+	"github.com/sngyai/go-cryptocom/v2/internal/auth"
 )
 
 const (
 	methodGetOpenOrders = "private/get-open-orders"
 
+	OrderStatusNew       OrderStatus = "NEW"
+	OrderStatusPending   OrderStatus = "PENDING"
 	OrderStatusActive    OrderStatus = "ACTIVE"
 	OrderStatusCancelled OrderStatus = "CANCELED"
 	OrderStatusFilled    OrderStatus = "FILLED"
@@ -29,19 +31,18 @@ type (
 		// InstrumentName represents the currency pair for the orders (e.g. ETH_CRO or BTC_USDT).
 		// if InstrumentName is omitted, all instruments will be returned.
 		InstrumentName string `json:"instrument_name"`
-		// PageSize represents maximum number of orders returned (for pagination)
-		// (Default: 20, Max: 200)
-		// if PageSize is 0, it will be set as 20 by default.
-		PageSize int `json:"page_size"`
-		// Page represents the page number (for pagination)
-		// (0-based)
-		Page int `json:"page"`
+		// PageRequest is the page size (Default: 20, Max: 200) and page number (0-based) for pagination.
+		PageRequest
+		// ClientOID filters the orders by Client order ID.
+		// The filter is applied to the returned page, so Count will reflect the filtered orders.
+		// if ClientOID is omitted, all open orders will be returned.
+		ClientOID string `json:"client_oid"`
 	}
 
 	// GetOpenOrdersResponse is the base response returned from the private/get-open-orders API.
 	GetOpenOrdersResponse struct {
-		// api.BaseResponse is the common response fields.
-		api.BaseResponse
+		// BaseResponse is the common response fields.
+		BaseResponse
 		// Result is the response attributes of the endpoint.
 		Result GetOpenOrdersResult `json:"result"`
 	}
@@ -52,39 +53,41 @@ type (
 		Count int `json:"count"`
 		// OrderList is the array of open orders.
 		OrderList []Order `json:"order_list"`
+		// Raw is the raw JSON of the result, for fields not decoded by this package.
+		Raw json.RawMessage `json:"-"`
 	}
 
 	// Order represents the details of a specific order.
 	// Note: To detect a 'partial filled' status, look for status as ACTIVE and cumulative_quantity > 0.
 	Order struct {
-		// Status is the status of the order, can be ACTIVE, CANCELED, FILLED, REJECTED or EXPIRED.
+		// Status is the status of the order, can be NEW, PENDING, ACTIVE, CANCELED, FILLED, REJECTED or EXPIRED.
 		Status OrderStatus `json:"status"`
 		// Reason is the reason code for rejected orders (see "Response and Reason Codes").
 		Reason int64 `json:"reason"`
 		// Side represents whether the order is buy or sell.
 		Side OrderSide `json:"side"`
 		// Price is the price specified in the order.
-		Price float64 `json:"price"`
+		Price Decimal `json:"price"`
 		// Quantity	is the quantity specified in the order.
-		Quantity float64 `json:"quantity"`
+		Quantity Decimal `json:"quantity"`
 		// OrderID is the unique identifier for the order.
 		OrderID string `json:"order_id"`
 		// ClientOID is the optional Client order ID (if provided in request when creating the order).
 		ClientOID string `json:"client_oid"`
 		// CreateTime is the order creation time.
-		CreateTime time.Time `json:"create_time"`
+		CreateTime Timestamp `json:"create_time"`
 		// UpdateTime is the order update time.
-		UpdateTime time.Time `json:"update_time"`
+		UpdateTime Timestamp `json:"update_time"`
 		// Type represents the type of order.
 		OrderType OrderType `json:"type"`
 		// InstrumentName represents the currency pair to trade (e.g. ETH_CRO or BTC_USDT).
 		InstrumentName string `json:"instrument_name"`
 		// CumulativeQuantity is the cumulative-executed quantity (for partially filled orders).
-		CumulativeQuantity float64 `json:"cumulative_quantity"`
+		CumulativeQuantity Decimal `json:"cumulative_quantity"`
 		// CumulativeValue is the cumulative-executed value (for partially filled orders).
-		CumulativeValue float64 `json:"cumulative_value"`
+		CumulativeValue Decimal `json:"cumulative_value"`
 		// AvgPrice is the average filled price. If none is filled, 0 is returned.
-		AvgPrice float64 `json:"avg_price"`
+		AvgPrice Decimal `json:"avg_price"`
 		// FeeCurrency is the currency used for the fees (e.g. CRO).
 		FeeCurrency string `json:"fee_currency"`
 		// TimeInForce represents how long the order should be active before being cancelled.
@@ -99,42 +102,134 @@ type (
 		ExecInst ExecInst `json:"exec_inst"`
 		// TriggerPrice is the price at which the order is triggered.
 		// Used with STOP_LOSS, STOP_LIMIT, TAKE_PROFIT, and TAKE_PROFIT_LIMIT orders.
-		TriggerPrice float64 `json:"trigger_price"`
+		TriggerPrice Decimal `json:"trigger_price"`
+		// RefPrice is the v1 reference price at which the order is triggered.
+		// Used with STOP_LOSS, STOP_LIMIT, TAKE_PROFIT, and TAKE_PROFIT_LIMIT orders.
+		RefPrice Decimal `json:"ref_price"`
+		// RefPriceType is the price the RefPrice is compared against (MARK_PRICE, INDEX_PRICE or LAST_PRICE).
+		RefPriceType string `json:"ref_price_type"`
+		// AccountID is the account the order was placed by.
+		AccountID string `json:"account_id"`
+		// OrderValue is the value of the order (price * quantity).
+		OrderValue Decimal `json:"order_value"`
+		// CumulativeFee is the cumulative fee charged for the executed quantity.
+		CumulativeFee Decimal `json:"cumulative_fee"`
+		// MakerFeeRate is the fee rate charged if the order is filled as a maker (e.g. 0.00025).
+		MakerFeeRate Decimal `json:"maker_fee_rate"`
+		// TakerFeeRate is the fee rate charged if the order is filled as a taker (e.g. 0.0004).
+		TakerFeeRate Decimal `json:"taker_fee_rate"`
+		// OrderDate is the date the order was created (e.g. 2022-07-13).
+		OrderDate string `json:"order_date"`
+		// UpdateUserID is the user who last updated the order.
+		UpdateUserID string `json:"update_user_id"`
+		// OrderListID is the order list (e.g. OCO) the order belongs to, empty if it isn't part of a list.
+		OrderListID string `json:"list_id"`
+		// ContingencyType is the type of the order list the order belongs to (e.g. OCO), empty if it isn't part of a list.
+		ContingencyType string `json:"contingency_type"`
 	}
 )
 
+// UnmarshalJSON decodes an order returned by either API version.
+//
+// The v1 names of renamed fields (order_type, limit_price and fee_instrument_name) are decoded into
+// OrderType, Price and FeeCurrency, and the nanosecond create_time_ns and update_time_ns take precedence
+// over create_time and update_time. reason is decoded from either a number or a string, with "" decoded as 0.
+func (o *Order) UnmarshalJSON(data []byte) error {
+	type order Order
+	v := struct {
+		*order
+		V1OrderType       OrderType       `json:"order_type"`
+		LimitPrice        *Decimal        `json:"limit_price"`
+		FeeInstrumentName string          `json:"fee_instrument_name"`
+		CreateTimeNs      *Timestamp      `json:"create_time_ns"`
+		UpdateTimeNs      *Timestamp      `json:"update_time_ns"`
+		OrderListID       json.RawMessage `json:"list_id"`
+		Reason            json.RawMessage `json:"reason"`
+	}{order: (*order)(o)}
+
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+
+	if v.V1OrderType != "" {
+		o.OrderType = v.V1OrderType
+	}
+	if v.LimitPrice != nil {
+		o.Price = *v.LimitPrice
+	}
+	if v.FeeInstrumentName != "" {
+		o.FeeCurrency = v.FeeInstrumentName
+	}
+	if v.CreateTimeNs != nil {
+		o.CreateTime = *v.CreateTimeNs
+	}
+	if v.UpdateTimeNs != nil {
+		o.UpdateTime = *v.UpdateTimeNs
+	}
+
+	orderListID, err := unmarshalID("list_id", v.OrderListID)
+	if err != nil {
+		return err
+	}
+	o.OrderListID = orderListID
+
+	reason, err := unmarshalID("reason", v.Reason)
+	if err != nil {
+		return err
+	}
+	o.Reason = 0
+	if reason != "" {
+		if o.Reason, err = strconv.ParseInt(reason, 10, 64); err != nil {
+			return fmt.Errorf("invalid reason %s: %w", string(v.Reason), err)
+		}
+	}
+
+	return nil
+}
+
+// IsValid returns whether s is a known order status.
+func (s OrderStatus) IsValid() bool {
+	switch s {
+	case OrderStatusNew, OrderStatusPending, OrderStatusActive, OrderStatusCancelled, OrderStatusFilled, OrderStatusRejected, OrderStatusExpired:
+		return true
+	default:
+		return false
+	}
+}
+
 // GetOpenOrders gets all open orders for a particular instrument.
 //
 // Pagination is handled using page size (Default: 20, Max: 200) & number (0-based).
 //
 // req.Timeframe can be left blank to get open orders for all instruments.
 //
+// req.ClientOID can be used to look up an open order by its Client order ID.
+//
 // Method: private/get-open-orders
 func (c *Client) GetOpenOrders(ctx context.Context, req GetOpenOrdersRequest) (*GetOpenOrdersResult, error) {
-	if req.PageSize < 0 {
-		return nil, errors.InvalidParameterError{Parameter: "req.Limit", Reason: "cannot be less than 0"}
-	}
-	if req.PageSize > 200 {
-		return nil, errors.InvalidParameterError{Parameter: "req.Limit", Reason: "cannot be greater than 200"}
+	if err := req.PageRequest.validate(); err != nil {
+		return nil, err
 	}
 
 	var (
 		id        = c.idGenerator.Generate()
-		timestamp = c.clock.Now().UnixMilli()
+		timestamp = c.now().UnixMilli()
 		params    = make(map[string]interface{})
 	)
 
+	creds, err := c.credentials(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	if req.InstrumentName != "" {
 		params["instrument_name"] = req.InstrumentName
 	}
-	if req.PageSize != 0 {
-		params["page_size"] = req.PageSize
-	}
-	params["page"] = req.Page
+	req.PageRequest.setParams(params)
 
 	signature, err := c.signatureGenerator.GenerateSignature(auth.SignatureRequest{
-		APIKey:    c.apiKey,
-		SecretKey: c.secretKey,
+		APIKey:    creds.APIKey,
+		SecretKey: creds.SecretKey,
 		ID:        id,
 		Method:    methodGetOpenOrders,
 		Timestamp: timestamp,
@@ -150,7 +245,7 @@ func (c *Client) GetOpenOrders(ctx context.Context, req GetOpenOrdersRequest) (*
 		Nonce:     timestamp,
 		Params:    params,
 		Signature: signature,
-		APIKey:    c.apiKey,
+		APIKey:    creds.APIKey,
 	}
 
 	var getOpenOrdersResponse GetOpenOrdersResponse
@@ -159,9 +254,23 @@ func (c *Client) GetOpenOrders(ctx context.Context, req GetOpenOrdersRequest) (*
 		return nil, fmt.Errorf("failed to execute post request: %w", err)
 	}
 
-	if err := c.requester.CheckErrorResponse(statusCode, getOpenOrdersResponse.Code); err != nil {
+	if err := c.requester.CheckResponse(statusCode, api.BaseResponse(getOpenOrdersResponse.BaseResponse)); err != nil {
 		return nil, fmt.Errorf("error received in response: %w", err)
 	}
 
+	if req.ClientOID != "" {
+		orders := make([]Order, 0, 1)
+		for _, order := range getOpenOrdersResponse.Result.OrderList {
+			if order.ClientOID == req.ClientOID {
+				orders = append(orders, order)
+			}
+		}
+
+		getOpenOrdersResponse.Result.OrderList = orders
+		getOpenOrdersResponse.Result.Count = len(orders)
+	}
+
+	getOpenOrdersResponse.Result.Raw = getOpenOrdersResponse.RawResult
+
 	return &getOpenOrdersResponse.Result, nil
 }
@@ -15,13 +15,12 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
-	cdcexchange "github.com/sngyai/go-cryptocom"
-	cdcerrors "github.com/sngyai/go-cryptocom/errors"
-	"github.com/sngyai/go-cryptocom/internal/api"
-	"github.com/sngyai/go-cryptocom/internal/auth"
-	id_mocks "github.com/sngyai/go-cryptocom/internal/mocks/id"
-	signature_mocks "github.com/sngyai/go-cryptocom/internal/mocks/signature"
-	cdctime "github.com/sngyai/go-cryptocom/internal/time"
+	cdcexchange "github.com/sngyai/go-cryptocom/v2"
+	cdcerrors "github.com/sngyai/go-cryptocom/v2/errors"
+	"github.com/sngyai/go-cryptocom/v2/internal/api"
+	"github.com/sngyai/go-cryptocom/v2/internal/auth"
+	id_mocks "github.com/sngyai/go-cryptocom/v2/internal/mocks/id"
+	signature_mocks "github.com/sngyai/go-cryptocom/v2/internal/mocks/signature"
 )
 
 func TestClient_GetOpenOrders_Error(t *testing.T) {
@@ -47,11 +46,11 @@ func TestClient_GetOpenOrders_Error(t *testing.T) {
 			name: "returns error when page size is less than 0",
 			args: args{
 				req: cdcexchange.GetOpenOrdersRequest{
-					PageSize: -1,
+					PageRequest: cdcexchange.PageRequest{PageSize: cdcexchange.Some(-1)},
 				},
 			},
 			expectedErr: cdcerrors.InvalidParameterError{
-				Parameter: "req.Limit",
+				Parameter: "req.PageSize",
 				Reason:    "cannot be less than 0",
 			},
 		},
@@ -59,11 +58,11 @@ func TestClient_GetOpenOrders_Error(t *testing.T) {
 			name: "returns error when page size is greater than 200",
 			args: args{
 				req: cdcexchange.GetOpenOrdersRequest{
-					PageSize: 201,
+					PageRequest: cdcexchange.PageRequest{PageSize: cdcexchange.Some(201)},
 				},
 			},
 			expectedErr: cdcerrors.InvalidParameterError{
-				Parameter: "req.Limit",
+				Parameter: "req.PageSize",
 				Reason:    "cannot be greater than 200",
 			},
 		},
@@ -119,7 +118,7 @@ func TestClient_GetOpenOrders_Error(t *testing.T) {
 			)
 			require.NoError(t, err)
 
-			if tt.req.PageSize >= 0 && tt.req.PageSize < 200 {
+			if pageSize, _ := tt.req.PageSize.Get(); pageSize >= 0 && pageSize < 200 {
 				idGenerator.EXPECT().Generate().Return(id)
 				signatureGenerator.EXPECT().GenerateSignature(auth.SignatureRequest{
 					APIKey:    apiKey,
@@ -127,7 +126,7 @@ func TestClient_GetOpenOrders_Error(t *testing.T) {
 					ID:        id,
 					Method:    cdcexchange.MethodGetOpenOrders,
 					Timestamp: now.UnixMilli(),
-					Params:    map[string]interface{}{"page": 0},
+					Params:    map[string]interface{}{},
 				}).Return("signature", tt.signatureErr)
 			}
 
@@ -180,8 +179,7 @@ func TestClient_GetOpenOrders_Success(t *testing.T) {
 			args: args{
 				req: cdcexchange.GetOpenOrdersRequest{
 					InstrumentName: instrument,
-					PageSize:       100,
-					Page:           1,
+					PageRequest:    cdcexchange.PageRequest{PageSize: cdcexchange.Some(100), Page: cdcexchange.Some(1)},
 				},
 			},
 			handlerFunc: func(w http.ResponseWriter, r *http.Request) {
@@ -205,7 +203,7 @@ func TestClient_GetOpenOrders_Success(t *testing.T) {
 							"method":"",
 							"code":0,
 							"result":{
-								"order_id":1234,"order_list":[
+								"count":1234,"order_list":[
 									{
 										"status":"",
 										"reason":"",
@@ -234,8 +232,8 @@ func TestClient_GetOpenOrders_Success(t *testing.T) {
 				OrderList: []cdcexchange.Order{
 					{
 						ClientOID:  clientOID,
-						CreateTime: cdctime.Time(now),
-						UpdateTime: cdctime.Time(now),
+						CreateTime: cdcexchange.Timestamp(now),
+						UpdateTime: cdcexchange.Timestamp(now),
 					},
 				},
 			},
@@ -257,14 +255,14 @@ func TestClient_GetOpenOrders_Success(t *testing.T) {
 				assert.Equal(t, apiKey, body.APIKey)
 				assert.Equal(t, now.UnixMilli(), body.Nonce)
 				assert.Equal(t, signature, body.Signature)
-				assert.Equal(t, float64(0), body.Params["page"])
+				assert.NotContains(t, body.Params, "page")
 
 				res := fmt.Sprintf(`{
 							"id": 0,
 							"method":"",
 							"code":0,
 							"result":{
-								"order_id":1234,"order_list":[
+								"count":1234,"order_list":[
 									{
 										"status":"",
 										"reason":"",
@@ -283,16 +281,14 @@ func TestClient_GetOpenOrders_Success(t *testing.T) {
 				_, err := w.Write([]byte(res))
 				require.NoError(t, err)
 			},
-			expectedParams: map[string]interface{}{
-				"page": 0,
-			},
+			expectedParams: map[string]interface{}{},
 			expectedResult: cdcexchange.GetOpenOrdersResult{
 				Count: 1234,
 				OrderList: []cdcexchange.Order{
 					{
 						ClientOID:  clientOID,
-						CreateTime: cdctime.Time(now),
-						UpdateTime: cdctime.Time(now),
+						CreateTime: cdcexchange.Timestamp(now),
+						UpdateTime: cdcexchange.Timestamp(now),
 					},
 				},
 			},
@@ -334,7 +330,185 @@ func TestClient_GetOpenOrders_Success(t *testing.T) {
 			res, err := client.GetOpenOrders(ctx, tt.req)
 			require.NoError(t, err)
 
+			require.NotEmpty(t, res.Raw)
+			res.Raw = nil
 			assert.Equal(t, tt.expectedResult, *res)
 		})
 	}
 }
+
+func TestClient_GetOpenOrders_FiltersByClientOID(t *testing.T) {
+	const (
+		apiKey    = "some api key"
+		secretKey = "some secret key"
+		id        = int64(1234)
+		signature = "some signature"
+		clientOID = "some client oid"
+	)
+	now := time.Now()
+
+	ctrl, ctx := gomock.WithContext(context.Background(), t)
+	t.Cleanup(ctrl.Finish)
+
+	var (
+		signatureGenerator = signature_mocks.NewMockSignatureGenerator(ctrl)
+		idGenerator        = id_mocks.NewMockIDGenerator(ctrl)
+		clock              = clockwork.NewFakeClockAt(now)
+	)
+
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Contains(t, r.URL.Path, cdcexchange.MethodGetOpenOrders)
+
+		_, err := w.Write([]byte(`{
+			"id": 1234,
+			"method": "private/get-open-orders",
+			"code": 0,
+			"result": {
+				"count": 2,
+				"order_list": [
+					{"status": "ACTIVE", "order_id": "1", "client_oid": "some other client oid"},
+					{"status": "ACTIVE", "order_id": "2", "client_oid": "some client oid"}
+				]
+			}
+		}`))
+		require.NoError(t, err)
+	}))
+	t.Cleanup(s.Close)
+
+	client, err := cdcexchange.New(apiKey, secretKey,
+		cdcexchange.WithIDGenerator(idGenerator),
+		cdcexchange.WithClock(clock),
+		cdcexchange.WithHTTPClient(s.Client()),
+		cdcexchange.WithBaseURL(fmt.Sprintf("%s/", s.URL)),
+		cdcexchange.WithSignatureGenerator(signatureGenerator),
+	)
+	require.NoError(t, err)
+
+	idGenerator.EXPECT().Generate().Return(id)
+	signatureGenerator.EXPECT().GenerateSignature(auth.SignatureRequest{
+		APIKey:    apiKey,
+		SecretKey: secretKey,
+		ID:        id,
+		Method:    cdcexchange.MethodGetOpenOrders,
+		Timestamp: now.UnixMilli(),
+		Params:    map[string]interface{}{},
+	}).Return(signature, nil)
+
+	res, err := client.GetOpenOrders(ctx, cdcexchange.GetOpenOrdersRequest{ClientOID: clientOID})
+	require.NoError(t, err)
+
+	require.NotEmpty(t, res.Raw)
+	res.Raw = nil
+
+	assert.Equal(t, &cdcexchange.GetOpenOrdersResult{
+		Count: 1,
+		OrderList: []cdcexchange.Order{
+			{Status: cdcexchange.OrderStatusActive, OrderID: "2", ClientOID: clientOID},
+		},
+	}, res)
+}
+
+func TestOrder_UnmarshalJSON(t *testing.T) {
+	t.Run("decodes a v1 order", func(t *testing.T) {
+		var order cdcexchange.Order
+		require.NoError(t, json.Unmarshal([]byte(`{
+			"account_id": "52e7c00f-1324-5a6z-bfgt-de445bde21a5",
+			"order_id": "5340260040029378562",
+			"client_oid": "1658793053245",
+			"order_type": "STOP_LIMIT",
+			"time_in_force": "GOOD_TILL_CANCEL",
+			"side": "BUY",
+			"exec_inst": "",
+			"quantity": "0.0100",
+			"limit_price": "50000.0",
+			"order_value": "500.000000",
+			"maker_fee_rate": "0.000250",
+			"taker_fee_rate": "0.000400",
+			"avg_price": "49999.5",
+			"cumulative_quantity": "0.0050",
+			"cumulative_value": "249.997500",
+			"cumulative_fee": "0.062499",
+			"status": "ACTIVE",
+			"update_user_id": "fd797356-55db-48c2-a44d-157aabf702e8",
+			"order_date": "2022-07-26",
+			"instrument_name": "BTCUSD-PERP",
+			"fee_instrument_name": "USD",
+			"ref_price": "49000",
+			"ref_price_type": "MARK_PRICE",
+			"list_id": 6498090546073120100,
+			"contingency_type": "OCO",
+			"create_time": 1658793053250,
+			"create_time_ns": "1658793053250123456",
+			"update_time": 1658793053260
+		}`), &order))
+
+		assert.Equal(t, cdcexchange.Order{
+			AccountID:          "52e7c00f-1324-5a6z-bfgt-de445bde21a5",
+			OrderID:            "5340260040029378562",
+			ClientOID:          "1658793053245",
+			OrderType:          cdcexchange.OrderTypeStopLimit,
+			TimeInForce:        cdcexchange.TimeInForceGoodTilCancelled,
+			Side:               cdcexchange.OrderSideBuy,
+			Quantity:           cdcexchange.MustParseDecimal("0.01"),
+			Price:              cdcexchange.MustParseDecimal("50000"),
+			OrderValue:         cdcexchange.MustParseDecimal("500"),
+			MakerFeeRate:       cdcexchange.MustParseDecimal("0.00025"),
+			TakerFeeRate:       cdcexchange.MustParseDecimal("0.0004"),
+			AvgPrice:           cdcexchange.MustParseDecimal("49999.5"),
+			CumulativeQuantity: cdcexchange.MustParseDecimal("0.005"),
+			CumulativeValue:    cdcexchange.MustParseDecimal("249.9975"),
+			CumulativeFee:      cdcexchange.MustParseDecimal("0.062499"),
+			Status:             cdcexchange.OrderStatusActive,
+			UpdateUserID:       "fd797356-55db-48c2-a44d-157aabf702e8",
+			OrderDate:          "2022-07-26",
+			InstrumentName:     "BTCUSD-PERP",
+			FeeCurrency:        "USD",
+			RefPrice:           cdcexchange.MustParseDecimal("49000"),
+			RefPriceType:       "MARK_PRICE",
+			OrderListID:        "6498090546073120100",
+			ContingencyType:    "OCO",
+			CreateTime:         cdcexchange.Timestamp(time.Unix(0, 1658793053250123456)),
+			UpdateTime:         cdcexchange.Timestamp(time.UnixMilli(1658793053260)),
+		}, order)
+	})
+
+	t.Run("decodes a legacy order", func(t *testing.T) {
+		var order cdcexchange.Order
+		require.NoError(t, json.Unmarshal([]byte(`{
+			"status": "FILLED",
+			"side": "SELL",
+			"price": 1.5,
+			"quantity": 10,
+			"order_id": "1",
+			"type": "LIMIT",
+			"instrument_name": "CRO_USDT",
+			"fee_currency": "CRO",
+			"create_time": 1610905445000,
+			"update_time": 1610905446000
+		}`), &order))
+
+		assert.Equal(t, cdcexchange.Order{
+			Status:         cdcexchange.OrderStatusFilled,
+			Side:           cdcexchange.OrderSideSell,
+			Price:          cdcexchange.MustParseDecimal("1.5"),
+			Quantity:       cdcexchange.MustParseDecimal("10"),
+			OrderID:        "1",
+			OrderType:      cdcexchange.OrderTypeLimit,
+			InstrumentName: "CRO_USDT",
+			FeeCurrency:    "CRO",
+			CreateTime:     cdcexchange.Timestamp(time.UnixMilli(1610905445000)),
+			UpdateTime:     cdcexchange.Timestamp(time.UnixMilli(1610905446000)),
+		}, order)
+	})
+
+	t.Run("decodes reason from a number or a string", func(t *testing.T) {
+		for raw, expected := range map[string]int64{`43012`: 43012, `"43012"`: 43012, `""`: 0, `null`: 0} {
+			var order cdcexchange.Order
+			require.NoError(t, json.Unmarshal([]byte(fmt.Sprintf(`{"reason": %s}`, raw)), &order), raw)
+			assert.Equal(t, expected, order.Reason, raw)
+		}
+
+		var order cdcexchange.Order
+		assert.Error(t, json.Unmarshal([]byte(`{"reason": "unknown"}`), &order))
+	})
+}
@@ -0,0 +1,151 @@
+package cdcexchange
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/sngyai/go-cryptocom/v2/errors"
+	"github.com/sngyai/go-cryptocom/v2/internal/api"
+	"github.com/sngyai/go-cryptocom/v2/internal/auth"
+)
+
+const (
+	methodGetOpenStake = "private/staking/get-open-stake"
+
+	StakingSideStake   StakingSide = "STAKE"
+	StakingSideUnstake StakingSide = "UNSTAKE"
+)
+
+type (
+	// StakingSide is the side of a staking request (STAKE/UNSTAKE).
+	StakingSide string
+
+	// GetOpenStakeRequest is the request params sent for the private/staking/get-open-stake API.
+	GetOpenStakeRequest struct {
+		// InstrumentName is the staking instrument name (e.g. SOL.staked).
+		// if InstrumentName is omitted, all instruments will be returned.
+		InstrumentName string `json:"instrument_name"`
+		// Start is the start timestamp (milliseconds since the Unix epoch)
+		// (Default: 30 days ago)
+		Start time.Time `json:"start_time"`
+		// End is the end timestamp (milliseconds since the Unix epoch)
+		// (Default: now)
+		End time.Time `json:"end_time"`
+		// Limit represents maximum number of requests returned (for pagination)
+		// (Default: 20, Max: 500)
+		// if Limit is not set, it will be set as 20 by default.
+		Limit Optional[int] `json:"limit"`
+	}
+
+	// GetOpenStakeResponse is the base response returned from the private/staking/get-open-stake API.
+	GetOpenStakeResponse struct {
+		// BaseResponse is the common response fields.
+		BaseResponse
+		// Result is the response attributes of the endpoint.
+		Result GetOpenStakeResult `json:"result"`
+	}
+
+	// GetOpenStakeResult is the result returned from the private/staking/get-open-stake API.
+	GetOpenStakeResult struct {
+		// Data is the list of open stake/unstake requests.
+		Data []StakingRequest `json:"data"`
+	}
+
+	// StakingRequest represents the details of a stake or unstake request.
+	StakingRequest struct {
+		// InstrumentName is the staking instrument name (e.g. SOL.staked).
+		InstrumentName string `json:"instrument_name"`
+		// UnderlyingInstName is the underlying instrument name (e.g. SOL).
+		UnderlyingInstName string `json:"underlying_inst_name"`
+		// CycleID is the staking cycle the request is processed in.
+		CycleID string `json:"cycle_id"`
+		// StakingID is the unique identifier of the request.
+		StakingID string `json:"staking_id"`
+		// Status is the status of the request.
+		Status StakingStatus `json:"status"`
+		// Account is the account UUID the request was made from.
+		Account string `json:"account"`
+		// Quantity is the quantity requested to be staked or unstaked.
+		Quantity Decimal `json:"quantity"`
+		// Side represents whether the request is a stake or an unstake.
+		Side StakingSide `json:"side"`
+		// CreateTime is the request creation time.
+		CreateTime Timestamp `json:"create_timestamp_ms"`
+	}
+)
+
+// GetOpenStake gets the stake and unstake requests which are still being processed.
+//
+// Pagination is handled using Limit (Default: 20, Max: 500) and moving the End timestamp
+// back to the CreateTime of the oldest returned request.
+//
+// req.InstrumentName can be left blank to get open requests for all instruments.
+//
+// Method: private/staking/get-open-stake
+func (c *Client) GetOpenStake(ctx context.Context, req GetOpenStakeRequest) ([]StakingRequest, error) {
+	limit, hasLimit := req.Limit.Get()
+	if limit < 0 {
+		return nil, errors.InvalidParameterError{Parameter: "req.Limit", Reason: "cannot be less than 0"}
+	}
+	if limit > 500 {
+		return nil, errors.InvalidParameterError{Parameter: "req.Limit", Reason: "cannot be greater than 500"}
+	}
+
+	var (
+		id        = c.idGenerator.Generate()
+		timestamp = c.now().UnixMilli()
+		params    = make(map[string]interface{})
+	)
+
+	creds, err := c.credentials(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.InstrumentName != "" {
+		params["instrument_name"] = req.InstrumentName
+	}
+	if !req.Start.IsZero() {
+		params["start_time"] = req.Start.UnixMilli()
+	}
+	if !req.End.IsZero() {
+		params["end_time"] = req.End.UnixMilli()
+	}
+	if hasLimit {
+		params["limit"] = limit
+	}
+
+	signature, err := c.signatureGenerator.GenerateSignature(auth.SignatureRequest{
+		APIKey:    creds.APIKey,
+		SecretKey: creds.SecretKey,
+		ID:        id,
+		Method:    methodGetOpenStake,
+		Timestamp: timestamp,
+		Params:    params,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create signature: %w", err)
+	}
+
+	body := api.Request{
+		ID:        id,
+		Method:    methodGetOpenStake,
+		Nonce:     timestamp,
+		Params:    params,
+		Signature: signature,
+		APIKey:    creds.APIKey,
+	}
+
+	var getOpenStakeResponse GetOpenStakeResponse
+	statusCode, err := c.requester.Post(ctx, body, methodGetOpenStake, &getOpenStakeResponse)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute post request: %w", err)
+	}
+
+	if err := c.requester.CheckResponse(statusCode, api.BaseResponse(getOpenStakeResponse.BaseResponse)); err != nil {
+		return nil, fmt.Errorf("error received in response: %w", err)
+	}
+
+	return getOpenStakeResponse.Result.Data, nil
+}
@@ -2,12 +2,12 @@ package cdcexchange
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 
-	"github.com/sngyai/go-cryptocom/errors"
-	"github.com/sngyai/go-cryptocom/internal/api"
-	"github.com/sngyai/go-cryptocom/internal/auth"
-	"github.com/sngyai/go-cryptocom/internal/time"
+	"github.com/sngyai/go-cryptocom/v2/errors"
+	"github.com/sngyai/go-cryptocom/v2/internal/api"
+	"github.com/sngyai/go-cryptocom/v2/internal/auth"
 )
 
 const (
@@ -23,8 +23,8 @@ type (
 
 	// GetOrderDetailResponse is the base response returned from the private/get-order-detail API.
 	GetOrderDetailResponse struct {
-		// api.BaseResponse is the common response fields.
-		api.BaseResponse
+		// BaseResponse is the common response fields.
+		BaseResponse
 		// Result is the response attributes of the endpoint.
 		Result GetOrderDetailResult `json:"result"`
 	}
@@ -35,6 +35,8 @@ type (
 		TradeList []Trade `json:"trade_list"`
 		// OrderInfo is the detailed information about the order.
 		OrderInfo Order `json:"order_info"`
+		// Raw is the raw JSON of the result, for fields not decoded by this package.
+		Raw json.RawMessage `json:"-"`
 	}
 
 	// Trade represents the details of a specific trade.
@@ -43,16 +45,16 @@ type (
 		Side OrderSide `json:"side"`
 		// InstrumentName represents the currency pair to trade (e.g. ETH_CRO or BTC_USDT).
 		InstrumentName string `json:"instrument_name"`
-		// Fee is the trade fee.
-		Fee float64 `json:"fee"`
+		// Fee is the trade fee charged, in FeeCurrency.
+		Fee Decimal `json:"fee"`
 		// TradeID is the unique identifier for the trade.
 		TradeID string `json:"trade_id"`
 		// CreateTime is the trade creation time.
-		CreateTime time.Time `json:"create_time"`
+		CreateTime Timestamp `json:"create_time"`
 		// TradedPrice is the executed trade price
-		TradedPrice float64 `json:"traded_price"`
+		TradedPrice Decimal `json:"traded_price"`
 		// TradedQuantity is the executed trade quantity
-		TradedQuantity float64 `json:"traded_quantity"`
+		TradedQuantity Decimal `json:"traded_quantity"`
 		// FeeCurrency is the currency used for the fees (e.g. CRO).
 		FeeCurrency string `json:"fee_currency"`
 		// OrderID is the unique identifier for the order.
@@ -61,9 +63,66 @@ type (
 		ClientOrderID string `json:"client_order_id"`
 		// LiquidityIndicator is the liquidity indicator for the trade (MAKER/TAKER).
 		LiquidityIndicator LiquidityIndicator `json:"liquidity_indicator"`
+		// MatchID is the unique identifier of the match the trade was part of (shared by the maker and taker trades).
+		MatchID string `json:"match_id"`
+		// AccountID is the account the trade was executed for.
+		AccountID string `json:"account_id"`
+		// EventDate is the date the trade was executed (e.g. 2022-07-26).
+		EventDate string `json:"event_date"`
 	}
 )
 
+// UnmarshalJSON decodes a trade returned by either API version.
+//
+// The v1 names of renamed fields (fee_instrument_name, taker_side, client_oid and trade_match_id) are decoded into
+// FeeCurrency, LiquidityIndicator, ClientOrderID and MatchID, and the nanosecond create_time_ns takes precedence over create_time.
+// v1 returns the fee as the (negative) change to the balance in fees, which is negated so that Fee is always
+// the fee charged (negative for rebates).
+func (t *Trade) UnmarshalJSON(data []byte) error {
+	type trade Trade
+	v := struct {
+		*trade
+		Fees              *Decimal           `json:"fees"`
+		FeeInstrumentName string             `json:"fee_instrument_name"`
+		TakerSide         LiquidityIndicator `json:"taker_side"`
+		ClientOID         string             `json:"client_oid"`
+		CreateTimeNs      *Timestamp         `json:"create_time_ns"`
+		MatchID           json.RawMessage    `json:"match_id"`
+		TradeMatchID      string             `json:"trade_match_id"`
+	}{trade: (*trade)(t)}
+
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+
+	if v.Fees != nil {
+		t.Fee = v.Fees.Neg()
+	}
+	if v.FeeInstrumentName != "" {
+		t.FeeCurrency = v.FeeInstrumentName
+	}
+	if v.TakerSide != "" {
+		t.LiquidityIndicator = v.TakerSide
+	}
+	if v.ClientOID != "" {
+		t.ClientOrderID = v.ClientOID
+	}
+	if v.CreateTimeNs != nil {
+		t.CreateTime = *v.CreateTimeNs
+	}
+
+	matchID, err := unmarshalID("match_id", v.MatchID)
+	if err != nil {
+		return err
+	}
+	if matchID == "" {
+		matchID = v.TradeMatchID
+	}
+	t.MatchID = matchID
+
+	return nil
+}
+
 // GetOrderDetail gets details of an order for a particular order ID.
 //
 // Method: private/get-order-detail
@@ -72,17 +131,36 @@ func (c *Client) GetOrderDetail(ctx context.Context, orderID string) (*GetOrderD
 		return nil, errors.InvalidParameterError{Parameter: "orderID", Reason: "cannot be empty"}
 	}
 
+	return c.getOrderDetail(ctx, map[string]interface{}{"order_id": orderID})
+}
+
+// GetOrderDetailByClientOID gets details of an order for a particular Client order ID.
+//
+// This allows an order to be tracked even if the response to CreateOrder was lost.
+//
+// Method: private/get-order-detail
+func (c *Client) GetOrderDetailByClientOID(ctx context.Context, clientOID string) (*GetOrderDetailResult, error) {
+	if clientOID == "" {
+		return nil, errors.InvalidParameterError{Parameter: "clientOID", Reason: "cannot be empty"}
+	}
+
+	return c.getOrderDetail(ctx, map[string]interface{}{"client_oid": clientOID})
+}
+
+func (c *Client) getOrderDetail(ctx context.Context, params map[string]interface{}) (*GetOrderDetailResult, error) {
 	var (
 		id        = c.idGenerator.Generate()
-		timestamp = c.clock.Now().UnixMilli()
-		params    = make(map[string]interface{})
+		timestamp = c.now().UnixMilli()
 	)
 
-	params["order_id"] = orderID
+	creds, err := c.credentials(ctx)
+	if err != nil {
+		return nil, err
+	}
 
 	signature, err := c.signatureGenerator.GenerateSignature(auth.SignatureRequest{
-		APIKey:    c.apiKey,
-		SecretKey: c.secretKey,
+		APIKey:    creds.APIKey,
+		SecretKey: creds.SecretKey,
 		ID:        id,
 		Method:    methodGetOrderDetail,
 		Timestamp: timestamp,
@@ -98,7 +176,7 @@ func (c *Client) GetOrderDetail(ctx context.Context, orderID string) (*GetOrderD
 		Nonce:     timestamp,
 		Params:    params,
 		Signature: signature,
-		APIKey:    c.apiKey,
+		APIKey:    creds.APIKey,
 	}
 
 	var getOrderDetailResponse GetOrderDetailResponse
@@ -107,9 +185,11 @@ func (c *Client) GetOrderDetail(ctx context.Context, orderID string) (*GetOrderD
 		return nil, fmt.Errorf("failed to execute post request: %w", err)
 	}
 
-	if err := c.requester.CheckErrorResponse(statusCode, getOrderDetailResponse.Code); err != nil {
+	if err := c.requester.CheckResponse(statusCode, api.BaseResponse(getOrderDetailResponse.BaseResponse)); err != nil {
 		return nil, fmt.Errorf("error received in response: %w", err)
 	}
 
+	getOrderDetailResponse.Result.Raw = getOrderDetailResponse.RawResult
+
 	return &getOrderDetailResponse.Result, nil
 }
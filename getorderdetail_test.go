@@ -15,13 +15,12 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
-	"github.com/sngyai/go-cryptocom/internal/api"
-	"github.com/sngyai/go-cryptocom/internal/auth"
-	cdcexchange "github.com/sngyai/go-cryptocom"
-	cdcerrors "github.com/sngyai/go-cryptocom/errors"
-	id_mocks "github.com/sngyai/go-cryptocom/internal/mocks/id"
-	signature_mocks "github.com/sngyai/go-cryptocom/internal/mocks/signature"
-	cdctime "github.com/sngyai/go-cryptocom/internal/time"
+	"github.com/sngyai/go-cryptocom/v2/internal/api"
+	"github.com/sngyai/go-cryptocom/v2/internal/auth"
+	cdcexchange "github.com/sngyai/go-cryptocom/v2"
+	cdcerrors "github.com/sngyai/go-cryptocom/v2/errors"
+	id_mocks "github.com/sngyai/go-cryptocom/v2/internal/mocks/id"
+	signature_mocks "github.com/sngyai/go-cryptocom/v2/internal/mocks/signature"
 )
 
 func TestClient_GetOrderDetail_Error(t *testing.T) {
@@ -238,11 +237,11 @@ func TestClient_GetOrderDetail_Success(t *testing.T) {
 					{
 						Side:           cdcexchange.OrderSideBuy,
 						InstrumentName: "ETH_CRO",
-						Fee:            0.007,
+						Fee:            cdcexchange.MustParseDecimal("0.007"),
 						TradeID:        "371303044218155296",
-						CreateTime:     cdctime.Time(now),
-						TradedPrice:    7,
-						TradedQuantity: 7,
+						CreateTime:     cdcexchange.Timestamp(now),
+						TradedPrice:    cdcexchange.MustParseDecimal("7"),
+						TradedQuantity: cdcexchange.MustParseDecimal("7"),
 						FeeCurrency:    "CRO",
 						OrderID:        orderID,
 					},
@@ -252,13 +251,13 @@ func TestClient_GetOrderDetail_Success(t *testing.T) {
 					Side:               cdcexchange.OrderSideBuy,
 					OrderID:            orderID,
 					ClientOID:          clientOID,
-					CreateTime:         cdctime.Time(now),
-					UpdateTime:         cdctime.Time(now),
+					CreateTime:         cdcexchange.Timestamp(now),
+					UpdateTime:         cdcexchange.Timestamp(now),
 					OrderType:          cdcexchange.OrderTypeLimit,
 					InstrumentName:     "ETH_CRO",
-					CumulativeQuantity: 7,
-					CumulativeValue:    7,
-					AvgPrice:           7,
+					CumulativeQuantity: cdcexchange.MustParseDecimal("7"),
+					CumulativeValue:    cdcexchange.MustParseDecimal("7"),
+					AvgPrice:           cdcexchange.MustParseDecimal("7"),
 					FeeCurrency:        "CRO",
 					TimeInForce:        cdcexchange.TimeInForceGoodTilCancelled,
 					ExecInst:           cdcexchange.ExecInstPostOnly,
@@ -302,7 +301,94 @@ func TestClient_GetOrderDetail_Success(t *testing.T) {
 			res, err := client.GetOrderDetail(ctx, tt.orderID)
 			require.NoError(t, err)
 
+			require.NotEmpty(t, res.Raw)
+			res.Raw = nil
+
 			assert.Equal(t, tt.expectedResult, *res)
 		})
 	}
 }
+
+func TestClient_GetOrderDetailByClientOID_Success(t *testing.T) {
+	const (
+		apiKey    = "some api key"
+		secretKey = "some secret key"
+		id        = int64(1234)
+		signature = "some signature"
+		orderID   = "some order id"
+		clientOID = "some Client oid"
+	)
+	now := time.Now()
+
+	ctrl, ctx := gomock.WithContext(context.Background(), t)
+	t.Cleanup(ctrl.Finish)
+
+	var (
+		signatureGenerator = signature_mocks.NewMockSignatureGenerator(ctrl)
+		idGenerator        = id_mocks.NewMockIDGenerator(ctrl)
+		clock              = clockwork.NewFakeClockAt(now)
+	)
+
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Contains(t, r.URL.Path, cdcexchange.MethodGetOrderDetail)
+		t.Cleanup(func() { require.NoError(t, r.Body.Close()) })
+
+		var body api.Request
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+
+		assert.Equal(t, clientOID, body.Params["client_oid"])
+		assert.NotContains(t, body.Params, "order_id")
+
+		_, err := fmt.Fprintf(w, `{
+			"id": 1234,
+			"method": "private/get-order-detail",
+			"code": 0,
+			"result": {
+				"trade_list": [],
+				"order_info": {
+					"status": "ACTIVE",
+					"side": "SELL",
+					"order_id": "%s",
+					"client_oid": "%s"
+				}
+			}
+		}`, orderID, clientOID)
+		require.NoError(t, err)
+	}))
+	t.Cleanup(s.Close)
+
+	client, err := cdcexchange.New(apiKey, secretKey,
+		cdcexchange.WithIDGenerator(idGenerator),
+		cdcexchange.WithClock(clock),
+		cdcexchange.WithHTTPClient(s.Client()),
+		cdcexchange.WithBaseURL(fmt.Sprintf("%s/", s.URL)),
+		cdcexchange.WithSignatureGenerator(signatureGenerator),
+	)
+	require.NoError(t, err)
+
+	idGenerator.EXPECT().Generate().Return(id)
+	signatureGenerator.EXPECT().GenerateSignature(auth.SignatureRequest{
+		APIKey:    apiKey,
+		SecretKey: secretKey,
+		ID:        id,
+		Method:    cdcexchange.MethodGetOrderDetail,
+		Timestamp: now.UnixMilli(),
+		Params:    map[string]interface{}{"client_oid": clientOID},
+	}).Return(signature, nil)
+
+	res, err := client.GetOrderDetailByClientOID(ctx, clientOID)
+	require.NoError(t, err)
+
+	require.NotEmpty(t, res.Raw)
+	res.Raw = nil
+
+	assert.Equal(t, &cdcexchange.GetOrderDetailResult{
+		TradeList: []cdcexchange.Trade{},
+		OrderInfo: cdcexchange.Order{
+			Status:    cdcexchange.OrderStatusActive,
+			Side:      cdcexchange.OrderSideSell,
+			OrderID:   orderID,
+			ClientOID: clientOID,
+		},
+	}, res)
+}
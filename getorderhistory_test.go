@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 	"time"
 
@@ -15,13 +16,12 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
-	cdcexchange "github.com/sngyai/go-cryptocom"
-	cdcerrors "github.com/sngyai/go-cryptocom/errors"
-	"github.com/sngyai/go-cryptocom/internal/api"
-	"github.com/sngyai/go-cryptocom/internal/auth"
-	id_mocks "github.com/sngyai/go-cryptocom/internal/mocks/id"
-	signature_mocks "github.com/sngyai/go-cryptocom/internal/mocks/signature"
-	cdctime "github.com/sngyai/go-cryptocom/internal/time"
+	cdcexchange "github.com/sngyai/go-cryptocom/v2"
+	cdcerrors "github.com/sngyai/go-cryptocom/v2/errors"
+	"github.com/sngyai/go-cryptocom/v2/internal/api"
+	"github.com/sngyai/go-cryptocom/v2/internal/auth"
+	id_mocks "github.com/sngyai/go-cryptocom/v2/internal/mocks/id"
+	signature_mocks "github.com/sngyai/go-cryptocom/v2/internal/mocks/signature"
 )
 
 func TestClient_GetOrderHistory_Error(t *testing.T) {
@@ -47,11 +47,11 @@ func TestClient_GetOrderHistory_Error(t *testing.T) {
 			name: "returns error when page size is less than 0",
 			args: args{
 				req: cdcexchange.GetOrderHistoryRequest{
-					PageSize: -1,
+					PageRequest: cdcexchange.PageRequest{PageSize: cdcexchange.Some(-1)},
 				},
 			},
 			expectedErr: cdcerrors.InvalidParameterError{
-				Parameter: "req.Limit",
+				Parameter: "req.PageSize",
 				Reason:    "cannot be less than 0",
 			},
 		},
@@ -59,11 +59,11 @@ func TestClient_GetOrderHistory_Error(t *testing.T) {
 			name: "returns error when page size is greater than 200",
 			args: args{
 				req: cdcexchange.GetOrderHistoryRequest{
-					PageSize: 201,
+					PageRequest: cdcexchange.PageRequest{PageSize: cdcexchange.Some(201)},
 				},
 			},
 			expectedErr: cdcerrors.InvalidParameterError{
-				Parameter: "req.Limit",
+				Parameter: "req.PageSize",
 				Reason:    "cannot be greater than 200",
 			},
 		},
@@ -119,7 +119,7 @@ func TestClient_GetOrderHistory_Error(t *testing.T) {
 			)
 			require.NoError(t, err)
 
-			if tt.req.PageSize >= 0 && tt.req.PageSize < 200 {
+			if pageSize, _ := tt.req.PageSize.Get(); pageSize >= 0 && pageSize < 200 {
 				idGenerator.EXPECT().Generate().Return(id)
 				signatureGenerator.EXPECT().GenerateSignature(auth.SignatureRequest{
 					APIKey:    apiKey,
@@ -127,7 +127,7 @@ func TestClient_GetOrderHistory_Error(t *testing.T) {
 					ID:        id,
 					Method:    cdcexchange.MethodGetOrderHistory,
 					Timestamp: now.UnixMilli(),
-					Params:    map[string]interface{}{"page": 0},
+					Params:    map[string]interface{}{},
 				}).Return("signature", tt.signatureErr)
 			}
 
@@ -180,8 +180,7 @@ func TestClient_GetOrderHistory_Success(t *testing.T) {
 			args: args{
 				req: cdcexchange.GetOrderHistoryRequest{
 					InstrumentName: instrument,
-					PageSize:       100,
-					Page:           1,
+					PageRequest:    cdcexchange.PageRequest{PageSize: cdcexchange.Some(100), Page: cdcexchange.Some(1)},
 				},
 			},
 			handlerFunc: func(w http.ResponseWriter, r *http.Request) {
@@ -205,7 +204,7 @@ func TestClient_GetOrderHistory_Success(t *testing.T) {
 							"method":"",
 							"code":0,
 							"result":{
-								"order_id":1234,"order_list":[
+								"count":1234,"order_list":[
 									{
 										"status":"",
 										"reason":"",
@@ -232,8 +231,8 @@ func TestClient_GetOrderHistory_Success(t *testing.T) {
 			expectedResult: []cdcexchange.Order{
 				{
 					ClientOID:  clientOID,
-					CreateTime: cdctime.Time(now),
-					UpdateTime: cdctime.Time(now),
+					CreateTime: cdcexchange.Timestamp(now),
+					UpdateTime: cdcexchange.Timestamp(now),
 				},
 			},
 		},
@@ -254,7 +253,7 @@ func TestClient_GetOrderHistory_Success(t *testing.T) {
 				assert.Equal(t, apiKey, body.APIKey)
 				assert.Equal(t, now.UnixMilli(), body.Nonce)
 				assert.Equal(t, signature, body.Signature)
-				assert.Equal(t, float64(0), body.Params["page"])
+				assert.NotContains(t, body.Params, "page")
 
 				res := fmt.Sprintf(`{
 							"id": 0,
@@ -280,14 +279,12 @@ func TestClient_GetOrderHistory_Success(t *testing.T) {
 				_, err := w.Write([]byte(res))
 				require.NoError(t, err)
 			},
-			expectedParams: map[string]interface{}{
-				"page": 0,
-			},
+			expectedParams: map[string]interface{}{},
 			expectedResult: []cdcexchange.Order{
 				{
 					ClientOID:  clientOID,
-					CreateTime: cdctime.Time(now),
-					UpdateTime: cdctime.Time(now),
+					CreateTime: cdcexchange.Timestamp(now),
+					UpdateTime: cdcexchange.Timestamp(now),
 				},
 			},
 		},
@@ -295,10 +292,9 @@ func TestClient_GetOrderHistory_Success(t *testing.T) {
 			name: "successfully gets all orders between timestamps",
 			args: args{
 				req: cdcexchange.GetOrderHistoryRequest{
-					Start:    now,
-					End:      now.Add(time.Hour),
-					PageSize: 1,
-					Page:     2,
+					Start:       now,
+					End:         now.Add(time.Hour),
+					PageRequest: cdcexchange.PageRequest{PageSize: cdcexchange.Some(1), Page: cdcexchange.Some(2)},
 				},
 			},
 			handlerFunc: func(w http.ResponseWriter, r *http.Request) {
@@ -351,8 +347,8 @@ func TestClient_GetOrderHistory_Success(t *testing.T) {
 			expectedResult: []cdcexchange.Order{
 				{
 					ClientOID:  clientOID,
-					CreateTime: cdctime.Time(now),
-					UpdateTime: cdctime.Time(now),
+					CreateTime: cdcexchange.Timestamp(now),
+					UpdateTime: cdcexchange.Timestamp(now),
 				},
 			},
 		},
@@ -397,3 +393,209 @@ func TestClient_GetOrderHistory_Success(t *testing.T) {
 		})
 	}
 }
+
+func TestClient_GetOrderHistory_SplitsLongRange(t *testing.T) {
+	const (
+		apiKey    = "some api key"
+		secretKey = "some secret key"
+	)
+	var (
+		end   = time.Now().Round(time.Second)
+		start = end.Add(-30 * time.Hour)
+
+		windows [][2]int64
+	)
+
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Contains(t, r.URL.Path, cdcexchange.MethodGetOrderHistory)
+		t.Cleanup(func() { require.NoError(t, r.Body.Close()) })
+
+		var body api.Request
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+
+		windowStart, windowEnd := int64(body.Params["start_ts"].(float64)), int64(body.Params["end_ts"].(float64))
+		windows = append(windows, [2]int64{windowStart, windowEnd})
+
+		res := fmt.Sprintf(`{
+					"id": 0,
+					"method":"",
+					"code":0,
+					"result":{
+						"order_list":[{"order_id": "%d"}]
+					}
+				}`, len(windows))
+
+		_, err := w.Write([]byte(res))
+		require.NoError(t, err)
+	}))
+	t.Cleanup(s.Close)
+
+	client, err := cdcexchange.New(apiKey, secretKey,
+		cdcexchange.WithHTTPClient(s.Client()),
+		cdcexchange.WithBaseURL(fmt.Sprintf("%s/", s.URL)),
+		cdcexchange.WithoutRateLimiter(),
+	)
+	require.NoError(t, err)
+
+	res, err := client.GetOrderHistory(context.Background(), cdcexchange.GetOrderHistoryRequest{
+		Start: start,
+		End:   end,
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, []cdcexchange.Order{{OrderID: "1"}, {OrderID: "2"}}, res)
+	assert.Equal(t, [][2]int64{
+		{end.Add(-24 * time.Hour).UnixMilli(), end.UnixMilli()},
+		{start.UnixMilli(), end.Add(-24*time.Hour).UnixMilli() - 1},
+	}, windows)
+}
+
+func TestClient_GetOrderHistory_SplitsLongRangePages(t *testing.T) {
+	const (
+		apiKey    = "some api key"
+		secretKey = "some secret key"
+	)
+	var (
+		end   = time.Now().Round(time.Second)
+		start = end.Add(-30 * time.Hour)
+
+		requests [][2]int64
+	)
+
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Cleanup(func() { require.NoError(t, r.Body.Close()) })
+
+		var body api.Request
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+
+		assert.Equal(t, float64(1), body.Params["page_size"])
+
+		var (
+			windowEnd = int64(body.Params["end_ts"].(float64))
+			page      = int64(body.Params["page"].(float64))
+		)
+		requests = append(requests, [2]int64{windowEnd, page})
+
+		// the newest window has 2 pages of orders, the oldest 1
+		orders := "[]"
+		if windowEnd == end.UnixMilli() && page < 2 || windowEnd != end.UnixMilli() && page < 1 {
+			orders = fmt.Sprintf(`[{"order_id": "%d"}]`, len(requests))
+		}
+
+		_, err := w.Write([]byte(fmt.Sprintf(`{"id": 0, "code": 0, "result": {"order_list": %s}}`, orders)))
+		require.NoError(t, err)
+	}))
+	t.Cleanup(s.Close)
+
+	client, err := cdcexchange.New(apiKey, secretKey,
+		cdcexchange.WithHTTPClient(s.Client()),
+		cdcexchange.WithBaseURL(fmt.Sprintf("%s/", s.URL)),
+		cdcexchange.WithoutRateLimiter(),
+	)
+	require.NoError(t, err)
+
+	res, err := client.GetOrderHistory(context.Background(), cdcexchange.GetOrderHistoryRequest{
+		Start:       start,
+		End:         end,
+		PageRequest: cdcexchange.PageRequest{PageSize: cdcexchange.Some(1), Page: cdcexchange.Some(5)},
+	})
+	require.NoError(t, err)
+
+	// every page of each window is fetched, and the page of the request is ignored
+	assert.Equal(t, []cdcexchange.Order{{OrderID: "1"}, {OrderID: "2"}, {OrderID: "4"}}, res)
+	assert.Equal(t, [][2]int64{
+		{end.UnixMilli(), 0},
+		{end.UnixMilli(), 1},
+		{end.UnixMilli(), 2},
+		{end.Add(-24*time.Hour).UnixMilli() - 1, 0},
+		{end.Add(-24*time.Hour).UnixMilli() - 1, 1},
+	}, requests)
+}
+
+func TestClient_OrderHistoryIterator(t *testing.T) {
+	var (
+		end   = time.Now().Round(time.Second)
+		start = end.Add(-30 * time.Hour)
+
+		// orders are the order ids of each window, newest window first.
+		orders = [][]string{{"1", "2", "3"}, {}}
+		failed bool
+
+		requests [][3]int64
+	)
+
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body api.Request
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+
+		var (
+			windowEnd = int64(body.Params["end_ts"].(float64))
+			page      = int(body.Params["page"].(float64))
+			pageSize  = int(body.Params["page_size"].(float64))
+			window    = 0
+		)
+		if windowEnd != end.UnixMilli() {
+			window = 1
+		}
+
+		// the second page fails once.
+		if page == 1 && !failed {
+			failed = true
+			w.WriteHeader(http.StatusInternalServerError)
+			_, err := w.Write([]byte(`{"id": 0, "code": 10001}`))
+			require.NoError(t, err)
+			return
+		}
+		requests = append(requests, [3]int64{int64(window), int64(page), int64(pageSize)})
+
+		var list []string
+		for i := page * pageSize; i < len(orders[window]) && i < (page+1)*pageSize; i++ {
+			list = append(list, fmt.Sprintf(`{"order_id": %q}`, orders[window][i]))
+		}
+
+		_, err := fmt.Fprintf(w, `{"id": 0, "code": 0, "result": {"order_list": [%s]}}`, strings.Join(list, ","))
+		require.NoError(t, err)
+	}))
+	t.Cleanup(s.Close)
+
+	client, err := cdcexchange.New("some api key", "some secret key",
+		cdcexchange.WithHTTPClient(s.Client()),
+		cdcexchange.WithBaseURL(fmt.Sprintf("%s/", s.URL)),
+		cdcexchange.WithoutRateLimiter(),
+	)
+	require.NoError(t, err)
+
+	it, err := client.OrderHistoryIterator(cdcexchange.GetOrderHistoryRequest{
+		Start:       start,
+		End:         end,
+		PageRequest: cdcexchange.PageRequest{PageSize: cdcexchange.Some(2)},
+	})
+	require.NoError(t, err)
+
+	var got []string
+	for it.Next(context.Background()) {
+		got = append(got, it.Value().OrderID)
+	}
+	require.Error(t, it.Err())
+	assert.Equal(t, []string{"1", "2"}, got)
+
+	// iteration resumes from the failed page.
+	for it.Next(context.Background()) {
+		got = append(got, it.Value().OrderID)
+	}
+	require.NoError(t, it.Err())
+
+	assert.Equal(t, []string{"1", "2", "3"}, got)
+	assert.Equal(t, [][3]int64{{0, 0, 2}, {0, 1, 2}, {1, 0, 2}}, requests)
+}
+
+func TestClient_OrderHistoryIterator_Error(t *testing.T) {
+	client, err := cdcexchange.New("some api key", "some secret key")
+	require.NoError(t, err)
+
+	it, err := client.OrderHistoryIterator(cdcexchange.GetOrderHistoryRequest{PageRequest: cdcexchange.PageRequest{PageSize: cdcexchange.Some(201)}})
+	require.Error(t, err)
+
+	assert.Nil(t, it)
+	assert.Equal(t, cdcerrors.InvalidParameterError{Parameter: "req.PageSize", Reason: "cannot be greater than 200"}, err)
+}
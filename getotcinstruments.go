@@ -0,0 +1,104 @@
+package cdcexchange
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/sngyai/go-cryptocom/v2/internal/api"
+	"github.com/sngyai/go-cryptocom/v2/internal/auth"
+)
+
+const (
+	methodGetOTCInstruments = "private/otc/get-instruments"
+)
+
+type (
+	// GetOTCInstrumentsResponse is the base response returned from the private/otc/get-instruments API.
+	GetOTCInstrumentsResponse struct {
+		// BaseResponse is the common response fields.
+		BaseResponse
+		// Result is the response attributes of the endpoint.
+		Result GetOTCInstrumentsResult `json:"result"`
+	}
+
+	// GetOTCInstrumentsResult is the result returned from the private/otc/get-instruments API.
+	GetOTCInstrumentsResult struct {
+		// InstrumentList is the list of OTC-tradable instruments.
+		InstrumentList []OTCInstrument `json:"instrument_list"`
+	}
+
+	// OTCInstrument represents details of a currency pair tradable through OTC.
+	OTCInstrument struct {
+		// InstrumentName is the currency pair (e.g. BTC_USDT).
+		InstrumentName string `json:"instrument_name"`
+		// BaseCurrency is the base currency of the pair (e.g. BTC).
+		BaseCurrency string `json:"base_currency"`
+		// QuoteCurrency is the quote currency of the pair (e.g. USDT).
+		QuoteCurrency string `json:"quote_currency"`
+		// BaseCurrencyDecimals is the maximum number of decimal places for base currency quantities.
+		BaseCurrencyDecimals int `json:"base_currency_decimals"`
+		// QuoteCurrencyDecimals is the maximum number of decimal places for quote currency quantities.
+		QuoteCurrencyDecimals int `json:"quote_currency_decimals"`
+		// BaseCurrencyMinQuantity is the minimum base currency quantity of a quote request.
+		BaseCurrencyMinQuantity Decimal `json:"base_currency_min_quantity"`
+		// BaseCurrencyMaxQuantity is the maximum base currency quantity of a quote request.
+		BaseCurrencyMaxQuantity Decimal `json:"base_currency_max_quantity"`
+		// QuoteCurrencyMinQuantity is the minimum quote currency quantity of a quote request.
+		QuoteCurrencyMinQuantity Decimal `json:"quote_currency_min_quantity"`
+		// QuoteCurrencyMaxQuantity is the maximum quote currency quantity of a quote request.
+		QuoteCurrencyMaxQuantity Decimal `json:"quote_currency_max_quantity"`
+		// SettlementTime is the time taken to settle a trade (e.g. T+0).
+		SettlementTime string `json:"settlement_time"`
+		// Tradable is true when quotes can currently be requested for the instrument.
+		Tradable bool `json:"tradable"`
+	}
+)
+
+// GetOTCInstruments provides information on all instruments which can be traded through OTC.
+//
+// Method: private/otc/get-instruments
+func (c *Client) GetOTCInstruments(ctx context.Context) ([]OTCInstrument, error) {
+	var (
+		id        = c.idGenerator.Generate()
+		timestamp = c.now().UnixMilli()
+		params    = make(map[string]interface{})
+	)
+
+	creds, err := c.credentials(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	signature, err := c.signatureGenerator.GenerateSignature(auth.SignatureRequest{
+		APIKey:    creds.APIKey,
+		SecretKey: creds.SecretKey,
+		ID:        id,
+		Method:    methodGetOTCInstruments,
+		Timestamp: timestamp,
+		Params:    params,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create signature: %w", err)
+	}
+
+	body := api.Request{
+		ID:        id,
+		Method:    methodGetOTCInstruments,
+		Nonce:     timestamp,
+		Params:    params,
+		Signature: signature,
+		APIKey:    creds.APIKey,
+	}
+
+	var getOTCInstrumentsResponse GetOTCInstrumentsResponse
+	statusCode, err := c.requester.Post(ctx, body, methodGetOTCInstruments, &getOTCInstrumentsResponse)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute post request: %w", err)
+	}
+
+	if err := c.requester.CheckResponse(statusCode, api.BaseResponse(getOTCInstrumentsResponse.BaseResponse)); err != nil {
+		return nil, fmt.Errorf("error received in response: %w", err)
+	}
+
+	return getOTCInstrumentsResponse.Result.InstrumentList, nil
+}
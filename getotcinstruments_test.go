@@ -0,0 +1,132 @@
+package cdcexchange_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/jonboulle/clockwork"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	cdcexchange "github.com/sngyai/go-cryptocom/v2"
+	"github.com/sngyai/go-cryptocom/v2/internal/auth"
+	id_mocks "github.com/sngyai/go-cryptocom/v2/internal/mocks/id"
+	signature_mocks "github.com/sngyai/go-cryptocom/v2/internal/mocks/signature"
+)
+
+func TestClient_GetOTCInstruments_Success(t *testing.T) {
+	const (
+		apiKey    = "some api key"
+		secretKey = "some secret key"
+		id        = int64(1234)
+		signature = "some signature"
+	)
+	now := time.Now()
+
+	ctrl, ctx := gomock.WithContext(context.Background(), t)
+	t.Cleanup(ctrl.Finish)
+
+	var (
+		signatureGenerator = signature_mocks.NewMockSignatureGenerator(ctrl)
+		idGenerator        = id_mocks.NewMockIDGenerator(ctrl)
+		clock              = clockwork.NewFakeClockAt(now)
+	)
+
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Contains(t, r.URL.Path, cdcexchange.MethodGetOTCInstruments)
+
+		_, err := w.Write([]byte(`{
+			"id": 1234,
+			"method": "private/otc/get-instruments",
+			"code": 0,
+			"result": {
+				"instrument_list": [
+					{
+						"instrument_name": "BTC_USDT",
+						"base_currency": "BTC",
+						"quote_currency": "USDT",
+						"base_currency_decimals": 6,
+						"quote_currency_decimals": 2,
+						"base_currency_min_quantity": "0.5",
+						"base_currency_max_quantity": "100",
+						"quote_currency_min_quantity": "15000",
+						"quote_currency_max_quantity": "3000000",
+						"settlement_time": "T+0",
+						"tradable": true
+					},
+					{
+						"instrument_name": "ETH_USDT",
+						"base_currency": "ETH",
+						"quote_currency": "USDT",
+						"base_currency_decimals": 5,
+						"quote_currency_decimals": 2,
+						"base_currency_min_quantity": "5",
+						"base_currency_max_quantity": "1500",
+						"quote_currency_min_quantity": "15000",
+						"quote_currency_max_quantity": "3000000",
+						"settlement_time": "T+0",
+						"tradable": false
+					}
+				]
+			}
+		}`))
+		require.NoError(t, err)
+	}))
+	t.Cleanup(s.Close)
+
+	client, err := cdcexchange.New(apiKey, secretKey,
+		cdcexchange.WithIDGenerator(idGenerator),
+		cdcexchange.WithClock(clock),
+		cdcexchange.WithHTTPClient(s.Client()),
+		cdcexchange.WithBaseURL(fmt.Sprintf("%s/", s.URL)),
+		cdcexchange.WithSignatureGenerator(signatureGenerator),
+	)
+	require.NoError(t, err)
+
+	idGenerator.EXPECT().Generate().Return(id)
+	signatureGenerator.EXPECT().GenerateSignature(auth.SignatureRequest{
+		APIKey:    apiKey,
+		SecretKey: secretKey,
+		ID:        id,
+		Method:    cdcexchange.MethodGetOTCInstruments,
+		Timestamp: now.UnixMilli(),
+		Params:    map[string]interface{}{},
+	}).Return(signature, nil)
+
+	instruments, err := client.GetOTCInstruments(ctx)
+	require.NoError(t, err)
+
+	assert.Equal(t, []cdcexchange.OTCInstrument{
+		{
+			InstrumentName:           "BTC_USDT",
+			BaseCurrency:             "BTC",
+			QuoteCurrency:            "USDT",
+			BaseCurrencyDecimals:     6,
+			QuoteCurrencyDecimals:    2,
+			BaseCurrencyMinQuantity:  cdcexchange.MustParseDecimal("0.5"),
+			BaseCurrencyMaxQuantity:  cdcexchange.MustParseDecimal("100"),
+			QuoteCurrencyMinQuantity: cdcexchange.MustParseDecimal("15000"),
+			QuoteCurrencyMaxQuantity: cdcexchange.MustParseDecimal("3000000"),
+			SettlementTime:           "T+0",
+			Tradable:                 true,
+		},
+		{
+			InstrumentName:           "ETH_USDT",
+			BaseCurrency:             "ETH",
+			QuoteCurrency:            "USDT",
+			BaseCurrencyDecimals:     5,
+			QuoteCurrencyDecimals:    2,
+			BaseCurrencyMinQuantity:  cdcexchange.MustParseDecimal("5"),
+			BaseCurrencyMaxQuantity:  cdcexchange.MustParseDecimal("1500"),
+			QuoteCurrencyMinQuantity: cdcexchange.MustParseDecimal("15000"),
+			QuoteCurrencyMaxQuantity: cdcexchange.MustParseDecimal("3000000"),
+			SettlementTime:           "T+0",
+			Tradable:                 false,
+		},
+	}, instruments)
+}
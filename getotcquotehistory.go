@@ -0,0 +1,129 @@
+package cdcexchange
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/sngyai/go-cryptocom/v2/errors"
+	"github.com/sngyai/go-cryptocom/v2/internal/api"
+	"github.com/sngyai/go-cryptocom/v2/internal/auth"
+)
+
+const (
+	methodGetOTCQuoteHistory = "private/otc/get-quote-history"
+)
+
+type (
+	// GetOTCQuoteHistoryRequest is the request params sent for the private/otc/get-quote-history API.
+	GetOTCQuoteHistoryRequest struct {
+		// CurrencyPair represents the currency pair of the quotes (e.g. BTC_USDT).
+		// if CurrencyPair is omitted, quotes for all currency pairs will be returned.
+		CurrencyPair string `json:"currency_pair"`
+		// Start is the start timestamp (milliseconds since the Unix epoch)
+		// (Default: 24 hours ago)
+		Start time.Time `json:"start_ts"`
+		// End is the end timestamp (milliseconds since the Unix epoch)
+		// (Default: now)
+		End time.Time `json:"end_ts"`
+		// QuoteStatus filters the quotes by their status.
+		// if QuoteStatus is omitted, quotes of every status will be returned.
+		QuoteStatus OTCQuoteStatus `json:"quote_status"`
+		// PageRequest is the page size (Default: 20, Max: 200) and page number (0-based) for pagination.
+		PageRequest
+	}
+
+	// GetOTCQuoteHistoryResponse is the base response returned from the private/otc/get-quote-history API.
+	GetOTCQuoteHistoryResponse struct {
+		// BaseResponse is the common response fields.
+		BaseResponse
+		// Result is the response attributes of the endpoint.
+		Result GetOTCQuoteHistoryResult `json:"result"`
+	}
+
+	// GetOTCQuoteHistoryResult is the result returned from the private/otc/get-quote-history API.
+	GetOTCQuoteHistoryResult struct {
+		// Count is the total number of quotes matching the request.
+		Count int `json:"count"`
+		// QuoteList is the array of quotes.
+		QuoteList []OTCQuote `json:"quote_list"`
+	}
+)
+
+// GetOTCQuoteHistory gets the history of OTC quotes requested by the user.
+//
+// Pagination is handled using page size (Default: 20, Max: 200) & number (0-based).
+// If paging is used, enumerate each page (starting with 0) until an empty quote_list array appears in the response.
+//
+// req.QuoteStatus can be left blank to get quotes of every status.
+//
+// Method: private/otc/get-quote-history
+func (c *Client) GetOTCQuoteHistory(ctx context.Context, req GetOTCQuoteHistoryRequest) ([]OTCQuote, error) {
+	if err := req.PageRequest.validate(); err != nil {
+		return nil, err
+	}
+
+	switch req.QuoteStatus {
+	case "", OTCQuoteStatusActive, OTCQuoteStatusFilled, OTCQuoteStatusExpired, OTCQuoteStatusRejected:
+	default:
+		return nil, errors.InvalidParameterError{Parameter: "req.QuoteStatus", Reason: "must be ACTIVE, FILLED, EXPIRED or REJECTED"}
+	}
+
+	var (
+		id        = c.idGenerator.Generate()
+		timestamp = c.now().UnixMilli()
+		params    = make(map[string]interface{})
+	)
+
+	creds, err := c.credentials(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.CurrencyPair != "" {
+		params["currency_pair"] = req.CurrencyPair
+	}
+	if !req.Start.IsZero() {
+		params["start_ts"] = req.Start.UnixMilli()
+	}
+	if !req.End.IsZero() {
+		params["end_ts"] = req.End.UnixMilli()
+	}
+	if req.QuoteStatus != "" {
+		params["quote_status"] = req.QuoteStatus
+	}
+	req.PageRequest.setParams(params)
+
+	signature, err := c.signatureGenerator.GenerateSignature(auth.SignatureRequest{
+		APIKey:    creds.APIKey,
+		SecretKey: creds.SecretKey,
+		ID:        id,
+		Method:    methodGetOTCQuoteHistory,
+		Timestamp: timestamp,
+		Params:    params,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create signature: %w", err)
+	}
+
+	body := api.Request{
+		ID:        id,
+		Method:    methodGetOTCQuoteHistory,
+		Nonce:     timestamp,
+		Params:    params,
+		Signature: signature,
+		APIKey:    creds.APIKey,
+	}
+
+	var getOTCQuoteHistoryResponse GetOTCQuoteHistoryResponse
+	statusCode, err := c.requester.Post(ctx, body, methodGetOTCQuoteHistory, &getOTCQuoteHistoryResponse)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute post request: %w", err)
+	}
+
+	if err := c.requester.CheckResponse(statusCode, api.BaseResponse(getOTCQuoteHistoryResponse.BaseResponse)); err != nil {
+		return nil, fmt.Errorf("error received in response: %w", err)
+	}
+
+	return getOTCQuoteHistoryResponse.Result.QuoteList, nil
+}
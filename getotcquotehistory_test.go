@@ -0,0 +1,177 @@
+package cdcexchange_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/jonboulle/clockwork"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	cdcexchange "github.com/sngyai/go-cryptocom/v2"
+	cdcerrors "github.com/sngyai/go-cryptocom/v2/errors"
+	"github.com/sngyai/go-cryptocom/v2/internal/api"
+	"github.com/sngyai/go-cryptocom/v2/internal/auth"
+	id_mocks "github.com/sngyai/go-cryptocom/v2/internal/mocks/id"
+	signature_mocks "github.com/sngyai/go-cryptocom/v2/internal/mocks/signature"
+)
+
+func TestClient_GetOTCQuoteHistory_Error(t *testing.T) {
+	const (
+		apiKey    = "some api key"
+		secretKey = "some secret key"
+	)
+
+	tests := []struct {
+		name        string
+		req         cdcexchange.GetOTCQuoteHistoryRequest
+		expectedErr error
+	}{
+		{
+			name:        "returns error when page size is less than 0",
+			req:         cdcexchange.GetOTCQuoteHistoryRequest{PageRequest: cdcexchange.PageRequest{PageSize: cdcexchange.Some(-1)}},
+			expectedErr: cdcerrors.InvalidParameterError{Parameter: "req.PageSize", Reason: "cannot be less than 0"},
+		},
+		{
+			name:        "returns error when page size is greater than 200",
+			req:         cdcexchange.GetOTCQuoteHistoryRequest{PageRequest: cdcexchange.PageRequest{PageSize: cdcexchange.Some(201)}},
+			expectedErr: cdcerrors.InvalidParameterError{Parameter: "req.PageSize", Reason: "cannot be greater than 200"},
+		},
+		{
+			name:        "returns error when page is less than 0",
+			req:         cdcexchange.GetOTCQuoteHistoryRequest{PageRequest: cdcexchange.PageRequest{Page: cdcexchange.Some(-1)}},
+			expectedErr: cdcerrors.InvalidParameterError{Parameter: "req.Page", Reason: "cannot be less than 0"},
+		},
+		{
+			name:        "returns error when quote status is invalid",
+			req:         cdcexchange.GetOTCQuoteHistoryRequest{QuoteStatus: "UNKNOWN"},
+			expectedErr: cdcerrors.InvalidParameterError{Parameter: "req.QuoteStatus", Reason: "must be ACTIVE, FILLED, EXPIRED or REJECTED"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			client, err := cdcexchange.New(apiKey, secretKey)
+			require.NoError(t, err)
+
+			quotes, err := client.GetOTCQuoteHistory(ctx, tt.req)
+			require.Error(t, err)
+
+			assert.Empty(t, quotes)
+			assert.True(t, errors.Is(err, tt.expectedErr))
+		})
+	}
+}
+
+func TestClient_GetOTCQuoteHistory_Success(t *testing.T) {
+	const (
+		apiKey       = "some api key"
+		secretKey    = "some secret key"
+		id           = int64(1234)
+		signature    = "some signature"
+		currencyPair = "BTC_USDT"
+		pageSize     = 50
+		page         = 1
+	)
+	now := time.Now()
+
+	ctrl, ctx := gomock.WithContext(context.Background(), t)
+	t.Cleanup(ctrl.Finish)
+
+	var (
+		signatureGenerator = signature_mocks.NewMockSignatureGenerator(ctrl)
+		idGenerator        = id_mocks.NewMockIDGenerator(ctrl)
+		clock              = clockwork.NewFakeClockAt(now)
+	)
+
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Contains(t, r.URL.Path, cdcexchange.MethodGetOTCQuoteHistory)
+		t.Cleanup(func() { require.NoError(t, r.Body.Close()) })
+
+		var body api.Request
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+
+		assert.Equal(t, cdcexchange.MethodGetOTCQuoteHistory, body.Method)
+		assert.Equal(t, currencyPair, body.Params["currency_pair"])
+		assert.Equal(t, string(cdcexchange.OTCQuoteStatusFilled), body.Params["quote_status"])
+
+		_, err := w.Write([]byte(`{
+			"id": 1234,
+			"method": "private/otc/get-quote-history",
+			"code": 0,
+			"result": {
+				"count": 1,
+				"quote_list": [
+					{
+						"quote_id": "2412323",
+						"quote_status": "FILLED",
+						"quote_direction": "SELL",
+						"base_currency": "BTC",
+						"quote_currency": "USDT",
+						"base_currency_size": "2",
+						"quote_sell": "39600.5",
+						"quote_duration": 10,
+						"quote_time": 1635410000000,
+						"quote_expiry_time": 1635410010000
+					}
+				]
+			}
+		}`))
+		require.NoError(t, err)
+	}))
+	t.Cleanup(s.Close)
+
+	client, err := cdcexchange.New(apiKey, secretKey,
+		cdcexchange.WithIDGenerator(idGenerator),
+		cdcexchange.WithClock(clock),
+		cdcexchange.WithHTTPClient(s.Client()),
+		cdcexchange.WithBaseURL(fmt.Sprintf("%s/", s.URL)),
+		cdcexchange.WithSignatureGenerator(signatureGenerator),
+	)
+	require.NoError(t, err)
+
+	idGenerator.EXPECT().Generate().Return(id)
+	signatureGenerator.EXPECT().GenerateSignature(auth.SignatureRequest{
+		APIKey:    apiKey,
+		SecretKey: secretKey,
+		ID:        id,
+		Method:    cdcexchange.MethodGetOTCQuoteHistory,
+		Timestamp: now.UnixMilli(),
+		Params: map[string]interface{}{
+			"currency_pair": currencyPair,
+			"quote_status":  cdcexchange.OTCQuoteStatusFilled,
+			"page_size":     pageSize,
+			"page":          page,
+		},
+	}).Return(signature, nil)
+
+	quotes, err := client.GetOTCQuoteHistory(ctx, cdcexchange.GetOTCQuoteHistoryRequest{
+		CurrencyPair: currencyPair,
+		QuoteStatus:  cdcexchange.OTCQuoteStatusFilled,
+		PageRequest:  cdcexchange.PageRequest{PageSize: cdcexchange.Some(pageSize), Page: cdcexchange.Some(page)},
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, []cdcexchange.OTCQuote{
+		{
+			QuoteID:          "2412323",
+			QuoteStatus:      cdcexchange.OTCQuoteStatusFilled,
+			QuoteDirection:   cdcexchange.OTCQuoteDirectionSell,
+			BaseCurrency:     "BTC",
+			QuoteCurrency:    "USDT",
+			BaseCurrencySize: cdcexchange.MustParseDecimal("2"),
+			QuoteSell:        cdcexchange.MustParseDecimal("39600.5"),
+			QuoteDuration:    10,
+			QuoteTime:        cdcexchange.Timestamp(time.UnixMilli(1635410000000)),
+			QuoteExpiryTime:  cdcexchange.Timestamp(time.UnixMilli(1635410010000)),
+		},
+	}, quotes)
+}
@@ -0,0 +1,124 @@
+package cdcexchange
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/sngyai/go-cryptocom/v2/internal/api"
+	"github.com/sngyai/go-cryptocom/v2/internal/auth"
+)
+
+const (
+	methodGetOTCTradeHistory = "private/otc/get-trade-history"
+)
+
+type (
+	// GetOTCTradeHistoryRequest is the request params sent for the private/otc/get-trade-history API.
+	//
+	// The maximum duration between Start and End is 24 hours.
+	//
+	// An errors.DateRangeError is returned without making a request if the difference exceeds the maximum duration,
+	// or End is before Start (End defaults to now if only Start is set), which matches errors.ErrInvalidDateRange.
+	GetOTCTradeHistoryRequest struct {
+		// CurrencyPair represents the currency pair of the trades (e.g. BTC_USDT).
+		// if CurrencyPair is omitted, trades for all currency pairs will be returned.
+		CurrencyPair string `json:"currency_pair"`
+		// Start is the start timestamp (milliseconds since the Unix epoch)
+		// (Default: 24 hours ago)
+		Start time.Time `json:"start_ts"`
+		// End is the end timestamp (milliseconds since the Unix epoch)
+		// (Default: now)
+		End time.Time `json:"end_ts"`
+		// PageRequest is the page size (Default: 20, Max: 200) and page number (0-based) for pagination.
+		PageRequest
+	}
+
+	// GetOTCTradeHistoryResponse is the base response returned from the private/otc/get-trade-history API.
+	GetOTCTradeHistoryResponse struct {
+		// BaseResponse is the common response fields.
+		BaseResponse
+		// Result is the response attributes of the endpoint.
+		Result GetOTCTradeHistoryResult `json:"result"`
+	}
+
+	// GetOTCTradeHistoryResult is the result returned from the private/otc/get-trade-history API.
+	GetOTCTradeHistoryResult struct {
+		// Count is the total number of trades matching the request.
+		Count int `json:"count"`
+		// TradeList is the array of trades.
+		TradeList []OTCDeal `json:"trade_list"`
+	}
+)
+
+// GetOTCTradeHistory gets the history of OTC trades executed by the user through accepted quotes.
+//
+// Pagination is handled using page size (Default: 20, Max: 200) & number (0-based).
+// If paging is used, enumerate each page (starting with 0) until an empty trade_list array appears in the response.
+//
+// req.CurrencyPair can be left blank to get trades for all currency pairs.
+//
+// Method: private/otc/get-trade-history
+func (c *Client) GetOTCTradeHistory(ctx context.Context, req GetOTCTradeHistoryRequest) ([]OTCDeal, error) {
+	if err := req.PageRequest.validate(); err != nil {
+		return nil, err
+	}
+	if err := validateTimeRange(req.Start, req.End, c.now(), MaxHistoryWindow); err != nil {
+		return nil, err
+	}
+
+	var (
+		id        = c.idGenerator.Generate()
+		timestamp = c.now().UnixMilli()
+		params    = make(map[string]interface{})
+	)
+
+	creds, err := c.credentials(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.CurrencyPair != "" {
+		params["currency_pair"] = req.CurrencyPair
+	}
+	if !req.Start.IsZero() {
+		params["start_ts"] = req.Start.UnixMilli()
+	}
+	if !req.End.IsZero() {
+		params["end_ts"] = req.End.UnixMilli()
+	}
+	req.PageRequest.setParams(params)
+
+	signature, err := c.signatureGenerator.GenerateSignature(auth.SignatureRequest{
+		APIKey:    creds.APIKey,
+		SecretKey: creds.SecretKey,
+		ID:        id,
+		Method:    methodGetOTCTradeHistory,
+		Timestamp: timestamp,
+		Params:    params,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create signature: %w", err)
+	}
+
+	body := api.Request{
+		ID:        id,
+		Method:    methodGetOTCTradeHistory,
+		Nonce:     timestamp,
+		Params:    params,
+		Signature: signature,
+		APIKey:    creds.APIKey,
+	}
+
+	var getOTCTradeHistoryResponse GetOTCTradeHistoryResponse
+	statusCode, err := c.requester.Post(ctx, body, methodGetOTCTradeHistory, &getOTCTradeHistoryResponse)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute post request: %w", err)
+	}
+
+	if err := c.requester.CheckResponse(statusCode, api.BaseResponse(getOTCTradeHistoryResponse.BaseResponse)); err != nil {
+		return nil, fmt.Errorf("error received in response: %w", err)
+	}
+
+	return getOTCTradeHistoryResponse.Result.TradeList, nil
+}
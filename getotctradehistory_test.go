@@ -0,0 +1,213 @@
+package cdcexchange_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/jonboulle/clockwork"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	cdcexchange "github.com/sngyai/go-cryptocom/v2"
+	cdcerrors "github.com/sngyai/go-cryptocom/v2/errors"
+	"github.com/sngyai/go-cryptocom/v2/internal/api"
+	"github.com/sngyai/go-cryptocom/v2/internal/auth"
+	id_mocks "github.com/sngyai/go-cryptocom/v2/internal/mocks/id"
+	signature_mocks "github.com/sngyai/go-cryptocom/v2/internal/mocks/signature"
+)
+
+func TestClient_GetOTCTradeHistory_Error(t *testing.T) {
+	const (
+		apiKey    = "some api key"
+		secretKey = "some secret key"
+	)
+	start := time.Date(2023, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name        string
+		req         cdcexchange.GetOTCTradeHistoryRequest
+		expectedErr error
+	}{
+		{
+			name:        "returns error when page size is less than 0",
+			req:         cdcexchange.GetOTCTradeHistoryRequest{PageRequest: cdcexchange.PageRequest{PageSize: cdcexchange.Some(-1)}},
+			expectedErr: cdcerrors.InvalidParameterError{Parameter: "req.PageSize", Reason: "cannot be less than 0"},
+		},
+		{
+			name:        "returns error when page size is greater than 200",
+			req:         cdcexchange.GetOTCTradeHistoryRequest{PageRequest: cdcexchange.PageRequest{PageSize: cdcexchange.Some(201)}},
+			expectedErr: cdcerrors.InvalidParameterError{Parameter: "req.PageSize", Reason: "cannot be greater than 200"},
+		},
+		{
+			name:        "returns error when page is less than 0",
+			req:         cdcexchange.GetOTCTradeHistoryRequest{PageRequest: cdcexchange.PageRequest{Page: cdcexchange.Some(-1)}},
+			expectedErr: cdcerrors.InvalidParameterError{Parameter: "req.Page", Reason: "cannot be less than 0"},
+		},
+		{
+			name:        "returns error when end is before start",
+			req:         cdcexchange.GetOTCTradeHistoryRequest{Start: start, End: start.Add(-time.Hour)},
+			expectedErr: cdcerrors.DateRangeError{Start: start, End: start.Add(-time.Hour), MaxRange: 24 * time.Hour},
+		},
+		{
+			name:        "returns error when the range is longer than 24 hours",
+			req:         cdcexchange.GetOTCTradeHistoryRequest{Start: start, End: start.Add(25 * time.Hour)},
+			expectedErr: cdcerrors.DateRangeError{Start: start, End: start.Add(25 * time.Hour), MaxRange: 24 * time.Hour},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			client, err := cdcexchange.New(apiKey, secretKey)
+			require.NoError(t, err)
+
+			trades, err := client.GetOTCTradeHistory(ctx, tt.req)
+			require.Error(t, err)
+
+			assert.Empty(t, trades)
+			assert.True(t, errors.Is(err, tt.expectedErr))
+		})
+	}
+
+	t.Run("returns a date range error which matches ErrInvalidDateRange", func(t *testing.T) {
+		client, err := cdcexchange.New(apiKey, secretKey)
+		require.NoError(t, err)
+
+		_, err = client.GetOTCTradeHistory(context.Background(), cdcexchange.GetOTCTradeHistoryRequest{
+			Start: start,
+			End:   start.Add(48 * time.Hour),
+		})
+		assert.True(t, errors.Is(err, cdcerrors.ErrInvalidDateRange))
+	})
+}
+
+func TestClient_GetOTCTradeHistory_Success(t *testing.T) {
+	const (
+		apiKey       = "some api key"
+		secretKey    = "some secret key"
+		id           = int64(1234)
+		signature    = "some signature"
+		currencyPair = "BTC_USDT"
+		pageSize     = 50
+		page         = 1
+	)
+	var (
+		now   = time.Now()
+		start = now.Add(-time.Hour)
+	)
+
+	ctrl, ctx := gomock.WithContext(context.Background(), t)
+	t.Cleanup(ctrl.Finish)
+
+	var (
+		signatureGenerator = signature_mocks.NewMockSignatureGenerator(ctrl)
+		idGenerator        = id_mocks.NewMockIDGenerator(ctrl)
+		clock              = clockwork.NewFakeClockAt(now)
+	)
+
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Contains(t, r.URL.Path, cdcexchange.MethodGetOTCTradeHistory)
+		t.Cleanup(func() { require.NoError(t, r.Body.Close()) })
+
+		var body api.Request
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+
+		assert.Equal(t, cdcexchange.MethodGetOTCTradeHistory, body.Method)
+		assert.Equal(t, signature, body.Signature)
+		assert.Equal(t, currencyPair, body.Params["currency_pair"])
+		assert.Equal(t, float64(start.UnixMilli()), body.Params["start_ts"])
+		assert.Equal(t, float64(now.UnixMilli()), body.Params["end_ts"])
+
+		_, err := w.Write([]byte(`{
+			"id": 1234,
+			"method": "private/otc/get-trade-history",
+			"code": 0,
+			"result": {
+				"count": 1,
+				"trade_list": [
+					{
+						"quote_id": "2412323",
+						"quote_status": "FILLED",
+						"quote_direction": "SELL",
+						"base_currency": "BTC",
+						"quote_currency": "USDT",
+						"base_currency_size": "2",
+						"quote_sell": "39600.5",
+						"quote_duration": 10,
+						"quote_time": 1635410000000,
+						"quote_expiry_time": 1635410010000,
+						"trade_direction": "SELL",
+						"trade_price": "39600.5",
+						"trade_quantity": "2",
+						"trade_value": "79201",
+						"trade_time": 1635410005000
+					}
+				]
+			}
+		}`))
+		require.NoError(t, err)
+	}))
+	t.Cleanup(s.Close)
+
+	client, err := cdcexchange.New(apiKey, secretKey,
+		cdcexchange.WithIDGenerator(idGenerator),
+		cdcexchange.WithClock(clock),
+		cdcexchange.WithHTTPClient(s.Client()),
+		cdcexchange.WithBaseURL(fmt.Sprintf("%s/", s.URL)),
+		cdcexchange.WithSignatureGenerator(signatureGenerator),
+	)
+	require.NoError(t, err)
+
+	idGenerator.EXPECT().Generate().Return(id)
+	signatureGenerator.EXPECT().GenerateSignature(auth.SignatureRequest{
+		APIKey:    apiKey,
+		SecretKey: secretKey,
+		ID:        id,
+		Method:    cdcexchange.MethodGetOTCTradeHistory,
+		Timestamp: now.UnixMilli(),
+		Params: map[string]interface{}{
+			"currency_pair": currencyPair,
+			"start_ts":      start.UnixMilli(),
+			"end_ts":        now.UnixMilli(),
+			"page_size":     pageSize,
+			"page":          page,
+		},
+	}).Return(signature, nil)
+
+	trades, err := client.GetOTCTradeHistory(ctx, cdcexchange.GetOTCTradeHistoryRequest{
+		CurrencyPair: currencyPair,
+		Start:        start,
+		End:          now,
+		PageRequest:  cdcexchange.PageRequest{PageSize: cdcexchange.Some(pageSize), Page: cdcexchange.Some(page)},
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, []cdcexchange.OTCDeal{
+		{
+			OTCQuote: cdcexchange.OTCQuote{
+				QuoteID:          "2412323",
+				QuoteStatus:      cdcexchange.OTCQuoteStatusFilled,
+				QuoteDirection:   cdcexchange.OTCQuoteDirectionSell,
+				BaseCurrency:     "BTC",
+				QuoteCurrency:    "USDT",
+				BaseCurrencySize: cdcexchange.MustParseDecimal("2"),
+				QuoteSell:        cdcexchange.MustParseDecimal("39600.5"),
+				QuoteDuration:    10,
+				QuoteTime:        cdcexchange.Timestamp(time.UnixMilli(1635410000000)),
+				QuoteExpiryTime:  cdcexchange.Timestamp(time.UnixMilli(1635410010000)),
+			},
+			TradeDirection: cdcexchange.OTCQuoteDirectionSell,
+			TradePrice:     cdcexchange.MustParseDecimal("39600.5"),
+			TradeQuantity:  cdcexchange.MustParseDecimal("2"),
+			TradeValue:     cdcexchange.MustParseDecimal("79201"),
+			TradeTime:      cdcexchange.Timestamp(time.UnixMilli(1635410005000)),
+		},
+	}, trades)
+}
@@ -0,0 +1,93 @@
+package cdcexchange
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/sngyai/go-cryptocom/v2/internal/api"
+	"github.com/sngyai/go-cryptocom/v2/internal/auth"
+)
+
+const (
+	methodGetOTCUser = "private/otc/get-otc-user"
+)
+
+type (
+	// GetOTCUserResponse is the base response returned from the private/otc/get-otc-user API.
+	GetOTCUserResponse struct {
+		// BaseResponse is the common response fields.
+		BaseResponse
+		// Result is the response attributes of the endpoint.
+		Result OTCUser `json:"result"`
+	}
+
+	// OTCUser is the result returned from the private/otc/get-otc-user API.
+	OTCUser struct {
+		// AccountUUID is the UUID of the OTC account.
+		AccountUUID string `json:"account_uuid"`
+		// RequestsPerMinute is the maximum number of quote requests allowed per minute.
+		RequestsPerMinute int `json:"requests_per_minute"`
+		// MaxTradeValueUSD is the maximum value of a single trade, in USD.
+		MaxTradeValueUSD Decimal `json:"max_trade_value_usd"`
+		// MinTradeValueUSD is the minimum value of a single trade, in USD.
+		MinTradeValueUSD Decimal `json:"min_trade_value_usd"`
+		// TradeEnabled is true when the account is allowed to trade OTC.
+		TradeEnabled bool `json:"trade_enabled"`
+		// AcceptOTCTCTime is the time the OTC terms & conditions were accepted.
+		AcceptOTCTCTime Timestamp `json:"accept_otc_tc_datetime"`
+		// Raw is the raw JSON of the result, for fields not decoded by this package.
+		Raw json.RawMessage `json:"-"`
+	}
+)
+
+// GetOTCUser returns the OTC account status of the user (e.g. quote request quota and trade permissions).
+//
+// Method: private/otc/get-otc-user
+func (c *Client) GetOTCUser(ctx context.Context) (*OTCUser, error) {
+	var (
+		id        = c.idGenerator.Generate()
+		timestamp = c.now().UnixMilli()
+		params    = make(map[string]interface{})
+	)
+
+	creds, err := c.credentials(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	signature, err := c.signatureGenerator.GenerateSignature(auth.SignatureRequest{
+		APIKey:    creds.APIKey,
+		SecretKey: creds.SecretKey,
+		ID:        id,
+		Method:    methodGetOTCUser,
+		Timestamp: timestamp,
+		Params:    params,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create signature: %w", err)
+	}
+
+	body := api.Request{
+		ID:        id,
+		Method:    methodGetOTCUser,
+		Nonce:     timestamp,
+		Params:    params,
+		Signature: signature,
+		APIKey:    creds.APIKey,
+	}
+
+	var getOTCUserResponse GetOTCUserResponse
+	statusCode, err := c.requester.Post(ctx, body, methodGetOTCUser, &getOTCUserResponse)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute post request: %w", err)
+	}
+
+	if err := c.requester.CheckResponse(statusCode, api.BaseResponse(getOTCUserResponse.BaseResponse)); err != nil {
+		return nil, fmt.Errorf("error received in response: %w", err)
+	}
+
+	getOTCUserResponse.Result.Raw = getOTCUserResponse.RawResult
+
+	return &getOTCUserResponse.Result, nil
+}
@@ -0,0 +1,93 @@
+package cdcexchange_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/jonboulle/clockwork"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	cdcexchange "github.com/sngyai/go-cryptocom/v2"
+	"github.com/sngyai/go-cryptocom/v2/internal/auth"
+	id_mocks "github.com/sngyai/go-cryptocom/v2/internal/mocks/id"
+	signature_mocks "github.com/sngyai/go-cryptocom/v2/internal/mocks/signature"
+)
+
+func TestClient_GetOTCUser_Success(t *testing.T) {
+	const (
+		apiKey    = "some api key"
+		secretKey = "some secret key"
+		id        = int64(1234)
+		signature = "some signature"
+	)
+	now := time.Now()
+
+	ctrl, ctx := gomock.WithContext(context.Background(), t)
+	t.Cleanup(ctrl.Finish)
+
+	var (
+		signatureGenerator = signature_mocks.NewMockSignatureGenerator(ctrl)
+		idGenerator        = id_mocks.NewMockIDGenerator(ctrl)
+		clock              = clockwork.NewFakeClockAt(now)
+	)
+
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Contains(t, r.URL.Path, cdcexchange.MethodGetOTCUser)
+
+		_, err := w.Write([]byte(`{
+			"id": 1234,
+			"method": "private/otc/get-otc-user",
+			"code": 0,
+			"result": {
+				"account_uuid": "00000000-00000000-00000000-00000000",
+				"requests_per_minute": 30,
+				"max_trade_value_usd": "5000000",
+				"min_trade_value_usd": "50000",
+				"trade_enabled": true,
+				"accept_otc_tc_datetime": 1636512069509
+			}
+		}`))
+		require.NoError(t, err)
+	}))
+	t.Cleanup(s.Close)
+
+	client, err := cdcexchange.New(apiKey, secretKey,
+		cdcexchange.WithIDGenerator(idGenerator),
+		cdcexchange.WithClock(clock),
+		cdcexchange.WithHTTPClient(s.Client()),
+		cdcexchange.WithBaseURL(fmt.Sprintf("%s/", s.URL)),
+		cdcexchange.WithSignatureGenerator(signatureGenerator),
+	)
+	require.NoError(t, err)
+
+	idGenerator.EXPECT().Generate().Return(id)
+	signatureGenerator.EXPECT().GenerateSignature(auth.SignatureRequest{
+		APIKey:    apiKey,
+		SecretKey: secretKey,
+		ID:        id,
+		Method:    cdcexchange.MethodGetOTCUser,
+		Timestamp: now.UnixMilli(),
+		Params:    map[string]interface{}{},
+	}).Return(signature, nil)
+
+	user, err := client.GetOTCUser(ctx)
+	require.NoError(t, err)
+
+	require.NotEmpty(t, user.Raw)
+	user.Raw = nil
+
+	assert.Equal(t, &cdcexchange.OTCUser{
+		AccountUUID:       "00000000-00000000-00000000-00000000",
+		RequestsPerMinute: 30,
+		MaxTradeValueUSD:  cdcexchange.MustParseDecimal("5000000"),
+		MinTradeValueUSD:  cdcexchange.MustParseDecimal("50000"),
+		TradeEnabled:      true,
+		AcceptOTCTCTime:   cdcexchange.Timestamp(time.UnixMilli(1636512069509)),
+	}, user)
+}
@@ -0,0 +1,139 @@
+package cdcexchange
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/sngyai/go-cryptocom/v2/errors"
+	"github.com/sngyai/go-cryptocom/v2/internal/api"
+	"github.com/sngyai/go-cryptocom/v2/internal/auth"
+)
+
+const (
+	methodGetRewardHistory = "private/staking/get-reward-history"
+)
+
+type (
+	// GetRewardHistoryRequest is the request params sent for the private/staking/get-reward-history API.
+	GetRewardHistoryRequest struct {
+		// InstrumentName is the staking instrument name (e.g. SOL.staked).
+		// if InstrumentName is omitted, all instruments will be returned.
+		InstrumentName string `json:"instrument_name"`
+		// Start is the start timestamp (milliseconds since the Unix epoch)
+		// (Default: 30 days ago)
+		Start time.Time `json:"start_time"`
+		// End is the end timestamp (milliseconds since the Unix epoch)
+		// (Default: now)
+		End time.Time `json:"end_time"`
+		// Limit represents maximum number of rewards returned (for pagination)
+		// (Default: 20, Max: 500)
+		// if Limit is not set, it will be set as 20 by default.
+		Limit Optional[int] `json:"limit"`
+	}
+
+	// GetRewardHistoryResponse is the base response returned from the private/staking/get-reward-history API.
+	GetRewardHistoryResponse struct {
+		// BaseResponse is the common response fields.
+		BaseResponse
+		// Result is the response attributes of the endpoint.
+		Result GetRewardHistoryResult `json:"result"`
+	}
+
+	// GetRewardHistoryResult is the result returned from the private/staking/get-reward-history API.
+	GetRewardHistoryResult struct {
+		// Data is the list of reward events.
+		Data []StakingReward `json:"data"`
+	}
+
+	// StakingReward represents a single staking reward payout.
+	StakingReward struct {
+		// StakingInstName is the staking instrument name (e.g. SOL.staked).
+		StakingInstName string `json:"staking_inst_name"`
+		// UnderlyingInstName is the underlying instrument name (e.g. SOL).
+		UnderlyingInstName string `json:"underlying_inst_name"`
+		// RewardInstName is the instrument the reward was paid out in.
+		RewardInstName string `json:"reward_inst_name"`
+		// RewardQuantity is the quantity of the reward.
+		RewardQuantity Decimal `json:"reward_quantity"`
+		// StakedBalance is the staked balance the reward was calculated on.
+		StakedBalance Decimal `json:"staked_balance"`
+		// EventTime is the time the reward was paid out.
+		EventTime Timestamp `json:"event_timestamp_ms"`
+	}
+)
+
+// GetRewardHistory gets the staking reward history for a particular instrument.
+//
+// Pagination is handled using Limit (Default: 20, Max: 500) and moving the End timestamp
+// back to the EventTime of the oldest returned reward.
+//
+// req.InstrumentName can be left blank to get rewards for all instruments.
+//
+// Method: private/staking/get-reward-history
+func (c *Client) GetRewardHistory(ctx context.Context, req GetRewardHistoryRequest) ([]StakingReward, error) {
+	limit, hasLimit := req.Limit.Get()
+	if limit < 0 {
+		return nil, errors.InvalidParameterError{Parameter: "req.Limit", Reason: "cannot be less than 0"}
+	}
+	if limit > 500 {
+		return nil, errors.InvalidParameterError{Parameter: "req.Limit", Reason: "cannot be greater than 500"}
+	}
+
+	var (
+		id        = c.idGenerator.Generate()
+		timestamp = c.now().UnixMilli()
+		params    = make(map[string]interface{})
+	)
+
+	creds, err := c.credentials(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.InstrumentName != "" {
+		params["instrument_name"] = req.InstrumentName
+	}
+	if !req.Start.IsZero() {
+		params["start_time"] = req.Start.UnixMilli()
+	}
+	if !req.End.IsZero() {
+		params["end_time"] = req.End.UnixMilli()
+	}
+	if hasLimit {
+		params["limit"] = limit
+	}
+
+	signature, err := c.signatureGenerator.GenerateSignature(auth.SignatureRequest{
+		APIKey:    creds.APIKey,
+		SecretKey: creds.SecretKey,
+		ID:        id,
+		Method:    methodGetRewardHistory,
+		Timestamp: timestamp,
+		Params:    params,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create signature: %w", err)
+	}
+
+	body := api.Request{
+		ID:        id,
+		Method:    methodGetRewardHistory,
+		Nonce:     timestamp,
+		Params:    params,
+		Signature: signature,
+		APIKey:    creds.APIKey,
+	}
+
+	var getRewardHistoryResponse GetRewardHistoryResponse
+	statusCode, err := c.requester.Post(ctx, body, methodGetRewardHistory, &getRewardHistoryResponse)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute post request: %w", err)
+	}
+
+	if err := c.requester.CheckResponse(statusCode, api.BaseResponse(getRewardHistoryResponse.BaseResponse)); err != nil {
+		return nil, fmt.Errorf("error received in response: %w", err)
+	}
+
+	return getRewardHistoryResponse.Result.Data, nil
+}
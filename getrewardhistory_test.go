@@ -0,0 +1,102 @@
+package cdcexchange_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/jonboulle/clockwork"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	cdcexchange "github.com/sngyai/go-cryptocom/v2"
+	"github.com/sngyai/go-cryptocom/v2/internal/auth"
+	id_mocks "github.com/sngyai/go-cryptocom/v2/internal/mocks/id"
+	signature_mocks "github.com/sngyai/go-cryptocom/v2/internal/mocks/signature"
+)
+
+func TestClient_GetRewardHistory_Success(t *testing.T) {
+	const (
+		apiKey    = "some api key"
+		secretKey = "some secret key"
+		id        = int64(1234)
+		signature = "some signature"
+
+		instrumentName = "SOL.staked"
+	)
+	now := time.Now()
+
+	ctrl, ctx := gomock.WithContext(context.Background(), t)
+	t.Cleanup(ctrl.Finish)
+
+	var (
+		signatureGenerator = signature_mocks.NewMockSignatureGenerator(ctrl)
+		idGenerator        = id_mocks.NewMockIDGenerator(ctrl)
+		clock              = clockwork.NewFakeClockAt(now)
+	)
+
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Contains(t, r.URL.Path, cdcexchange.MethodGetRewardHistory)
+
+		_, err := w.Write([]byte(`{
+			"id": 1234,
+			"method": "private/staking/get-reward-history",
+			"code": 0,
+			"result": {
+				"data": [
+					{
+						"staking_inst_name": "SOL.staked",
+						"underlying_inst_name": "SOL",
+						"reward_inst_name": "SOL.staked",
+						"reward_quantity": "123.4567",
+						"staked_balance": "1234567",
+						"event_timestamp_ms": "1667795832609"
+					}
+				]
+			}
+		}`))
+		require.NoError(t, err)
+	}))
+	t.Cleanup(s.Close)
+
+	client, err := cdcexchange.New(apiKey, secretKey,
+		cdcexchange.WithIDGenerator(idGenerator),
+		cdcexchange.WithClock(clock),
+		cdcexchange.WithHTTPClient(s.Client()),
+		cdcexchange.WithBaseURL(fmt.Sprintf("%s/", s.URL)),
+		cdcexchange.WithSignatureGenerator(signatureGenerator),
+	)
+	require.NoError(t, err)
+
+	idGenerator.EXPECT().Generate().Return(id)
+	signatureGenerator.EXPECT().GenerateSignature(auth.SignatureRequest{
+		APIKey:    apiKey,
+		SecretKey: secretKey,
+		ID:        id,
+		Method:    cdcexchange.MethodGetRewardHistory,
+		Timestamp: now.UnixMilli(),
+		Params: map[string]interface{}{
+			"instrument_name": instrumentName,
+		},
+	}).Return(signature, nil)
+
+	rewards, err := client.GetRewardHistory(ctx, cdcexchange.GetRewardHistoryRequest{
+		InstrumentName: instrumentName,
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, []cdcexchange.StakingReward{
+		{
+			StakingInstName:    instrumentName,
+			UnderlyingInstName: "SOL",
+			RewardInstName:     instrumentName,
+			RewardQuantity:     cdcexchange.MustParseDecimal("123.4567"),
+			StakedBalance:      cdcexchange.MustParseDecimal("1234567"),
+			EventTime:          cdcexchange.Timestamp(time.UnixMilli(1667795832609)),
+		},
+	}, rewards)
+}
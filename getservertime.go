@@ -0,0 +1,48 @@
+package cdcexchange
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// serverTimeInstrument is the instrument whose order book is requested by GetServerTime.
+const serverTimeInstrument = "BTC_USDT"
+
+// GetServerTime returns the current time of the Exchange.
+//
+// The Exchange has no method to get its time, so the time is estimated from the Date header of the response
+// to the cheapest public request, the order book of BTC_USDT with a depth of 1. The Date header is used rather than
+// the snapshot time of the order book, as it is set when the response is sent (accurate to within a second,
+// which is well within the nonce tolerance).
+//
+// If WithServerTimeSync is used, the measured clock drift is applied to all subsequent requests.
+//
+// No authentication is required.
+//
+// Method: public/get-book
+func (c *Client) GetServerTime(ctx context.Context) (time.Time, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.requester.URL(methodGetBook, ""), nil)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	q := req.URL.Query()
+	q.Add("instrument_name", serverTimeInstrument)
+	q.Add("depth", "1")
+	req.URL.RawQuery = q.Encode()
+
+	serverTime, err := c.requester.ServerTime(ctx, req, methodGetBook)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	return serverTime, nil
+}
+
+// now returns the current time, corrected for the drift from the clock of the Exchange (if server time sync is enabled).
+func (c *Client) now() time.Time {
+	return c.clock.Now().Add(c.requester.ServerClock.Offset())
+}
@@ -0,0 +1,132 @@
+package cdcexchange_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/jonboulle/clockwork"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	cdcexchange "github.com/sngyai/go-cryptocom/v2"
+	"github.com/sngyai/go-cryptocom/v2/internal/api"
+)
+
+func TestClient_GetServerTime_Error(t *testing.T) {
+	tests := []struct {
+		name   string
+		header string
+	}{
+		{
+			name:   "returns error when the date header is missing",
+			header: "",
+		},
+		{
+			name:   "returns error when the date header is invalid",
+			header: "not a date",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header()["Date"] = []string{tt.header}
+				_, err := w.Write([]byte(`{"id": 1, "code": 0, "result": {"data": []}}`))
+				require.NoError(t, err)
+			}))
+			t.Cleanup(s.Close)
+
+			client, err := cdcexchange.New("api key", "secret key",
+				cdcexchange.WithHTTPClient(s.Client()),
+				cdcexchange.WithBaseURL(fmt.Sprintf("%s/", s.URL)),
+			)
+			require.NoError(t, err)
+
+			serverTime, err := client.GetServerTime(context.Background())
+			require.Error(t, err)
+
+			assert.True(t, serverTime.IsZero())
+		})
+	}
+}
+
+func TestClient_GetServerTime_Success(t *testing.T) {
+	serverTime := time.Now().Add(time.Hour).UTC().Truncate(time.Second)
+
+	var nonce int64
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPost {
+			var body api.Request
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+			nonce = body.Nonce
+		} else {
+			assert.Contains(t, r.URL.Path, cdcexchange.MethodGetBook)
+			assert.Equal(t, "BTC_USDT", r.URL.Query().Get("instrument_name"))
+			assert.Equal(t, "1", r.URL.Query().Get("depth"))
+		}
+
+		w.Header().Set("Date", serverTime.Format(http.TimeFormat))
+		_, err := w.Write([]byte(`{"id": 1, "code": 0, "result": {"data": []}}`))
+		require.NoError(t, err)
+	}))
+	t.Cleanup(s.Close)
+
+	client, err := cdcexchange.New("api key", "secret key",
+		cdcexchange.WithHTTPClient(s.Client()),
+		cdcexchange.WithBaseURL(fmt.Sprintf("%s/", s.URL)),
+		cdcexchange.WithServerTimeSync(time.Hour),
+	)
+	require.NoError(t, err)
+
+	res, err := client.GetServerTime(context.Background())
+	require.NoError(t, err)
+	assert.WithinDuration(t, serverTime, res, time.Second)
+
+	_, err = client.GetUserBalance(context.Background())
+	require.NoError(t, err)
+
+	// the nonce of signed requests is corrected for the drift of the local clock
+	assert.WithinDuration(t, serverTime, time.UnixMilli(nonce), 2*time.Second)
+}
+
+func TestClient_GetServerTime_Clock(t *testing.T) {
+	var (
+		serverTime = time.Now().Add(time.Hour).UTC().Truncate(time.Second)
+		clock      = clockwork.NewFakeClockAt(serverTime.Add(-24 * time.Hour))
+	)
+
+	var nonce int64
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPost {
+			var body api.Request
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+			nonce = body.Nonce
+		}
+
+		w.Header().Set("Date", serverTime.Format(http.TimeFormat))
+		_, err := w.Write([]byte(`{"id": 1, "code": 0, "result": {"data": []}}`))
+		require.NoError(t, err)
+	}))
+	t.Cleanup(s.Close)
+
+	client, err := cdcexchange.New("api key", "secret key",
+		cdcexchange.WithClock(clock),
+		cdcexchange.WithHTTPClient(s.Client()),
+		cdcexchange.WithBaseURL(fmt.Sprintf("%s/", s.URL)),
+		cdcexchange.WithServerTimeSync(time.Hour),
+	)
+	require.NoError(t, err)
+
+	_, err = client.GetServerTime(context.Background())
+	require.NoError(t, err)
+
+	_, err = client.GetUserBalance(context.Background())
+	require.NoError(t, err)
+
+	// the offset is measured against the clock of the Client, so the nonce is the time of the Exchange
+	assert.WithinDuration(t, serverTime, time.UnixMilli(nonce), time.Second)
+}
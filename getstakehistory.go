@@ -0,0 +1,126 @@
+package cdcexchange
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/sngyai/go-cryptocom/v2/errors"
+	"github.com/sngyai/go-cryptocom/v2/internal/api"
+	"github.com/sngyai/go-cryptocom/v2/internal/auth"
+)
+
+const (
+	methodGetStakeHistory = "private/staking/get-stake-history"
+)
+
+type (
+	// GetStakeHistoryRequest is the request params sent for the private/staking/get-stake-history API.
+	//
+	// For users looking to pull longer histories, users can create a loop to make a request
+	// for each page, moving End back to the CreateTime of the oldest request returned.
+	GetStakeHistoryRequest struct {
+		// InstrumentName is the staking instrument name (e.g. SOL.staked).
+		// if InstrumentName is omitted, all instruments will be returned.
+		InstrumentName string `json:"instrument_name"`
+		// Start is the start timestamp (milliseconds since the Unix epoch)
+		// (Default: 30 days ago)
+		Start time.Time `json:"start_time"`
+		// End is the end timestamp (milliseconds since the Unix epoch)
+		// (Default: now)
+		End time.Time `json:"end_time"`
+		// Limit represents maximum number of requests returned (for pagination)
+		// (Default: 20, Max: 500)
+		// if Limit is not set, it will be set as 20 by default.
+		Limit Optional[int] `json:"limit"`
+	}
+
+	// GetStakeHistoryResponse is the base response returned from the private/staking/get-stake-history API.
+	GetStakeHistoryResponse struct {
+		// BaseResponse is the common response fields.
+		BaseResponse
+		// Result is the response attributes of the endpoint.
+		Result GetStakeHistoryResult `json:"result"`
+	}
+
+	// GetStakeHistoryResult is the result returned from the private/staking/get-stake-history API.
+	GetStakeHistoryResult struct {
+		// Data is the list of completed stake/unstake requests.
+		Data []StakingRequest `json:"data"`
+	}
+)
+
+// GetStakeHistory gets the stake and unstake request history for a particular instrument.
+//
+// Pagination is handled using Limit (Default: 20, Max: 500) and moving the End timestamp
+// back to the CreateTime of the oldest returned request.
+//
+// req.InstrumentName can be left blank to get the history for all instruments.
+//
+// Method: private/staking/get-stake-history
+func (c *Client) GetStakeHistory(ctx context.Context, req GetStakeHistoryRequest) ([]StakingRequest, error) {
+	limit, hasLimit := req.Limit.Get()
+	if limit < 0 {
+		return nil, errors.InvalidParameterError{Parameter: "req.Limit", Reason: "cannot be less than 0"}
+	}
+	if limit > 500 {
+		return nil, errors.InvalidParameterError{Parameter: "req.Limit", Reason: "cannot be greater than 500"}
+	}
+
+	var (
+		id        = c.idGenerator.Generate()
+		timestamp = c.now().UnixMilli()
+		params    = make(map[string]interface{})
+	)
+
+	creds, err := c.credentials(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.InstrumentName != "" {
+		params["instrument_name"] = req.InstrumentName
+	}
+	if !req.Start.IsZero() {
+		params["start_time"] = req.Start.UnixMilli()
+	}
+	if !req.End.IsZero() {
+		params["end_time"] = req.End.UnixMilli()
+	}
+	if hasLimit {
+		params["limit"] = limit
+	}
+
+	signature, err := c.signatureGenerator.GenerateSignature(auth.SignatureRequest{
+		APIKey:    creds.APIKey,
+		SecretKey: creds.SecretKey,
+		ID:        id,
+		Method:    methodGetStakeHistory,
+		Timestamp: timestamp,
+		Params:    params,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create signature: %w", err)
+	}
+
+	body := api.Request{
+		ID:        id,
+		Method:    methodGetStakeHistory,
+		Nonce:     timestamp,
+		Params:    params,
+		Signature: signature,
+		APIKey:    creds.APIKey,
+	}
+
+	var getStakeHistoryResponse GetStakeHistoryResponse
+	statusCode, err := c.requester.Post(ctx, body, methodGetStakeHistory, &getStakeHistoryResponse)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute post request: %w", err)
+	}
+
+	if err := c.requester.CheckResponse(statusCode, api.BaseResponse(getStakeHistoryResponse.BaseResponse)); err != nil {
+		return nil, fmt.Errorf("error received in response: %w", err)
+	}
+
+	return getStakeHistoryResponse.Result.Data, nil
+}
@@ -0,0 +1,213 @@
+package cdcexchange_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/jonboulle/clockwork"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	cdcexchange "github.com/sngyai/go-cryptocom/v2"
+	cdcerrors "github.com/sngyai/go-cryptocom/v2/errors"
+	"github.com/sngyai/go-cryptocom/v2/internal/api"
+	"github.com/sngyai/go-cryptocom/v2/internal/auth"
+	id_mocks "github.com/sngyai/go-cryptocom/v2/internal/mocks/id"
+	signature_mocks "github.com/sngyai/go-cryptocom/v2/internal/mocks/signature"
+)
+
+func TestClient_GetStakeHistory_Error(t *testing.T) {
+	const (
+		apiKey    = "some api key"
+		secretKey = "some secret key"
+	)
+
+	tests := []struct {
+		name        string
+		req         cdcexchange.GetStakeHistoryRequest
+		expectedErr error
+	}{
+		{
+			name:        "returns error when limit is less than 0",
+			req:         cdcexchange.GetStakeHistoryRequest{Limit: cdcexchange.Some(-1)},
+			expectedErr: cdcerrors.InvalidParameterError{Parameter: "req.Limit", Reason: "cannot be less than 0"},
+		},
+		{
+			name:        "returns error when limit is greater than 500",
+			req:         cdcexchange.GetStakeHistoryRequest{Limit: cdcexchange.Some(501)},
+			expectedErr: cdcerrors.InvalidParameterError{Parameter: "req.Limit", Reason: "cannot be greater than 500"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client, err := cdcexchange.New(apiKey, secretKey)
+			require.NoError(t, err)
+
+			requests, err := client.GetStakeHistory(context.Background(), tt.req)
+			require.Error(t, err)
+
+			assert.Empty(t, requests)
+			assert.True(t, errors.Is(err, tt.expectedErr))
+		})
+	}
+}
+
+func TestClient_GetStakeHistory_Success(t *testing.T) {
+	const (
+		apiKey    = "some api key"
+		secretKey = "some secret key"
+		id        = int64(1234)
+		signature = "some signature"
+
+		instrumentName = "SOL.staked"
+		limit          = 50
+	)
+	var (
+		now   = time.Now()
+		start = now.Add(-time.Hour)
+	)
+
+	ctrl, ctx := gomock.WithContext(context.Background(), t)
+	t.Cleanup(ctrl.Finish)
+
+	var (
+		signatureGenerator = signature_mocks.NewMockSignatureGenerator(ctrl)
+		idGenerator        = id_mocks.NewMockIDGenerator(ctrl)
+		clock              = clockwork.NewFakeClockAt(now)
+	)
+
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Contains(t, r.URL.Path, cdcexchange.MethodGetStakeHistory)
+		t.Cleanup(func() { require.NoError(t, r.Body.Close()) })
+
+		var body api.Request
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+
+		assert.Equal(t, cdcexchange.MethodGetStakeHistory, body.Method)
+		assert.Equal(t, signature, body.Signature)
+		assert.Equal(t, instrumentName, body.Params["instrument_name"])
+		assert.Equal(t, float64(start.UnixMilli()), body.Params["start_time"])
+		assert.Equal(t, float64(now.UnixMilli()), body.Params["end_time"])
+		assert.Equal(t, float64(limit), body.Params["limit"])
+
+		_, err := w.Write([]byte(`{
+			"id": 1234,
+			"method": "private/staking/get-stake-history",
+			"code": 0,
+			"result": {
+				"data": [
+					{
+						"instrument_name": "SOL.staked",
+						"underlying_inst_name": "SOL",
+						"cycle_id": "1",
+						"staking_id": "2",
+						"status": "COMPLETED",
+						"account": "12345678-9999-1234-9999-123456789999",
+						"quantity": "1",
+						"side": "UNSTAKE",
+						"create_timestamp_ms": "1668658093600"
+					}
+				]
+			}
+		}`))
+		require.NoError(t, err)
+	}))
+	t.Cleanup(s.Close)
+
+	client, err := cdcexchange.New(apiKey, secretKey,
+		cdcexchange.WithIDGenerator(idGenerator),
+		cdcexchange.WithClock(clock),
+		cdcexchange.WithHTTPClient(s.Client()),
+		cdcexchange.WithBaseURL(fmt.Sprintf("%s/", s.URL)),
+		cdcexchange.WithSignatureGenerator(signatureGenerator),
+	)
+	require.NoError(t, err)
+
+	idGenerator.EXPECT().Generate().Return(id)
+	signatureGenerator.EXPECT().GenerateSignature(auth.SignatureRequest{
+		APIKey:    apiKey,
+		SecretKey: secretKey,
+		ID:        id,
+		Method:    cdcexchange.MethodGetStakeHistory,
+		Timestamp: now.UnixMilli(),
+		Params: map[string]interface{}{
+			"instrument_name": instrumentName,
+			"start_time":      start.UnixMilli(),
+			"end_time":        now.UnixMilli(),
+			"limit":           limit,
+		},
+	}).Return(signature, nil)
+
+	requests, err := client.GetStakeHistory(ctx, cdcexchange.GetStakeHistoryRequest{
+		InstrumentName: instrumentName,
+		Start:          start,
+		End:            now,
+		Limit:          cdcexchange.Some(limit),
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, []cdcexchange.StakingRequest{
+		{
+			InstrumentName:     instrumentName,
+			UnderlyingInstName: "SOL",
+			CycleID:            "1",
+			StakingID:          "2",
+			Status:             cdcexchange.StakingStatusCompleted,
+			Account:            "12345678-9999-1234-9999-123456789999",
+			Quantity:           cdcexchange.MustParseDecimal("1"),
+			Side:               cdcexchange.StakingSideUnstake,
+			CreateTime:         cdcexchange.Timestamp(time.UnixMilli(1668658093600)),
+		},
+	}, requests)
+}
+
+func TestClient_GetStakeHistory_Params(t *testing.T) {
+	tests := []struct {
+		name           string
+		req            cdcexchange.GetStakeHistoryRequest
+		expectedParams map[string]interface{}
+	}{
+		{
+			name:           "omits unset params",
+			req:            cdcexchange.GetStakeHistoryRequest{},
+			expectedParams: map[string]interface{}{},
+		},
+		{
+			name:           "sends a limit explicitly set to 0",
+			req:            cdcexchange.GetStakeHistoryRequest{Limit: cdcexchange.Some(0)},
+			expectedParams: map[string]interface{}{"limit": float64(0)},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				var body api.Request
+				require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+
+				assert.Equal(t, tt.expectedParams, body.Params)
+
+				_, err := w.Write([]byte(`{"id": 1234, "code": 0, "result": {"data": []}}`))
+				require.NoError(t, err)
+			}))
+			t.Cleanup(s.Close)
+
+			client, err := cdcexchange.New("some api key", "some secret key",
+				cdcexchange.WithHTTPClient(s.Client()),
+				cdcexchange.WithBaseURL(fmt.Sprintf("%s/", s.URL)),
+			)
+			require.NoError(t, err)
+
+			requests, err := client.GetStakeHistory(context.Background(), tt.req)
+			require.NoError(t, err)
+
+			assert.Empty(t, requests)
+		})
+	}
+}
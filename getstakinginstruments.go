@@ -0,0 +1,110 @@
+package cdcexchange
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/sngyai/go-cryptocom/v2/internal/api"
+	"github.com/sngyai/go-cryptocom/v2/internal/auth"
+)
+
+const (
+	methodGetStakingInstruments = "private/staking/get-staking-instruments"
+)
+
+type (
+	// GetStakingInstrumentsResponse is the base response returned from the private/staking/get-staking-instruments API.
+	GetStakingInstrumentsResponse struct {
+		// BaseResponse is the common response fields.
+		BaseResponse
+		// Result is the response attributes of the endpoint.
+		Result GetStakingInstrumentsResult `json:"result"`
+	}
+
+	// GetStakingInstrumentsResult is the result returned from the private/staking/get-staking-instruments API.
+	GetStakingInstrumentsResult struct {
+		// Data is the list of stakeable instruments.
+		Data []StakingInstrument `json:"data"`
+	}
+
+	// StakingInstrument represents the staking terms of a specific instrument.
+	StakingInstrument struct {
+		// InstrumentName is the staking instrument name (e.g. SOL.staked).
+		InstrumentName string `json:"instrument_name"`
+		// UnderlyingInstName is the underlying instrument name (e.g. SOL).
+		UnderlyingInstName string `json:"underlying_inst_name"`
+		// RewardInstName is the instrument rewards are paid out in.
+		RewardInstName string `json:"reward_inst_name"`
+		// OutOfStock is true when the staking quota has been reached and new stakes are not accepted.
+		OutOfStock bool `json:"out_of_stock"`
+		// BlockUnstake is true when unstaking is temporarily blocked.
+		BlockUnstake bool `json:"block_unstake"`
+		// EstRewards is the estimated reward rate (e.g. 0.0523 for 5.23%).
+		EstRewards Decimal `json:"est_rewards"`
+		// AprY describes whether EstRewards is an APR or an APY.
+		AprY string `json:"apr_y"`
+		// MinStakeAmt is the minimum quantity which can be staked.
+		MinStakeAmt Decimal `json:"min_stake_amt"`
+		// MaxStakeAmt is the maximum quantity which can be staked, 0 if there isn't a limit.
+		MaxStakeAmt Decimal `json:"max_stake_amt"`
+		// RewardFrequency is the frequency at which rewards are paid out, in days.
+		RewardFrequency string `json:"reward_frequency"`
+		// LockUpPeriod is the lock-up period of unstaked funds, in days.
+		LockUpPeriod string `json:"lock_up_period"`
+		// IsCompoundReward is true when rewards are automatically restaked.
+		IsCompoundReward bool `json:"is_compound_reward"`
+		// PreStakeChargeEnable is true when a pre-stake charge applies.
+		PreStakeChargeEnable bool `json:"pre_stake_charge_enable"`
+		// PreStakeChargeRateInBps is the pre-stake charge rate in basis points.
+		PreStakeChargeRateInBps Decimal `json:"pre_stake_charge_rate_in_bps"`
+	}
+)
+
+// GetStakingInstruments returns the stakeable instruments along with their reward rates and lock-up terms.
+//
+// Method: private/staking/get-staking-instruments
+func (c *Client) GetStakingInstruments(ctx context.Context) ([]StakingInstrument, error) {
+	var (
+		id        = c.idGenerator.Generate()
+		timestamp = c.now().UnixMilli()
+		params    = make(map[string]interface{})
+	)
+
+	creds, err := c.credentials(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	signature, err := c.signatureGenerator.GenerateSignature(auth.SignatureRequest{
+		APIKey:    creds.APIKey,
+		SecretKey: creds.SecretKey,
+		ID:        id,
+		Method:    methodGetStakingInstruments,
+		Timestamp: timestamp,
+		Params:    params,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create signature: %w", err)
+	}
+
+	body := api.Request{
+		ID:        id,
+		Method:    methodGetStakingInstruments,
+		Nonce:     timestamp,
+		Params:    params,
+		Signature: signature,
+		APIKey:    creds.APIKey,
+	}
+
+	var getStakingInstrumentsResponse GetStakingInstrumentsResponse
+	statusCode, err := c.requester.Post(ctx, body, methodGetStakingInstruments, &getStakingInstrumentsResponse)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute post request: %w", err)
+	}
+
+	if err := c.requester.CheckResponse(statusCode, api.BaseResponse(getStakingInstrumentsResponse.BaseResponse)); err != nil {
+		return nil, fmt.Errorf("error received in response: %w", err)
+	}
+
+	return getStakingInstrumentsResponse.Result.Data, nil
+}
@@ -0,0 +1,151 @@
+package cdcexchange_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/jonboulle/clockwork"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	cdcexchange "github.com/sngyai/go-cryptocom/v2"
+	cdcerrors "github.com/sngyai/go-cryptocom/v2/errors"
+	"github.com/sngyai/go-cryptocom/v2/internal/api"
+	"github.com/sngyai/go-cryptocom/v2/internal/auth"
+	id_mocks "github.com/sngyai/go-cryptocom/v2/internal/mocks/id"
+	signature_mocks "github.com/sngyai/go-cryptocom/v2/internal/mocks/signature"
+)
+
+func TestClient_GetStakingInstruments_Error(t *testing.T) {
+	const (
+		apiKey    = "some api key"
+		secretKey = "some secret key"
+		id        = int64(1234)
+	)
+	now := time.Now()
+
+	ctrl, ctx := gomock.WithContext(context.Background(), t)
+	t.Cleanup(ctrl.Finish)
+
+	var (
+		idGenerator        = id_mocks.NewMockIDGenerator(ctrl)
+		signatureGenerator = signature_mocks.NewMockSignatureGenerator(ctrl)
+		clock              = clockwork.NewFakeClockAt(now)
+	)
+
+	client, err := cdcexchange.New(apiKey, secretKey,
+		cdcexchange.WithIDGenerator(idGenerator),
+		cdcexchange.WithClock(clock),
+		cdcexchange.WithHTTPClient(&http.Client{
+			Transport: roundTripper{
+				statusCode: http.StatusTeapot,
+				response: api.BaseResponse{
+					Code: "10002",
+				},
+			},
+		}),
+		cdcexchange.WithSignatureGenerator(signatureGenerator),
+	)
+	require.NoError(t, err)
+
+	idGenerator.EXPECT().Generate().Return(id)
+	signatureGenerator.EXPECT().GenerateSignature(gomock.Any()).Return("signature", nil)
+
+	instruments, err := client.GetStakingInstruments(ctx)
+	require.Error(t, err)
+
+	assert.Empty(t, instruments)
+	assert.True(t, errors.Is(err, cdcerrors.ErrUnauthorized))
+}
+
+func TestClient_GetStakingInstruments_Success(t *testing.T) {
+	const (
+		apiKey    = "some api key"
+		secretKey = "some secret key"
+		id        = int64(1234)
+		signature = "some signature"
+	)
+	now := time.Now()
+
+	ctrl, ctx := gomock.WithContext(context.Background(), t)
+	t.Cleanup(ctrl.Finish)
+
+	var (
+		signatureGenerator = signature_mocks.NewMockSignatureGenerator(ctrl)
+		idGenerator        = id_mocks.NewMockIDGenerator(ctrl)
+		clock              = clockwork.NewFakeClockAt(now)
+	)
+
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Contains(t, r.URL.Path, cdcexchange.MethodGetStakingInstruments)
+
+		_, err := w.Write([]byte(`{
+			"id": 1234,
+			"method": "private/staking/get-staking-instruments",
+			"code": 0,
+			"result": {
+				"data": [
+					{
+						"instrument_name": "SOL.staked",
+						"underlying_inst_name": "SOL",
+						"reward_inst_name": "SOL.staked",
+						"out_of_stock": false,
+						"block_unstake": false,
+						"est_rewards": "0.0523",
+						"apr_y": "APR",
+						"min_stake_amt": "0.00000001",
+						"reward_frequency": "2.5",
+						"lock_up_period": "5",
+						"is_compound_reward": true,
+						"pre_stake_charge_enable": false,
+						"pre_stake_charge_rate_in_bps": "0"
+					}
+				]
+			}
+		}`))
+		require.NoError(t, err)
+	}))
+	t.Cleanup(s.Close)
+
+	client, err := cdcexchange.New(apiKey, secretKey,
+		cdcexchange.WithIDGenerator(idGenerator),
+		cdcexchange.WithClock(clock),
+		cdcexchange.WithHTTPClient(s.Client()),
+		cdcexchange.WithBaseURL(fmt.Sprintf("%s/", s.URL)),
+		cdcexchange.WithSignatureGenerator(signatureGenerator),
+	)
+	require.NoError(t, err)
+
+	idGenerator.EXPECT().Generate().Return(id)
+	signatureGenerator.EXPECT().GenerateSignature(auth.SignatureRequest{
+		APIKey:    apiKey,
+		SecretKey: secretKey,
+		ID:        id,
+		Method:    cdcexchange.MethodGetStakingInstruments,
+		Timestamp: now.UnixMilli(),
+		Params:    map[string]interface{}{},
+	}).Return(signature, nil)
+
+	instruments, err := client.GetStakingInstruments(ctx)
+	require.NoError(t, err)
+
+	assert.Equal(t, []cdcexchange.StakingInstrument{
+		{
+			InstrumentName:     "SOL.staked",
+			UnderlyingInstName: "SOL",
+			RewardInstName:     "SOL.staked",
+			EstRewards:         cdcexchange.MustParseDecimal("0.0523"),
+			AprY:               "APR",
+			MinStakeAmt:        cdcexchange.MustParseDecimal("0.00000001"),
+			RewardFrequency:    "2.5",
+			LockUpPeriod:       "5",
+			IsCompoundReward:   true,
+		},
+	}, instruments)
+}
@@ -0,0 +1,101 @@
+package cdcexchange
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/sngyai/go-cryptocom/v2/internal/api"
+	"github.com/sngyai/go-cryptocom/v2/internal/auth"
+)
+
+const (
+	methodGetStakingPosition = "private/staking/get-staking-position"
+)
+
+type (
+	// GetStakingPositionResponse is the base response returned from the private/staking/get-staking-position API.
+	GetStakingPositionResponse struct {
+		// BaseResponse is the common response fields.
+		BaseResponse
+		// Result is the response attributes of the endpoint.
+		Result GetStakingPositionResult `json:"result"`
+	}
+
+	// GetStakingPositionResult is the result returned from the private/staking/get-staking-position API.
+	GetStakingPositionResult struct {
+		// Data is the list of staking positions.
+		Data []StakingPosition `json:"data"`
+	}
+
+	// StakingPosition represents the staking position of a specific instrument.
+	StakingPosition struct {
+		// InstrumentName is the staking instrument name (e.g. SOL.staked).
+		InstrumentName string `json:"instrument_name"`
+		// UnderlyingInstName is the underlying instrument name (e.g. SOL).
+		UnderlyingInstName string `json:"underlying_inst_name"`
+		// StakedQuantity is the total quantity currently staked.
+		StakedQuantity Decimal `json:"staked_quantity"`
+		// PendingStakedQuantity is the quantity waiting to be staked.
+		PendingStakedQuantity Decimal `json:"pending_staked_quantity"`
+		// PendingUnstakedQuantity is the quantity waiting to be unstaked.
+		PendingUnstakedQuantity Decimal `json:"pending_unstaked_quantity"`
+		// RewardEligibleQuantity is the quantity eligible for rewards.
+		RewardEligibleQuantity Decimal `json:"reward_eligible_quantity"`
+	}
+)
+
+// GetStakingPosition returns the staking positions of the user.
+//
+// instrumentName can be left blank to retrieve positions for ALL staking instruments.
+//
+// Method: private/staking/get-staking-position
+func (c *Client) GetStakingPosition(ctx context.Context, instrumentName string) ([]StakingPosition, error) {
+	var (
+		id        = c.idGenerator.Generate()
+		timestamp = c.now().UnixMilli()
+		params    = make(map[string]interface{})
+	)
+
+	creds, err := c.credentials(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// if instrumentName is omitted, ALL positions are returned.
+	if instrumentName != "" {
+		params["instrument_name"] = instrumentName
+	}
+
+	signature, err := c.signatureGenerator.GenerateSignature(auth.SignatureRequest{
+		APIKey:    creds.APIKey,
+		SecretKey: creds.SecretKey,
+		ID:        id,
+		Method:    methodGetStakingPosition,
+		Timestamp: timestamp,
+		Params:    params,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create signature: %w", err)
+	}
+
+	body := api.Request{
+		ID:        id,
+		Method:    methodGetStakingPosition,
+		Nonce:     timestamp,
+		Params:    params,
+		Signature: signature,
+		APIKey:    creds.APIKey,
+	}
+
+	var getStakingPositionResponse GetStakingPositionResponse
+	statusCode, err := c.requester.Post(ctx, body, methodGetStakingPosition, &getStakingPositionResponse)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute post request: %w", err)
+	}
+
+	if err := c.requester.CheckResponse(statusCode, api.BaseResponse(getStakingPositionResponse.BaseResponse)); err != nil {
+		return nil, fmt.Errorf("error received in response: %w", err)
+	}
+
+	return getStakingPositionResponse.Result.Data, nil
+}
@@ -0,0 +1,136 @@
+package cdcexchange_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/jonboulle/clockwork"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	cdcexchange "github.com/sngyai/go-cryptocom/v2"
+	"github.com/sngyai/go-cryptocom/v2/internal/api"
+	"github.com/sngyai/go-cryptocom/v2/internal/auth"
+	id_mocks "github.com/sngyai/go-cryptocom/v2/internal/mocks/id"
+	signature_mocks "github.com/sngyai/go-cryptocom/v2/internal/mocks/signature"
+)
+
+func TestClient_GetStakingPosition_Success(t *testing.T) {
+	const (
+		apiKey    = "some api key"
+		secretKey = "some secret key"
+		id        = int64(1234)
+		signature = "some signature"
+
+		instrumentName = "SOL.staked"
+	)
+	now := time.Now()
+
+	type args struct {
+		instrumentName string
+	}
+	tests := []struct {
+		name string
+		args
+		expectedParams map[string]interface{}
+	}{
+		{
+			name: "successfully gets staking positions for an instrument",
+			args: args{
+				instrumentName: instrumentName,
+			},
+			expectedParams: map[string]interface{}{
+				"instrument_name": instrumentName,
+			},
+		},
+		{
+			name:           "successfully gets staking positions for all instruments",
+			expectedParams: map[string]interface{}{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl, ctx := gomock.WithContext(context.Background(), t)
+			t.Cleanup(ctrl.Finish)
+
+			var (
+				signatureGenerator = signature_mocks.NewMockSignatureGenerator(ctrl)
+				idGenerator        = id_mocks.NewMockIDGenerator(ctrl)
+				clock              = clockwork.NewFakeClockAt(now)
+			)
+
+			s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Contains(t, r.URL.Path, cdcexchange.MethodGetStakingPosition)
+				t.Cleanup(func() { require.NoError(t, r.Body.Close()) })
+
+				var body api.Request
+				require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+
+				assert.Equal(t, cdcexchange.MethodGetStakingPosition, body.Method)
+				assert.Equal(t, id, body.ID)
+				assert.Equal(t, apiKey, body.APIKey)
+				assert.Equal(t, signature, body.Signature)
+				assert.Equal(t, len(tt.expectedParams), len(body.Params))
+
+				_, err := w.Write([]byte(`{
+					"id": 1234,
+					"method": "private/staking/get-staking-position",
+					"code": 0,
+					"result": {
+						"data": [
+							{
+								"instrument_name": "SOL.staked",
+								"underlying_inst_name": "SOL",
+								"staked_quantity": "30000.01",
+								"pending_staked_quantity": "20000.01",
+								"pending_unstaked_quantity": "10000.01",
+								"reward_eligible_quantity": "10000.01"
+							}
+						]
+					}
+				}`))
+				require.NoError(t, err)
+			}))
+			t.Cleanup(s.Close)
+
+			client, err := cdcexchange.New(apiKey, secretKey,
+				cdcexchange.WithIDGenerator(idGenerator),
+				cdcexchange.WithClock(clock),
+				cdcexchange.WithHTTPClient(s.Client()),
+				cdcexchange.WithBaseURL(fmt.Sprintf("%s/", s.URL)),
+				cdcexchange.WithSignatureGenerator(signatureGenerator),
+			)
+			require.NoError(t, err)
+
+			idGenerator.EXPECT().Generate().Return(id)
+			signatureGenerator.EXPECT().GenerateSignature(auth.SignatureRequest{
+				APIKey:    apiKey,
+				SecretKey: secretKey,
+				ID:        id,
+				Method:    cdcexchange.MethodGetStakingPosition,
+				Timestamp: now.UnixMilli(),
+				Params:    tt.expectedParams,
+			}).Return(signature, nil)
+
+			positions, err := client.GetStakingPosition(ctx, tt.instrumentName)
+			require.NoError(t, err)
+
+			assert.Equal(t, []cdcexchange.StakingPosition{
+				{
+					InstrumentName:          instrumentName,
+					UnderlyingInstName:      "SOL",
+					StakedQuantity:          cdcexchange.MustParseDecimal("30000.01"),
+					PendingStakedQuantity:   cdcexchange.MustParseDecimal("20000.01"),
+					PendingUnstakedQuantity: cdcexchange.MustParseDecimal("10000.01"),
+					RewardEligibleQuantity:  cdcexchange.MustParseDecimal("10000.01"),
+				},
+			}, positions)
+		})
+	}
+}
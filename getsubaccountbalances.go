@@ -0,0 +1,128 @@
+package cdcexchange
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/sngyai/go-cryptocom/v2/internal/api"
+	"github.com/sngyai/go-cryptocom/v2/internal/auth"
+)
+
+const (
+	methodGetSubAccountBalances = "private/get-subaccount-balances"
+)
+
+type (
+	// GetSubAccountBalancesResponse is the base response returned from the private/get-subaccount-balances API.
+	GetSubAccountBalancesResponse struct {
+		// BaseResponse is the common response fields.
+		BaseResponse
+		// Result is the response attributes of the endpoint.
+		Result GetSubAccountBalancesResult `json:"result"`
+	}
+
+	// GetSubAccountBalancesResult is the result returned from the private/get-subaccount-balances API.
+	GetSubAccountBalancesResult struct {
+		// Data is the list of balance summaries, one per sub-account.
+		Data []SubAccountBalance `json:"data"`
+	}
+
+	// SubAccountBalance represents the balance summary of a single sub-account.
+	SubAccountBalance struct {
+		// Account is the sub-account UUID.
+		Account string `json:"account"`
+		// InstrumentName is the currency the totals are denominated in (e.g. USD).
+		InstrumentName string `json:"instrument_name"`
+		// TotalAvailableBalance is the balance available to open new orders.
+		TotalAvailableBalance Decimal `json:"total_available_balance"`
+		// TotalMarginBalance is the balance including collateral and unrealised PnL.
+		TotalMarginBalance Decimal `json:"total_margin_balance"`
+		// TotalInitialMargin is the total margin requirement to support positions and open orders.
+		TotalInitialMargin Decimal `json:"total_initial_margin"`
+		// TotalMaintenanceMargin is the total maintenance margin requirement for positions.
+		TotalMaintenanceMargin Decimal `json:"total_maintenance_margin"`
+		// TotalPositionCost is the position cost or value in USD.
+		TotalPositionCost Decimal `json:"total_position_cost"`
+		// TotalCashBalance is the wallet balance (deposits - withdrawals + realised PnL - fees).
+		TotalCashBalance Decimal `json:"total_cash_balance"`
+		// TotalCollateralValue is the collateral value.
+		TotalCollateralValue Decimal `json:"total_collateral_value"`
+		// TotalSessionUnrealizedPNL is the current unrealised PnL from all open positions.
+		TotalSessionUnrealizedPNL Decimal `json:"total_session_unrealized_pnl"`
+		// TotalSessionRealizedPNL is the realised PnL of the current trading session.
+		TotalSessionRealizedPNL Decimal `json:"total_session_realized_pnl"`
+		// IsLiquidating describes whether the account is under liquidation.
+		IsLiquidating bool `json:"is_liquidating"`
+		// PositionBalances is the per-currency balance breakdown of the sub-account.
+		PositionBalances []PositionBalance `json:"position_balances"`
+	}
+
+	// PositionBalance represents the balance of a single currency within an account.
+	PositionBalance struct {
+		// InstrumentName is the currency symbol (e.g. CRO).
+		InstrumentName string `json:"instrument_name"`
+		// Quantity is the quantity of the currency held.
+		Quantity Decimal `json:"quantity"`
+		// ReservedQuantity is the quantity reserved for open orders.
+		ReservedQuantity Decimal `json:"reserved_qty"`
+		// MarketValue is the market value of the quantity held.
+		MarketValue Decimal `json:"market_value"`
+		// CollateralAmount is the collateral value of the quantity held.
+		CollateralAmount Decimal `json:"collateral_amount"`
+		// CollateralWeight is the share of MarketValue counted as collateral (i.e. 1 minus the haircut).
+		CollateralWeight Decimal `json:"collateral_weight"`
+		// HourlyInterestRate is the hourly interest rate charged on a negative balance.
+		HourlyInterestRate Decimal `json:"hourly_interest_rate"`
+		// MaxWithdrawalBalance is the maximum quantity which can be withdrawn.
+		MaxWithdrawalBalance Decimal `json:"max_withdrawal_balance"`
+	}
+)
+
+// GetSubAccountBalances returns the balance summaries of all sub-accounts in a single request.
+//
+// Method: private/get-subaccount-balances
+func (c *Client) GetSubAccountBalances(ctx context.Context) ([]SubAccountBalance, error) {
+	var (
+		id        = c.idGenerator.Generate()
+		timestamp = c.now().UnixMilli()
+		params    = make(map[string]interface{})
+	)
+
+	creds, err := c.credentials(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	signature, err := c.signatureGenerator.GenerateSignature(auth.SignatureRequest{
+		APIKey:    creds.APIKey,
+		SecretKey: creds.SecretKey,
+		ID:        id,
+		Method:    methodGetSubAccountBalances,
+		Timestamp: timestamp,
+		Params:    params,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create signature: %w", err)
+	}
+
+	body := api.Request{
+		ID:        id,
+		Method:    methodGetSubAccountBalances,
+		Nonce:     timestamp,
+		Params:    params,
+		Signature: signature,
+		APIKey:    creds.APIKey,
+	}
+
+	var getSubAccountBalancesResponse GetSubAccountBalancesResponse
+	statusCode, err := c.requester.Post(ctx, body, methodGetSubAccountBalances, &getSubAccountBalancesResponse)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute post request: %w", err)
+	}
+
+	if err := c.requester.CheckResponse(statusCode, api.BaseResponse(getSubAccountBalancesResponse.BaseResponse)); err != nil {
+		return nil, fmt.Errorf("error received in response: %w", err)
+	}
+
+	return getSubAccountBalancesResponse.Result.Data, nil
+}
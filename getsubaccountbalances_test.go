@@ -0,0 +1,218 @@
+package cdcexchange_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/jonboulle/clockwork"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	cdcexchange "github.com/sngyai/go-cryptocom/v2"
+	cdcerrors "github.com/sngyai/go-cryptocom/v2/errors"
+	"github.com/sngyai/go-cryptocom/v2/internal/api"
+	"github.com/sngyai/go-cryptocom/v2/internal/auth"
+	id_mocks "github.com/sngyai/go-cryptocom/v2/internal/mocks/id"
+	signature_mocks "github.com/sngyai/go-cryptocom/v2/internal/mocks/signature"
+)
+
+func TestClient_GetSubAccountBalances_Error(t *testing.T) {
+	const (
+		apiKey    = "some api key"
+		secretKey = "some secret key"
+		id        = int64(1234)
+	)
+	testErr := errors.New("some error")
+
+	tests := []struct {
+		name         string
+		client       http.Client
+		signatureErr error
+		expectedErr  error
+	}{
+		{
+			name:         "returns error given error generating signature",
+			signatureErr: testErr,
+			expectedErr:  testErr,
+		},
+		{
+			name: "returns error given error making request",
+			client: http.Client{
+				Transport: roundTripper{
+					err: testErr,
+				},
+			},
+			expectedErr: testErr,
+		},
+		{
+			name: "returns error given error response",
+			client: http.Client{
+				Transport: roundTripper{
+					statusCode: http.StatusTeapot,
+					response: api.BaseResponse{
+						Code: "10002",
+					},
+				},
+			},
+			expectedErr: cdcerrors.ResponseError{
+				Code:           10002,
+				HTTPStatusCode: http.StatusTeapot,
+				Err:            cdcerrors.ErrUnauthorized,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl, ctx := gomock.WithContext(context.Background(), t)
+			t.Cleanup(ctrl.Finish)
+
+			var (
+				idGenerator        = id_mocks.NewMockIDGenerator(ctrl)
+				signatureGenerator = signature_mocks.NewMockSignatureGenerator(ctrl)
+				now                = time.Now()
+				clock              = clockwork.NewFakeClockAt(now)
+			)
+
+			client, err := cdcexchange.New(apiKey, secretKey,
+				cdcexchange.WithIDGenerator(idGenerator),
+				cdcexchange.WithClock(clock),
+				cdcexchange.WithHTTPClient(&tt.client),
+				cdcexchange.WithSignatureGenerator(signatureGenerator),
+			)
+			require.NoError(t, err)
+
+			idGenerator.EXPECT().Generate().Return(id)
+			signatureGenerator.EXPECT().GenerateSignature(auth.SignatureRequest{
+				APIKey:    apiKey,
+				SecretKey: secretKey,
+				ID:        id,
+				Method:    cdcexchange.MethodGetSubAccountBalances,
+				Timestamp: now.UnixMilli(),
+				Params:    map[string]interface{}{},
+			}).Return("signature", tt.signatureErr)
+
+			balances, err := client.GetSubAccountBalances(ctx)
+			require.Error(t, err)
+
+			assert.Empty(t, balances)
+			assert.True(t, errors.Is(err, tt.expectedErr))
+		})
+	}
+}
+
+func TestClient_GetSubAccountBalances_Success(t *testing.T) {
+	const (
+		apiKey    = "some api key"
+		secretKey = "some secret key"
+		id        = int64(1234)
+		signature = "some signature"
+	)
+	now := time.Now()
+
+	ctrl, ctx := gomock.WithContext(context.Background(), t)
+	t.Cleanup(ctrl.Finish)
+
+	var (
+		signatureGenerator = signature_mocks.NewMockSignatureGenerator(ctrl)
+		idGenerator        = id_mocks.NewMockIDGenerator(ctrl)
+		clock              = clockwork.NewFakeClockAt(now)
+	)
+
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Contains(t, r.URL.Path, cdcexchange.MethodGetSubAccountBalances)
+		t.Cleanup(func() { require.NoError(t, r.Body.Close()) })
+
+		var body api.Request
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+
+		assert.Equal(t, cdcexchange.MethodGetSubAccountBalances, body.Method)
+		assert.Equal(t, id, body.ID)
+		assert.Equal(t, apiKey, body.APIKey)
+		assert.Equal(t, now.UnixMilli(), body.Nonce)
+		assert.Equal(t, signature, body.Signature)
+
+		_, err := w.Write([]byte(`{
+			"id": 1234,
+			"method": "private/get-subaccount-balances",
+			"code": 0,
+			"result": {
+				"data": [
+					{
+						"account": "a0d206a1-6b06-47c5-9cd3-8bc6ef0915c5",
+						"instrument_name": "USD",
+						"total_available_balance": "100.5",
+						"total_margin_balance": "101.5",
+						"total_initial_margin": "0",
+						"total_maintenance_margin": "0",
+						"total_position_cost": "0",
+						"total_cash_balance": "101.5",
+						"total_collateral_value": "101.5",
+						"total_session_unrealized_pnl": "0",
+						"total_session_realized_pnl": "0",
+						"is_liquidating": false,
+						"position_balances": [
+							{
+								"instrument_name": "CRO",
+								"quantity": "1000",
+								"market_value": "101.5",
+								"collateral_amount": "101.5",
+								"max_withdrawal_balance": "1000"
+							}
+						]
+					}
+				]
+			}
+		}`))
+		require.NoError(t, err)
+	}))
+	t.Cleanup(s.Close)
+
+	client, err := cdcexchange.New(apiKey, secretKey,
+		cdcexchange.WithIDGenerator(idGenerator),
+		cdcexchange.WithClock(clock),
+		cdcexchange.WithHTTPClient(s.Client()),
+		cdcexchange.WithBaseURL(fmt.Sprintf("%s/", s.URL)),
+		cdcexchange.WithSignatureGenerator(signatureGenerator),
+	)
+	require.NoError(t, err)
+
+	idGenerator.EXPECT().Generate().Return(id)
+	signatureGenerator.EXPECT().GenerateSignature(auth.SignatureRequest{
+		APIKey:    apiKey,
+		SecretKey: secretKey,
+		ID:        id,
+		Method:    cdcexchange.MethodGetSubAccountBalances,
+		Timestamp: now.UnixMilli(),
+		Params:    map[string]interface{}{},
+	}).Return(signature, nil)
+
+	balances, err := client.GetSubAccountBalances(ctx)
+	require.NoError(t, err)
+
+	assert.Equal(t, []cdcexchange.SubAccountBalance{
+		{
+			Account:               "a0d206a1-6b06-47c5-9cd3-8bc6ef0915c5",
+			InstrumentName:        "USD",
+			TotalAvailableBalance: cdcexchange.MustParseDecimal("100.5"),
+			TotalMarginBalance:    cdcexchange.MustParseDecimal("101.5"),
+			TotalCashBalance:      cdcexchange.MustParseDecimal("101.5"),
+			TotalCollateralValue:  cdcexchange.MustParseDecimal("101.5"),
+			PositionBalances: []cdcexchange.PositionBalance{
+				{
+					InstrumentName:       "CRO",
+					Quantity:             cdcexchange.MustParseDecimal("1000"),
+					MarketValue:          cdcexchange.MustParseDecimal("101.5"),
+					CollateralAmount:     cdcexchange.MustParseDecimal("101.5"),
+					MaxWithdrawalBalance: cdcexchange.MustParseDecimal("1000"),
+				},
+			},
+		},
+	}, balances)
+}
@@ -0,0 +1,101 @@
+package cdcexchange
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/sngyai/go-cryptocom/v2/internal/api"
+	"github.com/sngyai/go-cryptocom/v2/internal/auth"
+)
+
+const (
+	methodGetSubAccounts = "private/subaccount/get-sub-accounts"
+)
+
+type (
+	// GetSubAccountsResponse is the base response returned from the private/subaccount/get-sub-accounts API.
+	GetSubAccountsResponse struct {
+		// BaseResponse is the common response fields.
+		BaseResponse
+		// Result is the response attributes of the endpoint.
+		Result GetSubAccountsResult `json:"result"`
+	}
+
+	// GetSubAccountsResult is the result returned from the private/subaccount/get-sub-accounts API.
+	GetSubAccountsResult struct {
+		// SubAccountList is the list of sub-accounts.
+		SubAccountList []SubAccount `json:"sub_account_list"`
+	}
+
+	// SubAccount represents the details of a single sub-account.
+	SubAccount struct {
+		// UUID is the sub-account UUID.
+		UUID string `json:"uuid"`
+		// MasterAccountUUID is the UUID of the master account the sub-account belongs to.
+		MasterAccountUUID string `json:"master_account_uuid"`
+		// Label is the name given to the sub-account.
+		Label string `json:"label"`
+		// Email is the email address of the sub-account.
+		Email string `json:"email"`
+		// Enabled describes whether the sub-account is enabled.
+		Enabled bool `json:"enabled"`
+		// MarginAccess describes whether margin trading is enabled for the sub-account.
+		MarginAccess bool `json:"margin_access"`
+		// DerivativesAccess describes whether derivatives trading is enabled for the sub-account.
+		DerivativesAccess bool `json:"derivatives_access"`
+		// CreateTime is the time the sub-account was created.
+		CreateTime Timestamp `json:"create_time"`
+		// LastLoginTime is the last time the sub-account was logged into.
+		LastLoginTime Timestamp `json:"last_login_time"`
+	}
+)
+
+// GetSubAccounts returns all sub-accounts belonging to the master account.
+//
+// Method: private/subaccount/get-sub-accounts
+func (c *Client) GetSubAccounts(ctx context.Context) ([]SubAccount, error) {
+	var (
+		id        = c.idGenerator.Generate()
+		timestamp = c.now().UnixMilli()
+		params    = make(map[string]interface{})
+	)
+
+	creds, err := c.credentials(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	signature, err := c.signatureGenerator.GenerateSignature(auth.SignatureRequest{
+		APIKey:    creds.APIKey,
+		SecretKey: creds.SecretKey,
+		ID:        id,
+		Method:    methodGetSubAccounts,
+		Timestamp: timestamp,
+		Params:    params,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create signature: %w", err)
+	}
+
+	body := api.Request{
+		ID:        id,
+		Method:    methodGetSubAccounts,
+		Nonce:     timestamp,
+		Params:    params,
+		Signature: signature,
+		APIKey:    creds.APIKey,
+		Version:   api.V2,
+	}
+
+	var getSubAccountsResponse GetSubAccountsResponse
+	statusCode, err := c.requester.Post(ctx, body, methodGetSubAccounts, &getSubAccountsResponse)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute post request: %w", err)
+	}
+
+	if err := c.requester.CheckResponse(statusCode, api.BaseResponse(getSubAccountsResponse.BaseResponse)); err != nil {
+		return nil, fmt.Errorf("error received in response: %w", err)
+	}
+
+	return getSubAccountsResponse.Result.SubAccountList, nil
+}
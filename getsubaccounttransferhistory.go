@@ -0,0 +1,154 @@
+package cdcexchange
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/sngyai/go-cryptocom/v2/errors"
+	"github.com/sngyai/go-cryptocom/v2/internal/api"
+	"github.com/sngyai/go-cryptocom/v2/internal/auth"
+)
+
+const (
+	methodGetSubAccountTransferHistory = "private/subaccount/get-transfer-history"
+)
+
+type (
+	// GetSubAccountTransferHistoryRequest is the request params sent for the private/subaccount/get-transfer-history API.
+	GetSubAccountTransferHistoryRequest struct {
+		// SubAccount is the UUID of the sub-account to get transfers for.
+		// if SubAccount is omitted, transfers for all sub-accounts will be returned.
+		SubAccount string `json:"sub_account"`
+		// Direction filters the transfers by direction relative to the master account.
+		// if Direction is omitted, transfers in both directions will be returned.
+		Direction TransferDirection `json:"direction"`
+		// Currency represents the currency of the transfers (e.g. USDT).
+		// if Currency is omitted, transfers for all currencies will be returned.
+		Currency string `json:"currency"`
+		// Start is the start timestamp (milliseconds since the Unix epoch)
+		// (Default: 24 hours ago)
+		Start time.Time `json:"start_ts"`
+		// End is the end timestamp (milliseconds since the Unix epoch)
+		// (Default: now)
+		End time.Time `json:"end_ts"`
+		// PageRequest is the page size (Default: 20, Max: 200) and page number (0-based) for pagination.
+		PageRequest
+	}
+
+	// GetSubAccountTransferHistoryResponse is the base response returned from the private/subaccount/get-transfer-history API.
+	GetSubAccountTransferHistoryResponse struct {
+		// BaseResponse is the common response fields.
+		BaseResponse
+		// Result is the response attributes of the endpoint.
+		Result GetSubAccountTransferHistoryResult `json:"result"`
+	}
+
+	// GetSubAccountTransferHistoryResult is the result returned from the private/subaccount/get-transfer-history API.
+	GetSubAccountTransferHistoryResult struct {
+		// TransferList is the array of transfers.
+		TransferList []SubAccountTransfer `json:"transfer_list"`
+	}
+
+	// SubAccountTransfer represents a transfer between the master account and a sub-account.
+	SubAccountTransfer struct {
+		// Direction is the direction of the transfer relative to the master account.
+		Direction TransferDirection `json:"direction"`
+		// From is the account UUID funds were transferred from.
+		From string `json:"from"`
+		// To is the account UUID funds were transferred to.
+		To string `json:"to"`
+		// Currency is the currency transferred (e.g. USDT).
+		Currency string `json:"currency"`
+		// Amount is the amount transferred.
+		Amount Decimal `json:"amount"`
+		// Status is the status of the transfer (e.g. PROCESSING, COMPLETED, REJECTED).
+		Status string `json:"status"`
+		// Information is a description of the transfer (e.g. reason for rejection).
+		Information string `json:"information"`
+		// Time is the time the transfer was made.
+		Time Timestamp `json:"time"`
+	}
+)
+
+// GetSubAccountTransferHistory gets the history of transfers between the master account and sub-accounts.
+//
+// Pagination is handled using page size (Default: 20, Max: 200) & number (0-based).
+// If paging is used, enumerate each page (starting with 0) until an empty transfer_list array appears in the response.
+//
+// req.SubAccount, req.Direction and req.Currency can be left blank to get all transfers.
+//
+// Method: private/subaccount/get-transfer-history
+func (c *Client) GetSubAccountTransferHistory(ctx context.Context, req GetSubAccountTransferHistoryRequest) ([]SubAccountTransfer, error) {
+	if err := req.PageRequest.validate(); err != nil {
+		return nil, err
+	}
+
+	switch req.Direction {
+	case "", TransferDirectionIn, TransferDirectionOut:
+	default:
+		return nil, errors.InvalidParameterError{Parameter: "req.Direction", Reason: "must be IN or OUT"}
+	}
+
+	var (
+		id        = c.idGenerator.Generate()
+		timestamp = c.now().UnixMilli()
+		params    = make(map[string]interface{})
+	)
+
+	creds, err := c.credentials(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.SubAccount != "" {
+		params["sub_account"] = req.SubAccount
+	}
+	if req.Direction != "" {
+		params["direction"] = req.Direction
+	}
+	if req.Currency != "" {
+		params["currency"] = req.Currency
+	}
+	if !req.Start.IsZero() {
+		params["start_ts"] = req.Start.UnixMilli()
+	}
+	if !req.End.IsZero() {
+		params["end_ts"] = req.End.UnixMilli()
+	}
+	req.PageRequest.setParams(params)
+
+	signature, err := c.signatureGenerator.GenerateSignature(auth.SignatureRequest{
+		APIKey:    creds.APIKey,
+		SecretKey: creds.SecretKey,
+		ID:        id,
+		Method:    methodGetSubAccountTransferHistory,
+		Timestamp: timestamp,
+		Params:    params,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create signature: %w", err)
+	}
+
+	body := api.Request{
+		ID:        id,
+		Method:    methodGetSubAccountTransferHistory,
+		Nonce:     timestamp,
+		Params:    params,
+		Signature: signature,
+		APIKey:    creds.APIKey,
+		Version:   api.V2,
+	}
+
+	var getSubAccountTransferHistoryResponse GetSubAccountTransferHistoryResponse
+	statusCode, err := c.requester.Post(ctx, body, methodGetSubAccountTransferHistory, &getSubAccountTransferHistoryResponse)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute post request: %w", err)
+	}
+
+	if err := c.requester.CheckResponse(statusCode, api.BaseResponse(getSubAccountTransferHistoryResponse.BaseResponse)); err != nil {
+		return nil, fmt.Errorf("error received in response: %w", err)
+	}
+
+	return getSubAccountTransferHistoryResponse.Result.TransferList, nil
+}
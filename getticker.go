@@ -4,11 +4,10 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
-	"io/ioutil"
 	"net/http"
 
-	"github.com/sngyai/go-cryptocom/internal/api"
-	"github.com/sngyai/go-cryptocom/internal/time"
+	"github.com/sngyai/go-cryptocom/v2/errors"
+	"github.com/sngyai/go-cryptocom/v2/internal/api"
 )
 
 const (
@@ -19,8 +18,8 @@ type (
 	// TickerResponse is the base response returned from the public/get-ticker API.
 	// when no instrument is specified.
 	TickerResponse struct {
-		// api.BaseResponse is the common response fields.
-		api.BaseResponse
+		// BaseResponse is the common response fields.
+		BaseResponse
 		// Result is the response attributes of the endpoint.
 		Result TickerResult `json:"result"`
 	}
@@ -31,26 +30,35 @@ type (
 		Data []Ticker `json:"data"`
 	}
 
-	// Ticker represents ticker details of a specific currency pair.
+	// Ticker represents ticker details of a specific instrument.
+	//
+	// The v1 API covers both spot pairs and derivatives, so the full v1 field set is returned
+	// (e.g. OpenInterest for perpetuals and futures).
+	//
+	// Prices and volumes returned as null or "" (e.g. the bid and ask prices of illiquid instruments) are decoded as 0.
 	Ticker struct {
 		// Instrument is the instrument name (e.g. BTC_USDT, ETH_CRO, etc).
 		Instrument string `json:"i"`
 		// BidPrice is the current best bid price, 0 if there aren't any bids.
-		BidPrice float64 `json:"b,string"`
+		BidPrice Decimal `json:"b"`
 		// AskPrice is the current best ask price, 0 if there aren't any asks.
-		AskPrice float64 `json:"k,string"`
+		AskPrice Decimal `json:"k"`
 		// LatestTradePrice is the price of the latest trade, 0 if there weren't any trades.
-		LatestTradePrice float64 `json:"a,string"`
+		LatestTradePrice Decimal `json:"a"`
 		// Timestamp is the timestamp of the data.
-		Timestamp time.Time `json:"t"`
+		Timestamp Timestamp `json:"t"`
 		// Volume24H is the total 24h traded volume.
-		Volume24H float64 `json:"v,string"`
+		Volume24H Decimal `json:"v"`
+		// VolumeValue24H is the total 24h traded volume value (in USD).
+		VolumeValue24H Decimal `json:"vv"`
+		// OpenInterest is the open interest of the instrument, 0 for instruments without open interest (e.g. spot pairs).
+		OpenInterest Decimal `json:"oi"`
 		// PriceHigh24h is the price of the 24h highest trade, 0 if there weren't any trades.
-		PriceHigh24h float64 `json:"h,string"`
+		PriceHigh24h Decimal `json:"h"`
 		// PriceLow24h is the price of the 24h lowest trade, 0 if there weren't any trades.
-		PriceLow24h float64 `json:"l,string"`
+		PriceLow24h Decimal `json:"l"`
 		// PriceChange24h is the 24-hour price change, 0 if there weren't any trades.
-		PriceChange24h float64 `json:"c,string"`
+		PriceChange24h Decimal `json:"c"`
 	}
 )
 
@@ -60,7 +68,7 @@ type (
 //
 // Method: public/get-ticker
 func (c *Client) GetTickers(ctx context.Context, instrument string) ([]Ticker, error) {
-	req, err := http.NewRequestWithContext(ctx, http.MethodGet, fmt.Sprintf("%s%s%s", c.requester.BaseURL, api.V1, methodGetTicker), nil)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.requester.URL(methodGetTicker, ""), nil)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create request: %w", err)
 	}
@@ -73,33 +81,44 @@ func (c *Client) GetTickers(ctx context.Context, instrument string) ([]Ticker, e
 		req.URL.RawQuery = q.Encode()
 	}
 
-	res, err := c.requester.Client.Do(req)
+	statusCode, resBytes, err := c.requester.Do(ctx, req, methodGetTicker)
 	if err != nil {
-		return nil, fmt.Errorf("failed to do request: %w", err)
+		return nil, err
 	}
-	defer res.Body.Close()
-
-	resBytes, err := ioutil.ReadAll(res.Body)
-	if err != nil {
-		return nil, fmt.Errorf("failed to read response body: %w", err)
-	}
-
-	var (
-		tickers []Ticker
-		code    json.Number
-	)
 
 	var tickerResponse TickerResponse
 	if err := json.Unmarshal(resBytes, &tickerResponse); err != nil {
 		return nil, fmt.Errorf("failed to unmarshal response body: %w", err)
 	}
+	tickerResponse.SetRaw(resBytes)
 
-	tickers = tickerResponse.Result.Data
-	code = tickerResponse.Code
-
-	if err := c.requester.CheckErrorResponse(res.StatusCode, code); err != nil {
+	if err := c.requester.CheckResponse(statusCode, api.BaseResponse(tickerResponse.BaseResponse)); err != nil {
 		return nil, fmt.Errorf("error received in response: %w", err)
 	}
 
-	return tickers, nil
+	return tickerResponse.Result.Data, nil
+}
+
+// GetTicker fetches the public ticker of a single instrument (e.g. BTC_USDT).
+//
+// errors.TickerNotFoundError is returned if no ticker is returned for the instrument.
+//
+// Method: public/get-ticker
+func (c *Client) GetTicker(ctx context.Context, instrument string) (*Ticker, error) {
+	if instrument == "" {
+		return nil, errors.InvalidParameterError{Parameter: "instrument", Reason: "cannot be empty"}
+	}
+
+	tickers, err := c.GetTickers(ctx, instrument)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range tickers {
+		if tickers[i].Instrument == instrument {
+			return &tickers[i], nil
+		}
+	}
+
+	return nil, errors.TickerNotFoundError{Instrument: instrument}
 }
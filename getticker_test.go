@@ -15,10 +15,9 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
-	"github.com/sngyai/go-cryptocom/internal/api"
-	cdcexchange "github.com/sngyai/go-cryptocom"
-	cdcerrors "github.com/sngyai/go-cryptocom/errors"
-	cdctime "github.com/sngyai/go-cryptocom/internal/time"
+	"github.com/sngyai/go-cryptocom/v2/internal/api"
+	cdcexchange "github.com/sngyai/go-cryptocom/v2"
+	cdcerrors "github.com/sngyai/go-cryptocom/v2/errors"
 )
 
 func TestClient_GetTickers_Error(t *testing.T) {
@@ -135,10 +134,19 @@ func TestClient_GetTickers_Success(t *testing.T) {
 							"method":"",
 							"code":0,
 							"result":{
-								"data": {
+								"data": [{
 									"i": "%s",
+									"h": "19600.11",
+									"l": "18000.00",
+									"a": "19600.11",
+									"v": "0.0019",
+									"vv": "36.85",
+									"oi": "1234.5",
+									"c": "0.0889",
+									"b": "19600.10",
+									"k": "19600.12",
 									"t": %d
-								}
+								}]
 							}
 						}`, instrument, now.UnixMilli())
 
@@ -146,8 +154,17 @@ func TestClient_GetTickers_Success(t *testing.T) {
 				require.NoError(t, err)
 			},
 			expectedResult: []cdcexchange.Ticker{{
-				Instrument: instrument,
-				Timestamp:  cdctime.Time(now),
+				Instrument:       instrument,
+				BidPrice:         cdcexchange.MustParseDecimal("19600.10"),
+				AskPrice:         cdcexchange.MustParseDecimal("19600.12"),
+				LatestTradePrice: cdcexchange.MustParseDecimal("19600.11"),
+				Timestamp:        cdcexchange.Timestamp(now),
+				Volume24H:        cdcexchange.MustParseDecimal("0.0019"),
+				VolumeValue24H:   cdcexchange.MustParseDecimal("36.85"),
+				OpenInterest:     cdcexchange.MustParseDecimal("1234.5"),
+				PriceHigh24h:     cdcexchange.MustParseDecimal("19600.11"),
+				PriceLow24h:      cdcexchange.MustParseDecimal("18000.00"),
+				PriceChange24h:   cdcexchange.MustParseDecimal("0.0889"),
 			}},
 		},
 		{
@@ -181,7 +198,7 @@ func TestClient_GetTickers_Success(t *testing.T) {
 			},
 			expectedResult: []cdcexchange.Ticker{{
 				Instrument: instrument,
-				Timestamp:  cdctime.Time(now),
+				Timestamp:  cdcexchange.Timestamp(now),
 			}},
 		},
 	}
@@ -216,6 +233,100 @@ func TestClient_GetTickers(t *testing.T) {
 	s := `{"id":-1,"method":"public/get-tickers","code":0,"result":{"data":[{"i":"BTC_USDT","h":"19600.11","l":"18000.00","a":"19600.11","v":"0.0019","vv":"36.85","c":"0.0889","b":null,"k":null,"t":1668066540018}]}}`
 	var ticker cdcexchange.TickerResponse
 	err := json.Unmarshal([]byte(s), &ticker)
-	assert.Nil(t, err)
-	fmt.Printf("unmarshal succeed: %v", ticker)
+	require.NoError(t, err)
+
+	// illiquid instruments are returned with null bid and ask prices, which are decoded as 0.
+	assert.Equal(t, []cdcexchange.Ticker{{
+		Instrument:       "BTC_USDT",
+		LatestTradePrice: cdcexchange.MustParseDecimal("19600.11"),
+		Timestamp:        cdcexchange.Timestamp(time.UnixMilli(1668066540018)),
+		Volume24H:        cdcexchange.MustParseDecimal("0.0019"),
+		VolumeValue24H:   cdcexchange.MustParseDecimal("36.85"),
+		PriceHigh24h:     cdcexchange.MustParseDecimal("19600.11"),
+		PriceLow24h:      cdcexchange.MustParseDecimal("18000"),
+		PriceChange24h:   cdcexchange.MustParseDecimal("0.0889"),
+	}}, ticker.Result.Data)
+	assert.True(t, ticker.Result.Data[0].BidPrice.IsZero())
+	assert.True(t, ticker.Result.Data[0].AskPrice.IsZero())
+}
+
+func TestTicker_UnmarshalJSON_EmptyFields(t *testing.T) {
+	s := `{"i":"CRO_USDT","h":"","l":"","a":"","v":"0","vv":"0","oi":null,"c":null,"b":"","k":"","t":1668066540018}`
+
+	var ticker cdcexchange.Ticker
+	require.NoError(t, json.Unmarshal([]byte(s), &ticker))
+
+	assert.Equal(t, cdcexchange.Ticker{
+		Instrument: "CRO_USDT",
+		Timestamp:  cdcexchange.Timestamp(time.UnixMilli(1668066540018)),
+	}, ticker)
+}
+
+func TestClient_GetTicker(t *testing.T) {
+	tests := []struct {
+		name           string
+		instrument     string
+		data           string
+		expectedTicker *cdcexchange.Ticker
+		expectedErr    error
+	}{
+		{
+			name:       "returns the ticker of the instrument",
+			instrument: "BTC_USDT",
+			data:       `{"i":"BTC_USDT","a":"19600.11","b":"19600.1","k":"19600.2","t":1668066540018}`,
+			expectedTicker: &cdcexchange.Ticker{
+				Instrument:       "BTC_USDT",
+				BidPrice:         cdcexchange.MustParseDecimal("19600.1"),
+				AskPrice:         cdcexchange.MustParseDecimal("19600.2"),
+				LatestTradePrice: cdcexchange.MustParseDecimal("19600.11"),
+				Timestamp:        cdcexchange.Timestamp(time.UnixMilli(1668066540018)),
+			},
+		},
+		{
+			name:        "returns error when no ticker is returned",
+			instrument:  "BTC_USDT",
+			expectedErr: cdcerrors.TickerNotFoundError{Instrument: "BTC_USDT"},
+		},
+		{
+			name:        "returns error when the ticker of another instrument is returned",
+			instrument:  "BTC_USDT",
+			data:        `{"i":"ETH_USDT","a":"1200"}`,
+			expectedErr: cdcerrors.TickerNotFoundError{Instrument: "BTC_USDT"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Contains(t, r.URL.Path, cdcexchange.MethodGetTicker)
+				assert.Equal(t, tt.instrument, r.URL.Query().Get("instrument_name"))
+
+				_, err := fmt.Fprintf(w, `{"id":-1,"method":"public/get-tickers","code":0,"result":{"data":[%s]}}`, tt.data)
+				require.NoError(t, err)
+			}))
+			t.Cleanup(s.Close)
+
+			client, err := cdcexchange.New("api key", "secret key",
+				cdcexchange.WithHTTPClient(s.Client()),
+				cdcexchange.WithBaseURL(fmt.Sprintf("%s/", s.URL)),
+			)
+			require.NoError(t, err)
+
+			ticker, err := client.GetTicker(context.Background(), tt.instrument)
+			if tt.expectedErr != nil {
+				assert.Equal(t, tt.expectedErr, err)
+				assert.True(t, errors.Is(err, cdcerrors.ErrTickerNotFound))
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedTicker, ticker)
+		})
+	}
+
+	t.Run("returns error when instrument is empty", func(t *testing.T) {
+		client, err := cdcexchange.New("api key", "secret key")
+		require.NoError(t, err)
+
+		_, err = client.GetTicker(context.Background(), "")
+		assert.Equal(t, cdcerrors.InvalidParameterError{Parameter: "instrument", Reason: "cannot be empty"}, err)
+	})
 }
@@ -5,9 +5,8 @@ import (
 	"fmt"
 	"time"
 
-	"github.com/sngyai/go-cryptocom/errors"
-	"github.com/sngyai/go-cryptocom/internal/api"
-	"github.com/sngyai/go-cryptocom/internal/auth"
+	"github.com/sngyai/go-cryptocom/v2/internal/api"
+	"github.com/sngyai/go-cryptocom/v2/internal/auth"
 )
 
 const (
@@ -17,12 +16,11 @@ const (
 type (
 	// GetTradesRequest is the request params sent for the private/get-trades API.
 	//
-	// The maximum duration between Start and End is 24 hours.
+	// The maximum duration between Start and End of a single request is 24 hours.
 	//
-	// You will receive an INVALID_DATE_RANGE error if the difference exceeds the maximum duration.
-	//
-	// For users looking to pull longer historical trade data, users can create a loop to make a request
-	// for each 24-period from the desired start to end time.
+	// If both Start and End are provided and the difference exceeds the maximum duration, the range is split
+	// into consecutive 24 hour windows, every page of each window is fetched and the results are merged (newest first).
+	// Pages of PageSize trades are fetched (Default: 200), and Page is ignored.
 	GetTradesRequest struct {
 		// InstrumentName represents the currency pair for the trades (e.g. ETH_CRO or BTC_USDT).
 		// if InstrumentName is omitted, all instruments will be returned.
@@ -33,19 +31,14 @@ type (
 		// End is the end timestamp (milliseconds since the Unix epoch)
 		// (Default: now)
 		End time.Time `json:"end_ts"`
-		// PageSize represents maximum number of trades returned (for pagination)
-		// (Default: 20, Max: 200)
-		// if PageSize is 0, it will be set as 20 by default.
-		PageSize int `json:"page_size"`
-		// Page represents the page number (for pagination)
-		// (0-based)
-		Page int `json:"page"`
+		// PageRequest is the page size (Default: 20, Max: 200) and page number (0-based) for pagination.
+		PageRequest
 	}
 
 	// GetTradesResponse is the base response returned from the private/get-trades API.
 	GetTradesResponse struct {
-		// api.BaseResponse is the common response fields.
-		api.BaseResponse
+		// BaseResponse is the common response fields.
+		BaseResponse
 		// Result is the response attributes of the endpoint.
 		Result GetTradesResult `json:"result"`
 	}
@@ -64,38 +57,78 @@ type (
 //
 // req.Timeframe can be left blank to get executed trades for all instruments.
 //
+// Ranges of more than 24 hours between req.Start and req.End are split into 24 hour windows, and every page of
+// each window is fetched (see TradesIterator).
+//
 // Method: private/get-trades
 func (c *Client) GetTrades(ctx context.Context, req GetTradesRequest) ([]Trade, error) {
-	if req.PageSize < 0 {
-		return nil, errors.InvalidParameterError{Parameter: "req.Limit", Reason: "cannot be less than 0"}
+	if err := req.PageRequest.validate(); err != nil {
+		return nil, err
+	}
+
+	if req.Start.IsZero() || req.End.IsZero() || req.End.Sub(req.Start) <= MaxHistoryWindow {
+		return c.getTrades(ctx, req)
+	}
+
+	it, err := c.TradesIterator(req)
+	if err != nil {
+		return nil, err
+	}
+
+	return it.all(ctx)
+}
+
+// TradesIterator returns an Iterator over the trades matching req, which walks every page of
+// every 24 hour window between req.Start and req.End (newest first) until no trades are left.
+//
+// Pages of req.PageSize trades are fetched (Default: 200), and req.Page is ignored.
+func (c *Client) TradesIterator(req GetTradesRequest) (*Iterator[Trade], error) {
+	if err := req.PageRequest.validate(); err != nil {
+		return nil, err
 	}
-	if req.PageSize > 200 {
-		return nil, errors.InvalidParameterError{Parameter: "req.Limit", Reason: "cannot be greater than 200"}
+
+	pageSize, _ := req.PageSize.Get()
+	if pageSize == 0 {
+		pageSize = maxPageSize
 	}
 
+	return newIterator(historyWindows(req.Start, req.End), pageSize, func(ctx context.Context, window TimeWindow, page PageRequest) ([]Trade, error) {
+		windowReq := req
+		windowReq.Start = window.Start
+		windowReq.End = window.End
+		windowReq.PageRequest = page
+
+		return c.getTrades(ctx, windowReq)
+	}), nil
+}
+
+// getTrades makes a single private/get-trades request for the timeframe of req.
+func (c *Client) getTrades(ctx context.Context, req GetTradesRequest) ([]Trade, error) {
 	var (
 		id        = c.idGenerator.Generate()
-		timestamp = c.clock.Now().UnixMilli()
+		timestamp = c.now().UnixMilli()
 		params    = make(map[string]interface{})
 	)
 
+	creds, err := c.credentials(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	if req.InstrumentName != "" {
 		params["instrument_name"] = req.InstrumentName
 	}
-	if req.PageSize != 0 {
-		params["page_size"] = req.PageSize
-	}
+	req.PageRequest.setParams(params)
 	if !req.Start.IsZero() {
 		params["start_ts"] = req.Start.UnixMilli()
 	}
 	if !req.End.IsZero() {
 		params["end_ts"] = req.End.UnixMilli()
 	}
-	params["page"] = req.Page
 
 	signature, err := c.signatureGenerator.GenerateSignature(auth.SignatureRequest{
-		APIKey:    c.apiKey,
-		SecretKey: c.secretKey,
+		APIKey:    creds.APIKey,
+		SecretKey: creds.SecretKey,
 		ID:        id,
 		Method:    methodGetTrades,
 		Timestamp: timestamp,
@@ -111,7 +144,7 @@ func (c *Client) GetTrades(ctx context.Context, req GetTradesRequest) ([]Trade,
 		Nonce:     timestamp,
 		Params:    params,
 		Signature: signature,
-		APIKey:    c.apiKey,
+		APIKey:    creds.APIKey,
 	}
 
 	var getTradesResponse GetTradesResponse
@@ -120,7 +153,7 @@ func (c *Client) GetTrades(ctx context.Context, req GetTradesRequest) ([]Trade,
 		return nil, fmt.Errorf("failed to execute post request: %w", err)
 	}
 
-	if err := c.requester.CheckErrorResponse(statusCode, getTradesResponse.Code); err != nil {
+	if err := c.requester.CheckResponse(statusCode, api.BaseResponse(getTradesResponse.BaseResponse)); err != nil {
 		return nil, fmt.Errorf("error received in response: %w", err)
 	}
 
@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 	"time"
 
@@ -15,13 +16,12 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
-	cdcexchange "github.com/sngyai/go-cryptocom"
-	cdcerrors "github.com/sngyai/go-cryptocom/errors"
-	"github.com/sngyai/go-cryptocom/internal/api"
-	"github.com/sngyai/go-cryptocom/internal/auth"
-	id_mocks "github.com/sngyai/go-cryptocom/internal/mocks/id"
-	signature_mocks "github.com/sngyai/go-cryptocom/internal/mocks/signature"
-	cdctime "github.com/sngyai/go-cryptocom/internal/time"
+	cdcexchange "github.com/sngyai/go-cryptocom/v2"
+	cdcerrors "github.com/sngyai/go-cryptocom/v2/errors"
+	"github.com/sngyai/go-cryptocom/v2/internal/api"
+	"github.com/sngyai/go-cryptocom/v2/internal/auth"
+	id_mocks "github.com/sngyai/go-cryptocom/v2/internal/mocks/id"
+	signature_mocks "github.com/sngyai/go-cryptocom/v2/internal/mocks/signature"
 )
 
 func TestClient_GetTrades_Error(t *testing.T) {
@@ -47,11 +47,11 @@ func TestClient_GetTrades_Error(t *testing.T) {
 			name: "returns error when page size is less than 0",
 			args: args{
 				req: cdcexchange.GetTradesRequest{
-					PageSize: -1,
+					PageRequest: cdcexchange.PageRequest{PageSize: cdcexchange.Some(-1)},
 				},
 			},
 			expectedErr: cdcerrors.InvalidParameterError{
-				Parameter: "req.Limit",
+				Parameter: "req.PageSize",
 				Reason:    "cannot be less than 0",
 			},
 		},
@@ -59,11 +59,11 @@ func TestClient_GetTrades_Error(t *testing.T) {
 			name: "returns error when page size is greater than 200",
 			args: args{
 				req: cdcexchange.GetTradesRequest{
-					PageSize: 201,
+					PageRequest: cdcexchange.PageRequest{PageSize: cdcexchange.Some(201)},
 				},
 			},
 			expectedErr: cdcerrors.InvalidParameterError{
-				Parameter: "req.Limit",
+				Parameter: "req.PageSize",
 				Reason:    "cannot be greater than 200",
 			},
 		},
@@ -119,7 +119,7 @@ func TestClient_GetTrades_Error(t *testing.T) {
 			)
 			require.NoError(t, err)
 
-			if tt.req.PageSize >= 0 && tt.req.PageSize < 200 {
+			if pageSize, _ := tt.req.PageSize.Get(); pageSize >= 0 && pageSize < 200 {
 				idGenerator.EXPECT().Generate().Return(id)
 				signatureGenerator.EXPECT().GenerateSignature(auth.SignatureRequest{
 					APIKey:    apiKey,
@@ -127,7 +127,7 @@ func TestClient_GetTrades_Error(t *testing.T) {
 					ID:        id,
 					Method:    cdcexchange.MethodGetTrades,
 					Timestamp: now.UnixMilli(),
-					Params:    map[string]interface{}{"page": 0},
+					Params:    map[string]interface{}{},
 				}).Return("signature", tt.signatureErr)
 			}
 
@@ -180,8 +180,7 @@ func TestClient_GetTrades_Success(t *testing.T) {
 			args: args{
 				req: cdcexchange.GetTradesRequest{
 					InstrumentName: instrument,
-					PageSize:       100,
-					Page:           1,
+					PageRequest:    cdcexchange.PageRequest{PageSize: cdcexchange.Some(100), Page: cdcexchange.Some(1)},
 				},
 			},
 			handlerFunc: func(w http.ResponseWriter, r *http.Request) {
@@ -233,11 +232,11 @@ func TestClient_GetTrades_Success(t *testing.T) {
 				{
 					Side:           cdcexchange.OrderSideSell,
 					InstrumentName: "ETH_CRO",
-					Fee:            0.014,
+					Fee:            cdcexchange.MustParseDecimal("0.014"),
 					TradeID:        "367107655537806900",
-					CreateTime:     cdctime.Time(now),
-					TradedPrice:    7,
-					TradedQuantity: 1,
+					CreateTime:     cdcexchange.Timestamp(now),
+					TradedPrice:    cdcexchange.MustParseDecimal("7"),
+					TradedQuantity: cdcexchange.MustParseDecimal("1"),
 					FeeCurrency:    "CRO",
 					OrderID:        "367107623521528450",
 				},
@@ -260,7 +259,7 @@ func TestClient_GetTrades_Success(t *testing.T) {
 				assert.Equal(t, apiKey, body.APIKey)
 				assert.Equal(t, now.UnixMilli(), body.Nonce)
 				assert.Equal(t, signature, body.Signature)
-				assert.Equal(t, float64(0), body.Params["page"])
+				assert.NotContains(t, body.Params, "page")
 
 				res := fmt.Sprintf(`{
 							"id": 0,
@@ -286,18 +285,16 @@ func TestClient_GetTrades_Success(t *testing.T) {
 				_, err := w.Write([]byte(res))
 				require.NoError(t, err)
 			},
-			expectedParams: map[string]interface{}{
-				"page": 0,
-			},
+			expectedParams: map[string]interface{}{},
 			expectedResult: []cdcexchange.Trade{
 				{
 					Side:           cdcexchange.OrderSideSell,
 					InstrumentName: "ETH_CRO",
-					Fee:            0.014,
+					Fee:            cdcexchange.MustParseDecimal("0.014"),
 					TradeID:        "367107655537806900",
-					CreateTime:     cdctime.Time(now),
-					TradedPrice:    7,
-					TradedQuantity: 1,
+					CreateTime:     cdcexchange.Timestamp(now),
+					TradedPrice:    cdcexchange.MustParseDecimal("7"),
+					TradedQuantity: cdcexchange.MustParseDecimal("1"),
 					FeeCurrency:    "CRO",
 					OrderID:        "367107623521528450",
 				},
@@ -307,10 +304,9 @@ func TestClient_GetTrades_Success(t *testing.T) {
 			name: "successfully gets all trades between timestamps",
 			args: args{
 				req: cdcexchange.GetTradesRequest{
-					Start:    now,
-					End:      now.Add(time.Hour),
-					PageSize: 1,
-					Page:     2,
+					Start:       now,
+					End:         now.Add(time.Hour),
+					PageRequest: cdcexchange.PageRequest{PageSize: cdcexchange.Some(1), Page: cdcexchange.Some(2)},
 				},
 			},
 			handlerFunc: func(w http.ResponseWriter, r *http.Request) {
@@ -364,11 +360,11 @@ func TestClient_GetTrades_Success(t *testing.T) {
 				{
 					Side:           cdcexchange.OrderSideSell,
 					InstrumentName: "ETH_CRO",
-					Fee:            0.014,
+					Fee:            cdcexchange.MustParseDecimal("0.014"),
 					TradeID:        "367107655537806900",
-					CreateTime:     cdctime.Time(now),
-					TradedPrice:    7,
-					TradedQuantity: 1,
+					CreateTime:     cdcexchange.Timestamp(now),
+					TradedPrice:    cdcexchange.MustParseDecimal("7"),
+					TradedQuantity: cdcexchange.MustParseDecimal("1"),
 					FeeCurrency:    "CRO",
 					OrderID:        "367107623521528450",
 				},
@@ -415,3 +411,190 @@ func TestClient_GetTrades_Success(t *testing.T) {
 		})
 	}
 }
+
+func TestClient_GetTrades_SplitsLongRange(t *testing.T) {
+	const (
+		apiKey    = "some api key"
+		secretKey = "some secret key"
+	)
+	var (
+		end   = time.Now().Round(time.Second)
+		start = end.Add(-50 * time.Hour)
+
+		windows [][2]int64
+	)
+
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Contains(t, r.URL.Path, cdcexchange.MethodGetTrades)
+		t.Cleanup(func() { require.NoError(t, r.Body.Close()) })
+
+		var body api.Request
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+
+		assert.Equal(t, float64(100), body.Params["page_size"])
+
+		windowStart, windowEnd := int64(body.Params["start_ts"].(float64)), int64(body.Params["end_ts"].(float64))
+		windows = append(windows, [2]int64{windowStart, windowEnd})
+
+		res := fmt.Sprintf(`{
+					"id": 0,
+					"method":"",
+					"code":0,
+					"result":{
+						"trade_list":[{"trade_id": "%d"}]
+					}
+				}`, len(windows))
+
+		_, err := w.Write([]byte(res))
+		require.NoError(t, err)
+	}))
+	t.Cleanup(s.Close)
+
+	client, err := cdcexchange.New(apiKey, secretKey,
+		cdcexchange.WithHTTPClient(s.Client()),
+		cdcexchange.WithBaseURL(fmt.Sprintf("%s/", s.URL)),
+		cdcexchange.WithoutRateLimiter(),
+	)
+	require.NoError(t, err)
+
+	res, err := client.GetTrades(context.Background(), cdcexchange.GetTradesRequest{
+		Start:       start,
+		End:         end,
+		PageRequest: cdcexchange.PageRequest{PageSize: cdcexchange.Some(100)},
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, []cdcexchange.Trade{{TradeID: "1"}, {TradeID: "2"}, {TradeID: "3"}}, res)
+	assert.Equal(t, [][2]int64{
+		{end.Add(-24 * time.Hour).UnixMilli(), end.UnixMilli()},
+		{end.Add(-48*time.Hour).UnixMilli() - 1, end.Add(-24*time.Hour).UnixMilli() - 1},
+		{start.UnixMilli(), end.Add(-48*time.Hour).UnixMilli() - 2},
+	}, windows)
+}
+
+func TestClient_TradesIterator(t *testing.T) {
+	const total = 200
+
+	var pages []int
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body api.Request
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+
+		assert.Equal(t, "BTC_USDT", body.Params["instrument_name"])
+		assert.Equal(t, float64(200), body.Params["page_size"])
+		assert.NotContains(t, body.Params, "start_ts")
+
+		page := int(body.Params["page"].(float64))
+		pages = append(pages, page)
+
+		// a full page is followed by an empty page.
+		var list []string
+		for i := page * 200; i < total && i < (page+1)*200; i++ {
+			list = append(list, fmt.Sprintf(`{"trade_id": "%d"}`, i))
+		}
+
+		_, err := fmt.Fprintf(w, `{"id": 0, "code": 0, "result": {"trade_list": [%s]}}`, strings.Join(list, ","))
+		require.NoError(t, err)
+	}))
+	t.Cleanup(s.Close)
+
+	client, err := cdcexchange.New("some api key", "some secret key",
+		cdcexchange.WithHTTPClient(s.Client()),
+		cdcexchange.WithBaseURL(fmt.Sprintf("%s/", s.URL)),
+		cdcexchange.WithoutRateLimiter(),
+	)
+	require.NoError(t, err)
+
+	it, err := client.TradesIterator(cdcexchange.GetTradesRequest{InstrumentName: "BTC_USDT"})
+	require.NoError(t, err)
+
+	var count int
+	for it.Next(context.Background()) {
+		assert.Equal(t, fmt.Sprint(count), it.Value().TradeID)
+		count++
+	}
+	require.NoError(t, it.Err())
+
+	assert.Equal(t, total, count)
+	assert.Equal(t, []int{0, 1}, pages)
+	assert.False(t, it.Next(context.Background()))
+}
+
+func TestTrade_UnmarshalJSON(t *testing.T) {
+	t.Run("decodes a v1 trade", func(t *testing.T) {
+		var trade cdcexchange.Trade
+		require.NoError(t, json.Unmarshal([]byte(`{
+			"account_id": "ds4e7c00f-1324-5a6z-bfgt-de445bde21a5",
+			"event_date": "2022-07-26",
+			"journal_type": "TRADING",
+			"side": "SELL",
+			"instrument_name": "BTCUSD-PERP",
+			"fees": "-0.0125",
+			"trade_id": "5755600460443882762",
+			"trade_match_id": "4611686018455978480",
+			"create_time": 1658793053250,
+			"create_time_ns": "1658793053250123456",
+			"traded_price": "24997.5",
+			"traded_quantity": "0.0010",
+			"fee_instrument_name": "USD",
+			"client_oid": "1658793053245",
+			"taker_side": "TAKER",
+			"order_id": "5755600460443882760"
+		}`), &trade))
+
+		assert.Equal(t, cdcexchange.Trade{
+			AccountID:          "ds4e7c00f-1324-5a6z-bfgt-de445bde21a5",
+			EventDate:          "2022-07-26",
+			Side:               cdcexchange.OrderSideSell,
+			InstrumentName:     "BTCUSD-PERP",
+			Fee:                cdcexchange.MustParseDecimal("0.0125"),
+			TradeID:            "5755600460443882762",
+			CreateTime:         cdcexchange.Timestamp(time.Unix(0, 1658793053250123456)),
+			TradedPrice:        cdcexchange.MustParseDecimal("24997.5"),
+			TradedQuantity:     cdcexchange.MustParseDecimal("0.001"),
+			FeeCurrency:        "USD",
+			ClientOrderID:      "1658793053245",
+			LiquidityIndicator: cdcexchange.LiquidityIndicatorTaker,
+			OrderID:            "5755600460443882760",
+			MatchID:            "4611686018455978480",
+		}, trade)
+	})
+
+	t.Run("decodes a legacy trade", func(t *testing.T) {
+		var trade cdcexchange.Trade
+		require.NoError(t, json.Unmarshal([]byte(`{
+			"side": "BUY",
+			"instrument_name": "CRO_USDT",
+			"fee": 0.01,
+			"trade_id": "1",
+			"create_time": 1610905445000,
+			"traded_price": 0.1,
+			"traded_quantity": 100,
+			"fee_currency": "CRO",
+			"order_id": "2",
+			"client_order_id": "some client oid",
+			"liquidity_indicator": "MAKER",
+			"match_id": 3
+		}`), &trade))
+
+		assert.Equal(t, cdcexchange.Trade{
+			Side:               cdcexchange.OrderSideBuy,
+			InstrumentName:     "CRO_USDT",
+			Fee:                cdcexchange.MustParseDecimal("0.01"),
+			TradeID:            "1",
+			CreateTime:         cdcexchange.Timestamp(time.UnixMilli(1610905445000)),
+			TradedPrice:        cdcexchange.MustParseDecimal("0.1"),
+			TradedQuantity:     cdcexchange.MustParseDecimal("100"),
+			FeeCurrency:        "CRO",
+			OrderID:            "2",
+			ClientOrderID:      "some client oid",
+			LiquidityIndicator: cdcexchange.LiquidityIndicatorMaker,
+			MatchID:            "3",
+		}, trade)
+	})
+
+	t.Run("returns error given an invalid match id", func(t *testing.T) {
+		var trade cdcexchange.Trade
+		require.Error(t, json.Unmarshal([]byte(`{"match_id": {}}`), &trade))
+	})
+}
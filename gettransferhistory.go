@@ -0,0 +1,145 @@
+package cdcexchange
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/sngyai/go-cryptocom/v2/errors"
+	"github.com/sngyai/go-cryptocom/v2/internal/api"
+	"github.com/sngyai/go-cryptocom/v2/internal/auth"
+)
+
+const (
+	methodGetTransferHistory = "private/deriv/get-transfer-history"
+)
+
+type (
+	// GetTransferHistoryRequest is the request params sent for the private/deriv/get-transfer-history API.
+	GetTransferHistoryRequest struct {
+		// Direction filters the transfers by direction relative to the DERIVATIVES wallet.
+		// IN is a transfer from SPOT to DERIVATIVES, OUT is a transfer from DERIVATIVES to SPOT.
+		// if Direction is omitted, transfers in both directions will be returned.
+		Direction TransferDirection `json:"direction"`
+		// Currency represents the currency of the transfers (e.g. USDT).
+		// if Currency is omitted, transfers for all currencies will be returned.
+		Currency string `json:"currency"`
+		// Start is the start timestamp (milliseconds since the Unix epoch)
+		// (Default: 24 hours ago)
+		Start time.Time `json:"start_ts"`
+		// End is the end timestamp (milliseconds since the Unix epoch)
+		// (Default: now)
+		End time.Time `json:"end_ts"`
+		// PageRequest is the page size (Default: 20, Max: 200) and page number (0-based) for pagination.
+		PageRequest
+	}
+
+	// GetTransferHistoryResponse is the base response returned from the private/deriv/get-transfer-history API.
+	GetTransferHistoryResponse struct {
+		// BaseResponse is the common response fields.
+		BaseResponse
+		// Result is the response attributes of the endpoint.
+		Result GetTransferHistoryResult `json:"result"`
+	}
+
+	// GetTransferHistoryResult is the result returned from the private/deriv/get-transfer-history API.
+	GetTransferHistoryResult struct {
+		// TransferList is the array of transfers.
+		TransferList []WalletTransfer `json:"transfer_list"`
+	}
+
+	// WalletTransfer represents a transfer between the SPOT and DERIVATIVES wallets.
+	WalletTransfer struct {
+		// Direction is the direction of the transfer relative to the DERIVATIVES wallet.
+		Direction TransferDirection `json:"direction"`
+		// Currency is the currency transferred (e.g. USDT).
+		Currency string `json:"currency"`
+		// Amount is the amount transferred.
+		Amount Decimal `json:"amount"`
+		// Status is the status of the transfer (e.g. PROCESSING, COMPLETED, REJECTED).
+		Status string `json:"status"`
+		// Information is a description of the transfer (e.g. reason for rejection).
+		Information string `json:"information"`
+		// Time is the time the transfer was made.
+		Time Timestamp `json:"time"`
+	}
+)
+
+// GetTransferHistory gets the history of transfers between the SPOT and DERIVATIVES wallets.
+//
+// Pagination is handled using page size (Default: 20, Max: 200) & number (0-based).
+// If paging is used, enumerate each page (starting with 0) until an empty transfer_list array appears in the response.
+//
+// req.Direction and req.Currency can be left blank to get transfers in both directions and for all currencies.
+//
+// Method: private/deriv/get-transfer-history
+func (c *Client) GetTransferHistory(ctx context.Context, req GetTransferHistoryRequest) ([]WalletTransfer, error) {
+	if err := req.PageRequest.validate(); err != nil {
+		return nil, err
+	}
+
+	switch req.Direction {
+	case "", TransferDirectionIn, TransferDirectionOut:
+	default:
+		return nil, errors.InvalidParameterError{Parameter: "req.Direction", Reason: "must be IN or OUT"}
+	}
+
+	var (
+		id        = c.idGenerator.Generate()
+		timestamp = c.now().UnixMilli()
+		params    = make(map[string]interface{})
+	)
+
+	creds, err := c.credentials(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Direction != "" {
+		params["direction"] = req.Direction
+	}
+	if req.Currency != "" {
+		params["currency"] = req.Currency
+	}
+	if !req.Start.IsZero() {
+		params["start_ts"] = req.Start.UnixMilli()
+	}
+	if !req.End.IsZero() {
+		params["end_ts"] = req.End.UnixMilli()
+	}
+	req.PageRequest.setParams(params)
+
+	signature, err := c.signatureGenerator.GenerateSignature(auth.SignatureRequest{
+		APIKey:    creds.APIKey,
+		SecretKey: creds.SecretKey,
+		ID:        id,
+		Method:    methodGetTransferHistory,
+		Timestamp: timestamp,
+		Params:    params,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create signature: %w", err)
+	}
+
+	body := api.Request{
+		ID:        id,
+		Method:    methodGetTransferHistory,
+		Nonce:     timestamp,
+		Params:    params,
+		Signature: signature,
+		APIKey:    creds.APIKey,
+		Version:   api.V2,
+	}
+
+	var getTransferHistoryResponse GetTransferHistoryResponse
+	statusCode, err := c.requester.Post(ctx, body, methodGetTransferHistory, &getTransferHistoryResponse)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute post request: %w", err)
+	}
+
+	if err := c.requester.CheckResponse(statusCode, api.BaseResponse(getTransferHistoryResponse.BaseResponse)); err != nil {
+		return nil, fmt.Errorf("error received in response: %w", err)
+	}
+
+	return getTransferHistoryResponse.Result.TransferList, nil
+}
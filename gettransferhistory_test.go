@@ -0,0 +1,192 @@
+package cdcexchange_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/jonboulle/clockwork"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	cdcexchange "github.com/sngyai/go-cryptocom/v2"
+	cdcerrors "github.com/sngyai/go-cryptocom/v2/errors"
+	"github.com/sngyai/go-cryptocom/v2/internal/api"
+	"github.com/sngyai/go-cryptocom/v2/internal/auth"
+	id_mocks "github.com/sngyai/go-cryptocom/v2/internal/mocks/id"
+	signature_mocks "github.com/sngyai/go-cryptocom/v2/internal/mocks/signature"
+)
+
+func TestClient_GetTransferHistory_Error(t *testing.T) {
+	const (
+		apiKey    = "some api key"
+		secretKey = "some secret key"
+	)
+
+	tests := []struct {
+		name        string
+		req         cdcexchange.GetTransferHistoryRequest
+		expectedErr error
+	}{
+		{
+			name:        "returns error when page size is less than 0",
+			req:         cdcexchange.GetTransferHistoryRequest{PageRequest: cdcexchange.PageRequest{PageSize: cdcexchange.Some(-1)}},
+			expectedErr: cdcerrors.InvalidParameterError{Parameter: "req.PageSize", Reason: "cannot be less than 0"},
+		},
+		{
+			name:        "returns error when page size is greater than 200",
+			req:         cdcexchange.GetTransferHistoryRequest{PageRequest: cdcexchange.PageRequest{PageSize: cdcexchange.Some(201)}},
+			expectedErr: cdcerrors.InvalidParameterError{Parameter: "req.PageSize", Reason: "cannot be greater than 200"},
+		},
+		{
+			name:        "returns error when page is less than 0",
+			req:         cdcexchange.GetTransferHistoryRequest{PageRequest: cdcexchange.PageRequest{Page: cdcexchange.Some(-1)}},
+			expectedErr: cdcerrors.InvalidParameterError{Parameter: "req.Page", Reason: "cannot be less than 0"},
+		},
+		{
+			name:        "returns error when direction is invalid",
+			req:         cdcexchange.GetTransferHistoryRequest{Direction: "SIDEWAYS"},
+			expectedErr: cdcerrors.InvalidParameterError{Parameter: "req.Direction", Reason: "must be IN or OUT"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client, err := cdcexchange.New(apiKey, secretKey)
+			require.NoError(t, err)
+
+			transfers, err := client.GetTransferHistory(context.Background(), tt.req)
+			require.Error(t, err)
+
+			assert.Empty(t, transfers)
+			assert.True(t, errors.Is(err, tt.expectedErr))
+		})
+	}
+}
+
+func TestClient_GetTransferHistory_Success(t *testing.T) {
+	const (
+		apiKey    = "some api key"
+		secretKey = "some secret key"
+		id        = int64(1234)
+		signature = "some signature"
+		currency  = "USDT"
+		pageSize  = 50
+	)
+	var (
+		now   = time.Now()
+		start = now.Add(-time.Hour)
+	)
+
+	ctrl, ctx := gomock.WithContext(context.Background(), t)
+	t.Cleanup(ctrl.Finish)
+
+	var (
+		signatureGenerator = signature_mocks.NewMockSignatureGenerator(ctrl)
+		idGenerator        = id_mocks.NewMockIDGenerator(ctrl)
+		clock              = clockwork.NewFakeClockAt(now)
+	)
+
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Contains(t, r.URL.Path, api.V2+cdcexchange.MethodGetTransferHistory)
+		t.Cleanup(func() { require.NoError(t, r.Body.Close()) })
+
+		var body api.Request
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+
+		assert.Equal(t, cdcexchange.MethodGetTransferHistory, body.Method)
+		assert.Equal(t, signature, body.Signature)
+		assert.Equal(t, "IN", body.Params["direction"])
+		assert.Equal(t, currency, body.Params["currency"])
+		assert.Equal(t, float64(start.UnixMilli()), body.Params["start_ts"])
+		assert.Equal(t, float64(now.UnixMilli()), body.Params["end_ts"])
+		assert.Equal(t, float64(pageSize), body.Params["page_size"])
+		assert.NotContains(t, body.Params, "page")
+
+		_, err := w.Write([]byte(`{
+			"id": 1234,
+			"method": "private/deriv/get-transfer-history",
+			"code": 0,
+			"result": {
+				"transfer_list": [
+					{
+						"direction": "IN",
+						"time": 1587571424000,
+						"amount": 21.45,
+						"status": "COMPLETED",
+						"information": "From Spot Wallet",
+						"currency": "USDT"
+					},
+					{
+						"direction": "IN",
+						"time": 1587571436000,
+						"amount": "100",
+						"status": "REJECTED",
+						"information": "Insufficient balance",
+						"currency": "USDT"
+					}
+				]
+			}
+		}`))
+		require.NoError(t, err)
+	}))
+	t.Cleanup(s.Close)
+
+	client, err := cdcexchange.New(apiKey, secretKey,
+		cdcexchange.WithIDGenerator(idGenerator),
+		cdcexchange.WithClock(clock),
+		cdcexchange.WithHTTPClient(s.Client()),
+		cdcexchange.WithBaseURL(fmt.Sprintf("%s/", s.URL)),
+		cdcexchange.WithSignatureGenerator(signatureGenerator),
+	)
+	require.NoError(t, err)
+
+	idGenerator.EXPECT().Generate().Return(id)
+	signatureGenerator.EXPECT().GenerateSignature(auth.SignatureRequest{
+		APIKey:    apiKey,
+		SecretKey: secretKey,
+		ID:        id,
+		Method:    cdcexchange.MethodGetTransferHistory,
+		Timestamp: now.UnixMilli(),
+		Params: map[string]interface{}{
+			"direction": cdcexchange.TransferDirectionIn,
+			"currency":  currency,
+			"start_ts":  start.UnixMilli(),
+			"end_ts":    now.UnixMilli(),
+			"page_size": pageSize,
+		},
+	}).Return(signature, nil)
+
+	transfers, err := client.GetTransferHistory(ctx, cdcexchange.GetTransferHistoryRequest{
+		Direction:   cdcexchange.TransferDirectionIn,
+		Currency:    currency,
+		Start:       start,
+		End:         now,
+		PageRequest: cdcexchange.PageRequest{PageSize: cdcexchange.Some(pageSize)},
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, []cdcexchange.WalletTransfer{
+		{
+			Direction:   cdcexchange.TransferDirectionIn,
+			Currency:    currency,
+			Amount:      cdcexchange.MustParseDecimal("21.45"),
+			Status:      "COMPLETED",
+			Information: "From Spot Wallet",
+			Time:        cdcexchange.Timestamp(time.UnixMilli(1587571424000)),
+		},
+		{
+			Direction:   cdcexchange.TransferDirectionIn,
+			Currency:    currency,
+			Amount:      cdcexchange.MustParseDecimal("100"),
+			Status:      "REJECTED",
+			Information: "Insufficient balance",
+			Time:        cdcexchange.Timestamp(time.UnixMilli(1587571436000)),
+		},
+	}, transfers)
+}
@@ -0,0 +1,117 @@
+package cdcexchange
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/sngyai/go-cryptocom/v2/internal/api"
+	"github.com/sngyai/go-cryptocom/v2/internal/auth"
+)
+
+const (
+	methodGetUserBalance = "private/user-balance"
+)
+
+type (
+	// GetUserBalanceResponse is the base response returned from the private/user-balance API.
+	GetUserBalanceResponse struct {
+		// BaseResponse is the common response fields.
+		BaseResponse
+		// Result is the response attributes of the endpoint.
+		Result GetUserBalanceResult `json:"result"`
+	}
+
+	// GetUserBalanceResult is the result returned from the private/user-balance API.
+	GetUserBalanceResult struct {
+		// Data is the list of balance summaries for the account.
+		Data []UserBalanceSummary `json:"data"`
+	}
+
+	// UserBalanceSummary represents the v1 balance summary of the account, including collateral and position balances.
+	UserBalanceSummary struct {
+		// InstrumentName is the currency the totals are denominated in (e.g. USD).
+		InstrumentName string `json:"instrument_name"`
+		// TotalAvailableBalance is the balance available to open new orders.
+		TotalAvailableBalance Decimal `json:"total_available_balance"`
+		// TotalMarginBalance is the balance including collateral and unrealised PnL.
+		TotalMarginBalance Decimal `json:"total_margin_balance"`
+		// TotalInitialMargin is the total margin requirement to support positions and open orders.
+		TotalInitialMargin Decimal `json:"total_initial_margin"`
+		// TotalMaintenanceMargin is the total maintenance margin requirement for positions.
+		TotalMaintenanceMargin Decimal `json:"total_maintenance_margin"`
+		// TotalPositionCost is the position cost or value in USD.
+		TotalPositionCost Decimal `json:"total_position_cost"`
+		// TotalCashBalance is the wallet balance (deposits - withdrawals + realised PnL - fees).
+		TotalCashBalance Decimal `json:"total_cash_balance"`
+		// TotalCollateralValue is the collateral value after haircuts.
+		TotalCollateralValue Decimal `json:"total_collateral_value"`
+		// TotalSessionUnrealizedPNL is the current unrealised PnL from all open positions.
+		TotalSessionUnrealizedPNL Decimal `json:"total_session_unrealized_pnl"`
+		// TotalSessionRealizedPNL is the realised PnL of the current trading session.
+		TotalSessionRealizedPNL Decimal `json:"total_session_realized_pnl"`
+		// TotalEffectiveLeverage is the actual leverage used (all open positions combined).
+		TotalEffectiveLeverage Decimal `json:"total_effective_leverage"`
+		// TotalBorrow is the total amount borrowed against the account.
+		TotalBorrow Decimal `json:"total_borrow"`
+		// PositionLimit is the maximum position size allowed (for all open positions combined).
+		PositionLimit Decimal `json:"position_limit"`
+		// UsedPositionLimit is the position size currently used (for all open positions combined).
+		UsedPositionLimit Decimal `json:"used_position_limit"`
+		// IsLiquidating describes whether the account is under liquidation.
+		IsLiquidating bool `json:"is_liquidating"`
+		// PositionBalances is the per-currency balance breakdown of the account.
+		PositionBalances []PositionBalance `json:"position_balances"`
+	}
+)
+
+// GetUserBalance returns the balance summary of the account, including collateral, position balances and haircuts.
+//
+// Unlike GetAccountSummary, this is the v1 balance call and covers margin and derivatives collateral.
+//
+// Method: private/user-balance
+func (c *Client) GetUserBalance(ctx context.Context) ([]UserBalanceSummary, error) {
+	var (
+		id        = c.idGenerator.Generate()
+		timestamp = c.now().UnixMilli()
+		params    = make(map[string]interface{})
+	)
+
+	creds, err := c.credentials(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	signature, err := c.signatureGenerator.GenerateSignature(auth.SignatureRequest{
+		APIKey:    creds.APIKey,
+		SecretKey: creds.SecretKey,
+		ID:        id,
+		Method:    methodGetUserBalance,
+		Timestamp: timestamp,
+		Params:    params,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create signature: %w", err)
+	}
+
+	body := api.Request{
+		ID:        id,
+		Method:    methodGetUserBalance,
+		Nonce:     timestamp,
+		Params:    params,
+		Signature: signature,
+		APIKey:    creds.APIKey,
+		Version:   api.V1,
+	}
+
+	var getUserBalanceResponse GetUserBalanceResponse
+	statusCode, err := c.requester.Post(ctx, body, methodGetUserBalance, &getUserBalanceResponse)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute post request: %w", err)
+	}
+
+	if err := c.requester.CheckResponse(statusCode, api.BaseResponse(getUserBalanceResponse.BaseResponse)); err != nil {
+		return nil, fmt.Errorf("error received in response: %w", err)
+	}
+
+	return getUserBalanceResponse.Result.Data, nil
+}
@@ -0,0 +1,44 @@
+package cdcexchange
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/sngyai/go-cryptocom/v2/errors"
+)
+
+// GetWithdrawal gets the current state of a single withdrawal by its ID (e.g. as returned from CreateWithdrawal).
+//
+// The exchange has no endpoint to look up a single withdrawal, so the withdrawal history of the last 24 hours
+// is paged through until the withdrawal is found.
+//
+// errors.ErrWithdrawalNotFound is returned if no withdrawal with the ID was made in the last 24 hours.
+//
+// Method: private/get-withdrawal-history
+func (c *Client) GetWithdrawal(ctx context.Context, withdrawalID string) (*Withdrawal, error) {
+	if withdrawalID == "" {
+		return nil, errors.InvalidParameterError{Parameter: "withdrawalID", Reason: "cannot be empty"}
+	}
+
+	pager, err := NewPager(func(ctx context.Context, page PageRequest) ([]Withdrawal, error) {
+		return c.GetWithdrawalHistory(ctx, GetWithdrawalHistoryRequest{PageRequest: page})
+	}, maxPageSize)
+	if err != nil {
+		return nil, err
+	}
+
+	for pager.HasNext() {
+		page, err := pager.Next(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		for i := range page.Items {
+			if page.Items[i].ID == withdrawalID {
+				return &page.Items[i], nil
+			}
+		}
+	}
+
+	return nil, fmt.Errorf("withdrawal %s: %w", withdrawalID, errors.ErrWithdrawalNotFound)
+}
@@ -0,0 +1,105 @@
+package cdcexchange_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	cdcexchange "github.com/sngyai/go-cryptocom/v2"
+	cdcerrors "github.com/sngyai/go-cryptocom/v2/errors"
+	"github.com/sngyai/go-cryptocom/v2/internal/api"
+)
+
+func TestClient_GetWithdrawal(t *testing.T) {
+	const (
+		apiKey    = "some api key"
+		secretKey = "some secret key"
+	)
+
+	// withdrawalHistoryServer serves a full page of unrelated withdrawals followed by a page containing the
+	// given withdrawals.
+	withdrawalHistoryServer := func(t *testing.T, withdrawals []cdcexchange.Withdrawal) *httptest.Server {
+		s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Contains(t, r.URL.Path, cdcexchange.MethodGetWithdrawalHistory)
+
+			var body api.Request
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+			require.NoError(t, r.Body.Close())
+
+			assert.Equal(t, float64(200), body.Params["page_size"])
+
+			var res cdcexchange.GetWithdrawalHistoryResponse
+			switch body.Params["page"] {
+			case float64(0):
+				for i := 0; i < 200; i++ {
+					res.Result.WithdrawalList = append(res.Result.WithdrawalList, cdcexchange.Withdrawal{ID: strconv.Itoa(i)})
+				}
+			case float64(1):
+				res.Result.WithdrawalList = withdrawals
+			default:
+				t.Errorf("unexpected page: %v", body.Params["page"])
+			}
+
+			require.NoError(t, json.NewEncoder(w).Encode(res))
+		}))
+		t.Cleanup(s.Close)
+
+		return s
+	}
+
+	t.Run("returns error when withdrawal id is empty", func(t *testing.T) {
+		client, err := cdcexchange.New(apiKey, secretKey)
+		require.NoError(t, err)
+
+		withdrawal, err := client.GetWithdrawal(context.Background(), "")
+		require.Error(t, err)
+
+		assert.Nil(t, withdrawal)
+		assert.Equal(t, cdcerrors.InvalidParameterError{Parameter: "withdrawalID", Reason: "cannot be empty"}, err)
+	})
+
+	t.Run("returns error when withdrawal is not found", func(t *testing.T) {
+		s := withdrawalHistoryServer(t, []cdcexchange.Withdrawal{{ID: "some other id"}})
+
+		client, err := cdcexchange.New(apiKey, secretKey,
+			cdcexchange.WithHTTPClient(s.Client()),
+			cdcexchange.WithBaseURL(fmt.Sprintf("%s/", s.URL)),
+		)
+		require.NoError(t, err)
+
+		withdrawal, err := client.GetWithdrawal(context.Background(), "some id")
+		require.Error(t, err)
+
+		assert.Nil(t, withdrawal)
+		assert.True(t, errors.Is(err, cdcerrors.ErrWithdrawalNotFound))
+	})
+
+	t.Run("returns withdrawal found on a later page", func(t *testing.T) {
+		expected := cdcexchange.Withdrawal{
+			ID:       "some id",
+			Currency: "CRO",
+			Amount:   cdcexchange.MustParseDecimal("100"),
+			Status:   "5",
+		}
+		s := withdrawalHistoryServer(t, []cdcexchange.Withdrawal{{ID: "some other id"}, expected})
+
+		client, err := cdcexchange.New(apiKey, secretKey,
+			cdcexchange.WithHTTPClient(s.Client()),
+			cdcexchange.WithBaseURL(fmt.Sprintf("%s/", s.URL)),
+		)
+		require.NoError(t, err)
+
+		withdrawal, err := client.GetWithdrawal(context.Background(), "some id")
+		require.NoError(t, err)
+
+		assert.Equal(t, &expected, withdrawal)
+	})
+}
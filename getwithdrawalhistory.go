@@ -2,12 +2,13 @@ package cdcexchange
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"time"
 
-	"github.com/sngyai/go-cryptocom/errors"
-	"github.com/sngyai/go-cryptocom/internal/api"
-	"github.com/sngyai/go-cryptocom/internal/auth"
+	"github.com/sngyai/go-cryptocom/v2/errors"
+	"github.com/sngyai/go-cryptocom/v2/internal/api"
+	"github.com/sngyai/go-cryptocom/v2/internal/auth"
 )
 
 const (
@@ -19,10 +20,11 @@ type (
 	//
 	// The maximum duration between Start and End is 24 hours.
 	//
-	// You will receive an INVALID_DATE_RANGE error if the difference exceeds the maximum duration.
+	// An errors.DateRangeError is returned without making a request if the difference exceeds the maximum duration
+	// (End defaults to now if only Start is set), which matches errors.ErrInvalidDateRange.
 	//
-	// For users looking to pull longer historical withdrawal data, users can create a loop to make a request
-	// for each 24-period from the desired start to end time.
+	// Longer historical withdrawal data can be pulled with GetWithdrawalHistoryRange, which makes a request
+	// for each 24 hour period from the desired start to end time.
 	GetWithdrawalHistoryRequest struct {
 		// Currency represents the currency symbol for the withdrawals (e.g. BTC or ETH).
 		// if Currency is omitted, all currencies will be returned.
@@ -33,21 +35,16 @@ type (
 		// End is the end timestamp (milliseconds since the Unix epoch)
 		// (Default: now)
 		End time.Time `json:"end_ts"`
-		// PageSize represents maximum number of withdrawals returned (for pagination)
-		// (Default: 20, Max: 200)
-		// if PageSize is 0, it will be set as 20 by default.
-		PageSize int `json:"page_size"`
-		// Page represents the page number (for pagination)
-		// (0-based)
-		Page int `json:"page"`
-
+		// PageRequest is the page size (Default: 20, Max: 200) and page number (0-based) for pagination.
+		PageRequest
+		// Status filters by status (0 - Pending, 1 - Processing, 2 - Rejected, 3 - Payment In-progress, 4 - Payment Failed, 5 - Completed, 6 - Cancelled), all statuses are returned if empty.
 		Status string `json:"status"`
 	}
 
 	// GetWithdrawalHistoryResponse is the base response returned from the private/get-withdrawal-history API.
 	GetWithdrawalHistoryResponse struct {
-		// api.BaseResponse is the common response fields.
-		api.BaseResponse
+		// BaseResponse is the common response fields.
+		BaseResponse
 		// Result is the response attributes of the endpoint.
 		Result GetWithdrawalHistoryResult `json:"result"`
 	}
@@ -58,21 +55,73 @@ type (
 		WithdrawalList []Withdrawal `json:"withdrawal_list"`
 	}
 
+	// Withdrawal is a withdrawal from the account.
 	Withdrawal struct {
-		Currency   string      `json:"currency"`
-		ClientWid  string      `json:"client_wid"`
-		Fee        float64     `json:"fee"`
-		CreateTime int64       `json:"create_time"`
-		Id         string      `json:"id"`
-		UpdateTime int64       `json:"update_time"`
-		Amount     float64     `json:"amount"`
-		Address    string      `json:"address"`
-		Status     string      `json:"status"`
-		Txid       string      `json:"txid"`
-		NetworkId  interface{} `json:"network_id"`
+		// Currency is the currency symbol of the withdrawal (e.g. BTC or ETH).
+		Currency string `json:"currency"`
+		// ClientWID is the optional client withdrawal ID passed to CreateWithdrawal.
+		ClientWID string `json:"client_wid"`
+		// Fee is the fee charged for the withdrawal.
+		Fee Decimal `json:"fee"`
+		// CreateTime is the time the withdrawal was created (milliseconds since the Unix epoch).
+		CreateTime int64 `json:"create_time"`
+		// ID is the withdrawal ID.
+		ID string `json:"id"`
+		// UpdateTime is the time the withdrawal was last updated (milliseconds since the Unix epoch).
+		UpdateTime int64 `json:"update_time"`
+		// Amount is the amount withdrawn.
+		Amount Decimal `json:"amount"`
+		// Address is the address the withdrawal was sent to.
+		Address string `json:"address"`
+		// Status is the status of the withdrawal.
+		Status string `json:"status"`
+		// TxID is the transaction hash of the withdrawal, empty until it has been broadcast.
+		TxID string `json:"txid"`
+		// NetworkID is the network the withdrawal was sent on (e.g. ETH), empty if the default network was used.
+		NetworkID string `json:"network_id"`
 	}
 )
 
+// UnmarshalJSON decodes a withdrawal, tolerating a null or numeric network_id.
+func (w *Withdrawal) UnmarshalJSON(data []byte) error {
+	type withdrawal Withdrawal
+	v := struct {
+		*withdrawal
+		NetworkID json.RawMessage `json:"network_id"`
+	}{withdrawal: (*withdrawal)(w)}
+
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+
+	networkID, err := unmarshalID("network_id", v.NetworkID)
+	if err != nil {
+		return err
+	}
+	w.NetworkID = networkID
+
+	return nil
+}
+
+// unmarshalID decodes the ID field name (e.g. network_id), which is returned as a string, a number, or null (if it isn't set).
+func unmarshalID(name string, data json.RawMessage) (string, error) {
+	if len(data) == 0 || string(data) == "null" {
+		return "", nil
+	}
+
+	var id string
+	if err := json.Unmarshal(data, &id); err == nil {
+		return id, nil
+	}
+
+	var number json.Number
+	if err := json.Unmarshal(data, &number); err != nil {
+		return "", fmt.Errorf("invalid %s %s: %w", name, string(data), err)
+	}
+
+	return number.String(), nil
+}
+
 // GetWithdrawalHistory gets the withdrawal history for a particular instrument.
 //
 // Pagination is handled using page size (Default: 20, Max: 200) & number (0-based).
@@ -82,39 +131,77 @@ type (
 //
 // Method: private/get-withdrawal-history
 func (c *Client) GetWithdrawalHistory(ctx context.Context, req GetWithdrawalHistoryRequest) ([]Withdrawal, error) {
-	if req.PageSize < 0 {
-		return nil, errors.InvalidParameterError{Parameter: "req.Limit", Reason: "cannot be less than 0"}
+	if err := req.PageRequest.validate(); err != nil {
+		return nil, err
 	}
-	if req.PageSize > 200 {
-		return nil, errors.InvalidParameterError{Parameter: "req.Limit", Reason: "cannot be greater than 200"}
+	if err := validateTimeRange(req.Start, req.End, c.now(), MaxHistoryWindow); err != nil {
+		return nil, err
 	}
 
+	return c.getWithdrawalHistory(ctx, req)
+}
+
+// GetWithdrawalHistoryRange returns an Iterator over the withdrawals matching req between req.Start and req.End,
+// which can be any range: it is split into 24 hour windows (newest first), and every page of each window is fetched.
+//
+// req.End defaults to now if only req.Start is set. Pages of req.PageSize withdrawals are fetched (Default: 200),
+// and req.Page is ignored.
+func (c *Client) GetWithdrawalHistoryRange(req GetWithdrawalHistoryRequest) (*Iterator[Withdrawal], error) {
+	if err := req.PageRequest.validate(); err != nil {
+		return nil, err
+	}
+	if !req.Start.IsZero() && req.End.IsZero() {
+		req.End = c.now()
+	}
+	if req.End.Before(req.Start) {
+		return nil, errors.DateRangeError{Start: req.Start, End: req.End, MaxRange: MaxHistoryWindow}
+	}
+
+	pageSize, _ := req.PageSize.Get()
+	if pageSize == 0 {
+		pageSize = maxPageSize
+	}
+
+	return newIterator(historyWindows(req.Start, req.End), pageSize, func(ctx context.Context, window TimeWindow, page PageRequest) ([]Withdrawal, error) {
+		windowReq := req
+		windowReq.Start = window.Start
+		windowReq.End = window.End
+		windowReq.PageRequest = page
+
+		return c.getWithdrawalHistory(ctx, windowReq)
+	}), nil
+}
+
+// getWithdrawalHistory makes a single private/get-withdrawal-history request for the timeframe of req.
+func (c *Client) getWithdrawalHistory(ctx context.Context, req GetWithdrawalHistoryRequest) ([]Withdrawal, error) {
 	var (
 		id        = c.idGenerator.Generate()
-		timestamp = c.clock.Now().UnixMilli()
+		timestamp = c.now().UnixMilli()
 		params    = make(map[string]interface{})
 	)
 
+	creds, err := c.credentials(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	if req.Currency != "" {
 		params["currency"] = req.Currency
 	}
-	if req.PageSize != 0 {
-		params["page_size"] = req.PageSize
-	}
+	req.PageRequest.setParams(params)
 	if !req.Start.IsZero() {
 		params["start_ts"] = req.Start.UnixMilli()
 	}
 	if !req.End.IsZero() {
 		params["end_ts"] = req.End.UnixMilli()
 	}
-	params["page"] = req.Page
 	if req.Status != "" {
 		params["status"] = req.Status
 	}
 
 	signature, err := c.signatureGenerator.GenerateSignature(auth.SignatureRequest{
-		APIKey:    c.apiKey,
-		SecretKey: c.secretKey,
+		APIKey:    creds.APIKey,
+		SecretKey: creds.SecretKey,
 		ID:        id,
 		Method:    methodGetWithdrawalHistory,
 		Timestamp: timestamp,
@@ -130,7 +217,7 @@ func (c *Client) GetWithdrawalHistory(ctx context.Context, req GetWithdrawalHist
 		Nonce:     timestamp,
 		Params:    params,
 		Signature: signature,
-		APIKey:    c.apiKey,
+		APIKey:    creds.APIKey,
 	}
 
 	var getWithdrawalHistoryResponse GetWithdrawalHistoryResponse
@@ -139,7 +226,7 @@ func (c *Client) GetWithdrawalHistory(ctx context.Context, req GetWithdrawalHist
 		return nil, fmt.Errorf("failed to execute post request: %w", err)
 	}
 
-	if err := c.requester.CheckErrorResponse(statusCode, getWithdrawalHistoryResponse.Code); err != nil {
+	if err := c.requester.CheckResponse(statusCode, api.BaseResponse(getWithdrawalHistoryResponse.BaseResponse)); err != nil {
 		return nil, fmt.Errorf("error received in response: %w", err)
 	}
 
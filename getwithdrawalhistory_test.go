@@ -0,0 +1,140 @@
+package cdcexchange_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/jonboulle/clockwork"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	cdcexchange "github.com/sngyai/go-cryptocom/v2"
+	cdcerrors "github.com/sngyai/go-cryptocom/v2/errors"
+	"github.com/sngyai/go-cryptocom/v2/internal/api"
+)
+
+func TestWithdrawal_UnmarshalJSON(t *testing.T) {
+	tests := []struct {
+		name              string
+		networkID         string
+		expectedNetworkID string
+		wantErr           bool
+	}{
+		{name: "string network id", networkID: `"ETH"`, expectedNetworkID: "ETH"},
+		{name: "null network id", networkID: `null`, expectedNetworkID: ""},
+		{name: "numeric network id", networkID: `1`, expectedNetworkID: "1"},
+		{name: "invalid network id", networkID: `{}`, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data := []byte(`{
+				"currency": "XRP",
+				"client_wid": "my_withdrawal_002",
+				"fee": 1.0,
+				"create_time": 1607063412000,
+				"id": "2220",
+				"update_time": 1607063460000,
+				"amount": "100.5",
+				"address": "2NBqqD5GRJ8wHy1PYyCXTe9ke5226FhavBf?1234567890",
+				"status": "1",
+				"txid": "",
+				"network_id": ` + tt.networkID + `
+			}`)
+
+			var withdrawal cdcexchange.Withdrawal
+			err := json.Unmarshal(data, &withdrawal)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			assert.Equal(t, cdcexchange.Withdrawal{
+				Currency:   "XRP",
+				ClientWID:  "my_withdrawal_002",
+				Fee:        cdcexchange.MustParseDecimal("1"),
+				CreateTime: 1607063412000,
+				ID:         "2220",
+				UpdateTime: 1607063460000,
+				Amount:     cdcexchange.MustParseDecimal("100.5"),
+				Address:    "2NBqqD5GRJ8wHy1PYyCXTe9ke5226FhavBf?1234567890",
+				Status:     "1",
+				NetworkID:  tt.expectedNetworkID,
+			}, withdrawal)
+		})
+	}
+}
+
+func TestCreateWithdrawalResult_UnmarshalJSON(t *testing.T) {
+	var result cdcexchange.CreateWithdrawalResult
+	require.NoError(t, json.Unmarshal([]byte(`{"id": 2220, "amount": 1, "fee": 0.0004, "symbol": "BTC", "network_id": null}`), &result))
+
+	assert.Equal(t, cdcexchange.CreateWithdrawalResult{
+		ID:     2220,
+		Amount: cdcexchange.MustParseDecimal("1"),
+		Fee:    cdcexchange.MustParseDecimal("0.0004"),
+		Symbol: "BTC",
+	}, result)
+}
+
+func TestClient_GetWithdrawalHistory_DateRangeError(t *testing.T) {
+	start := time.Date(2023, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+	client, err := cdcexchange.New("some api key", "some secret key")
+	require.NoError(t, err)
+
+	withdrawals, err := client.GetWithdrawalHistory(context.Background(), cdcexchange.GetWithdrawalHistoryRequest{
+		Start: start,
+		End:   start.Add(48 * time.Hour),
+	})
+	require.Error(t, err)
+
+	assert.Nil(t, withdrawals)
+	assert.Equal(t, cdcerrors.DateRangeError{Start: start, End: start.Add(48 * time.Hour), MaxRange: 24 * time.Hour}, err)
+	assert.True(t, errors.Is(err, cdcerrors.ErrInvalidDateRange))
+}
+
+func TestClient_GetWithdrawalHistoryRange_DefaultsEndToNow(t *testing.T) {
+	now := time.Now().Round(time.Second)
+
+	var windows [][2]int64
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body api.Request
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+
+		windows = append(windows, [2]int64{int64(body.Params["start_ts"].(float64)), int64(body.Params["end_ts"].(float64))})
+
+		_, err := w.Write([]byte(`{"id": 0, "code": 0, "result": {"withdrawal_list": [{"id": "1"}]}}`))
+		require.NoError(t, err)
+	}))
+	t.Cleanup(s.Close)
+
+	client, err := cdcexchange.New("some api key", "some secret key",
+		cdcexchange.WithHTTPClient(s.Client()),
+		cdcexchange.WithBaseURL(fmt.Sprintf("%s/", s.URL)),
+		cdcexchange.WithClock(clockwork.NewFakeClockAt(now)),
+		cdcexchange.WithoutRateLimiter(),
+	)
+	require.NoError(t, err)
+
+	it, err := client.GetWithdrawalHistoryRange(cdcexchange.GetWithdrawalHistoryRequest{Start: now.Add(-36 * time.Hour)})
+	require.NoError(t, err)
+
+	var withdrawals []cdcexchange.Withdrawal
+	for it.Next(context.Background()) {
+		withdrawals = append(withdrawals, it.Value())
+	}
+	require.NoError(t, it.Err())
+
+	assert.Len(t, withdrawals, 2)
+	assert.Equal(t, [][2]int64{
+		{now.Add(-24 * time.Hour).UnixMilli(), now.UnixMilli()},
+		{now.Add(-36 * time.Hour).UnixMilli(), now.Add(-24*time.Hour).UnixMilli() - 1},
+	}, windows)
+}
@@ -0,0 +1,104 @@
+package cdcexchange
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/sngyai/go-cryptocom/v2/errors"
+)
+
+type (
+	// IcebergRequest is a parent LIMIT order executed by ExecuteIceberg.
+	IcebergRequest struct {
+		// InstrumentName represents the currency pair to trade (e.g. ETH_CRO or BTC_USDT).
+		InstrumentName string
+		// Side represents whether the orders are buy or sell orders.
+		Side OrderSide
+		// Price is the price of the child orders.
+		Price Decimal
+		// Quantity is the total quantity to execute, of which only ClipSize is visible on the order book at a time.
+		Quantity Decimal
+		// ClipSize is the quantity of each child order (the last one being for the remaining quantity).
+		ClipSize Decimal
+		// ExecInst is the execution instruction of the child orders (e.g. POST_ONLY), optional.
+		ExecInst ExecInst
+	}
+)
+
+// ExecuteIceberg executes req as an iceberg order, which the Exchange does not support natively: only a clip of
+// req.ClipSize is placed on the order book at a time, as a GOOD_TILL_CANCEL LIMIT child order, and the next clip is
+// placed once it is filled, until the parent order is filled.
+//
+// Each child order is waited for until it reaches a final status (see WaitForOrderFinal, which opts are passed to).
+// If a child order is cancelled, rejected or expired before it is filled (e.g. a POST_ONLY order which would have
+// matched, or an order cancelled from another session), no more child orders are placed and errors.ErrClipNotFilled
+// is returned.
+//
+// If ctx is done or a request fails, the open child order is cancelled and the result so far, including the fills of
+// that child order before it was cancelled, is returned along with the error (and any error cancelling it).
+func (c *Client) ExecuteIceberg(ctx context.Context, req IcebergRequest, opts ...WaitOption) (*ExecutionResult, error) {
+	if err := req.validate(); err != nil {
+		return nil, err
+	}
+
+	result := &ExecutionResult{RemainingQuantity: req.Quantity}
+	for result.RemainingQuantity.Sign() > 0 {
+		quantity := req.ClipSize
+		if result.RemainingQuantity.Cmp(quantity) < 0 {
+			quantity = result.RemainingQuantity
+		}
+
+		created, err := c.CreateOrder(ctx, CreateOrderRequest{
+			InstrumentName: req.InstrumentName,
+			Side:           req.Side,
+			Type:           OrderTypeLimit,
+			Price:          req.Price,
+			Quantity:       quantity,
+			TimeInForce:    TimeInForceGoodTilCancelled,
+			ExecInst:       req.ExecInst,
+		})
+		if err != nil {
+			return result, fmt.Errorf("failed to create child order: %w", err)
+		}
+
+		final, err := c.WaitForOrderFinal(ctx, created.OrderID, opts...)
+		if err != nil {
+			// the child order must not be left resting once the execution has stopped.
+			stopped, err := c.stopOrder(ctx, req.InstrumentName, created.OrderID, fmt.Errorf("failed to wait for child order: %w", err))
+			if stopped != nil {
+				result.add(*stopped)
+			}
+			return result, err
+		}
+		result.add(*final)
+
+		if final.Status != OrderStatusFilled {
+			return result, fmt.Errorf("child order %s is %s: %w", final.OrderID, final.Status, errors.ErrClipNotFilled)
+		}
+	}
+
+	return result, nil
+}
+
+func (req IcebergRequest) validate() error {
+	if req.InstrumentName == "" {
+		return errors.InvalidParameterError{Parameter: "req.InstrumentName", Reason: "cannot be empty"}
+	}
+	if !req.Side.IsValid() {
+		return errors.InvalidParameterError{Parameter: "req.Side", Reason: "must be BUY or SELL"}
+	}
+	if req.Price.Sign() <= 0 {
+		return errors.InvalidParameterError{Parameter: "req.Price", Reason: "must be greater than 0"}
+	}
+	if req.Quantity.Sign() <= 0 {
+		return errors.InvalidParameterError{Parameter: "req.Quantity", Reason: "must be greater than 0"}
+	}
+	if req.ClipSize.Sign() <= 0 {
+		return errors.InvalidParameterError{Parameter: "req.ClipSize", Reason: "must be greater than 0"}
+	}
+	if req.ExecInst != "" && !req.ExecInst.IsValid() {
+		return errors.InvalidParameterError{Parameter: "req.ExecInst", Reason: "must be POST_ONLY or SMART_POST_ONLY"}
+	}
+
+	return nil
+}
@@ -0,0 +1,164 @@
+package cdcexchange_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	cdcexchange "github.com/sngyai/go-cryptocom/v2"
+	cdcerrors "github.com/sngyai/go-cryptocom/v2/errors"
+	"github.com/sngyai/go-cryptocom/v2/internal/api"
+)
+
+func TestClient_ExecuteIceberg(t *testing.T) {
+	var requests []api.Request
+	client := twapServer(t, cdcexchange.OrderStatusFilled, "1", nil, http.StatusOK, &requests)
+
+	result, err := client.ExecuteIceberg(context.Background(), cdcexchange.IcebergRequest{
+		InstrumentName: "BTC_USDT",
+		Side:           cdcexchange.OrderSideSell,
+		Price:          cdcexchange.MustParseDecimal("25000"),
+		Quantity:       cdcexchange.MustParseDecimal("1"),
+		ClipSize:       cdcexchange.MustParseDecimal("0.4"),
+		ExecInst:       cdcexchange.ExecInstPostOnly,
+	}, cdcexchange.WithWaitBackoff(time.Millisecond, time.Millisecond))
+	require.NoError(t, err)
+
+	params := createOrderParams(requests)
+	require.Len(t, params, 3)
+	assert.Equal(t, map[string]interface{}{
+		"instrument_name": "BTC_USDT",
+		"side":            "SELL",
+		"type":            "LIMIT",
+		"price":           "25000",
+		"quantity":        "0.4",
+		"time_in_force":   "GOOD_TILL_CANCEL",
+		"exec_inst":       "POST_ONLY",
+	}, params[0])
+	assert.Equal(t, "0.4", params[1]["quantity"])
+	assert.Equal(t, "0.2", params[2]["quantity"])
+
+	assert.Len(t, result.Orders, 3)
+	assert.Equal(t, "1", result.FilledQuantity.String())
+	assert.Equal(t, "25000", result.FilledValue.String())
+	assert.Equal(t, "0.3", result.FilledFee.String())
+	assert.True(t, result.RemainingQuantity.IsZero())
+}
+
+func TestClient_ExecuteIceberg_Error(t *testing.T) {
+	req := cdcexchange.IcebergRequest{
+		InstrumentName: "BTC_USDT",
+		Side:           cdcexchange.OrderSideBuy,
+		Price:          cdcexchange.MustParseDecimal("25000"),
+		Quantity:       cdcexchange.MustParseDecimal("1"),
+		ClipSize:       cdcexchange.MustParseDecimal("0.4"),
+	}
+	wait := cdcexchange.WithWaitBackoff(time.Millisecond, time.Millisecond)
+
+	t.Run("stops when a clip is cancelled before it is filled", func(t *testing.T) {
+		var requests []api.Request
+		client := twapServer(t, cdcexchange.OrderStatusCancelled, "0.5", nil, http.StatusOK, &requests)
+
+		result, err := client.ExecuteIceberg(context.Background(), req, wait)
+		assert.True(t, errors.Is(err, cdcerrors.ErrClipNotFilled))
+
+		require.NotNil(t, result)
+		assert.Len(t, createOrderParams(requests), 1)
+		assert.Equal(t, "0.2", result.FilledQuantity.String())
+		assert.Equal(t, "0.8", result.RemainingQuantity.String())
+	})
+
+	t.Run("cancels the open clip when ctx is done", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		t.Cleanup(cancel)
+
+		var requests []api.Request
+		// ctx is done while the first clip is resting, after filling half of its quantity.
+		client := twapServer(t, cdcexchange.OrderStatusActive, "0.5", func() { time.AfterFunc(10*time.Millisecond, cancel) }, http.StatusOK, &requests)
+
+		result, err := client.ExecuteIceberg(ctx, req, wait)
+		assert.True(t, errors.Is(err, context.Canceled))
+
+		require.NotNil(t, result)
+		require.Len(t, result.Orders, 1)
+		assert.Equal(t, "0.2", result.FilledQuantity.String())
+		assert.Equal(t, "0.8", result.RemainingQuantity.String())
+
+		require.GreaterOrEqual(t, len(requests), 2)
+		assert.Equal(t, cdcexchange.MethodCancelOrder, requests[len(requests)-2].Method)
+		assert.Equal(t, map[string]interface{}{"instrument_name": "BTC_USDT", "order_id": "1"}, requests[len(requests)-2].Params)
+		assert.Equal(t, cdcexchange.MethodGetOrderDetail, requests[len(requests)-1].Method)
+	})
+
+	t.Run("returns the error cancelling the open clip", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		t.Cleanup(cancel)
+
+		var requests []api.Request
+		client := twapServer(t, cdcexchange.OrderStatusActive, "0.5", func() { time.AfterFunc(10*time.Millisecond, cancel) }, http.StatusBadRequest, &requests)
+
+		result, err := client.ExecuteIceberg(ctx, req, wait)
+		assert.True(t, errors.Is(err, context.Canceled))
+		assert.True(t, errors.Is(err, cdcerrors.ErrInvalidOrderID))
+
+		require.NotNil(t, result)
+		assert.Equal(t, "0.2", result.FilledQuantity.String())
+	})
+
+	t.Run("returns error when params are invalid", func(t *testing.T) {
+		client, err := cdcexchange.New("api key", "secret key")
+		require.NoError(t, err)
+
+		tests := []struct {
+			name        string
+			modify      func(req *cdcexchange.IcebergRequest)
+			expectedErr error
+		}{
+			{
+				name:        "empty instrument",
+				modify:      func(req *cdcexchange.IcebergRequest) { req.InstrumentName = "" },
+				expectedErr: cdcerrors.InvalidParameterError{Parameter: "req.InstrumentName", Reason: "cannot be empty"},
+			},
+			{
+				name:        "invalid side",
+				modify:      func(req *cdcexchange.IcebergRequest) { req.Side = "" },
+				expectedErr: cdcerrors.InvalidParameterError{Parameter: "req.Side", Reason: "must be BUY or SELL"},
+			},
+			{
+				name:        "empty price",
+				modify:      func(req *cdcexchange.IcebergRequest) { req.Price = cdcexchange.Decimal{} },
+				expectedErr: cdcerrors.InvalidParameterError{Parameter: "req.Price", Reason: "must be greater than 0"},
+			},
+			{
+				name:        "empty quantity",
+				modify:      func(req *cdcexchange.IcebergRequest) { req.Quantity = cdcexchange.Decimal{} },
+				expectedErr: cdcerrors.InvalidParameterError{Parameter: "req.Quantity", Reason: "must be greater than 0"},
+			},
+			{
+				name:        "empty clip size",
+				modify:      func(req *cdcexchange.IcebergRequest) { req.ClipSize = cdcexchange.Decimal{} },
+				expectedErr: cdcerrors.InvalidParameterError{Parameter: "req.ClipSize", Reason: "must be greater than 0"},
+			},
+			{
+				name:        "invalid exec inst",
+				modify:      func(req *cdcexchange.IcebergRequest) { req.ExecInst = "HIDDEN" },
+				expectedErr: cdcerrors.InvalidParameterError{Parameter: "req.ExecInst", Reason: "must be POST_ONLY or SMART_POST_ONLY"},
+			},
+		}
+		for _, tt := range tests {
+			tt := tt
+			t.Run(tt.name, func(t *testing.T) {
+				invalid := req
+				tt.modify(&invalid)
+
+				_, err := client.ExecuteIceberg(context.Background(), invalid)
+				assert.Equal(t, tt.expectedErr, err)
+			})
+		}
+	})
+}
@@ -0,0 +1,233 @@
+package cdcexchange
+
+import (
+	"context"
+	stderrors "errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/sngyai/go-cryptocom/v2/errors"
+)
+
+const (
+	// instrumentsMissRefreshInterval is the minimum time between refreshes of an InstrumentsCache
+	// caused by looking up unknown symbols, so that unknown symbols do not cause a request per lookup.
+	instrumentsMissRefreshInterval = time.Minute
+)
+
+type (
+	// InstrumentsFunc fetches all instruments (e.g. Client.GetInstruments).
+	InstrumentsFunc func(ctx context.Context) ([]Instrument, error)
+
+	// InstrumentsCache caches instruments by symbol, so that the metadata of an instrument can be looked up
+	// without a request per lookup.
+	//
+	// The instruments are fetched on the first lookup, and fetched again on the first lookup after the TTL expires,
+	// or on a lookup of an unknown symbol (at most once a minute), so that newly listed instruments are found.
+	// Concurrent lookups share a single fetch, and lookups which do not need a fetch are not blocked by one in flight.
+	//
+	// If the instruments cannot be fetched again once the TTL has expired, the stale instruments keep being used
+	// (and are fetched again on the next lookup), so the error is only returned if the symbol is not among them.
+	//
+	// An InstrumentsCache is safe for concurrent use.
+	InstrumentsCache struct {
+		fetch InstrumentsFunc
+		now   func() time.Time
+
+		// mu guards the fields below. It is not held while the instruments are fetched.
+		mu          sync.Mutex
+		ttl         time.Duration
+		instruments map[string]Instrument
+		fetchedAt   time.Time
+		// refreshing is the fetch in flight, which lookups needing the instruments fetched wait for (nil if none).
+		refreshing *instrumentsRefresh
+	}
+
+	// instrumentsRefresh is a fetch of the instruments shared by concurrent lookups.
+	instrumentsRefresh struct {
+		// done is closed once the fetch has completed.
+		done        chan struct{}
+		instruments map[string]Instrument
+		err         error
+	}
+)
+
+// NewInstrumentsCache creates an InstrumentsCache which fetches the instruments with fetch,
+// and fetches them again once they are older than ttl (0 caches them until a lookup of an unknown symbol).
+//
+// Every Client has its own InstrumentsCache of the instruments returned by GetInstruments (see Client.InstrumentsCache).
+func NewInstrumentsCache(fetch InstrumentsFunc, ttl time.Duration) (*InstrumentsCache, error) {
+	if fetch == nil {
+		return nil, errors.InvalidParameterError{Parameter: "fetch", Reason: "cannot be empty"}
+	}
+	if ttl < 0 {
+		return nil, errors.InvalidParameterError{Parameter: "ttl", Reason: "cannot be less than 0"}
+	}
+
+	return &InstrumentsCache{fetch: fetch, now: time.Now, ttl: ttl}, nil
+}
+
+// Lookup returns the instrument with symbol, fetching the instruments if they have not been fetched yet,
+// their TTL has expired, or no cached instrument has the symbol. false is returned if no instrument has the symbol.
+func (ic *InstrumentsCache) Lookup(ctx context.Context, symbol string) (Instrument, bool, error) {
+	ic.mu.Lock()
+	instruments, fetchedAt, ttl := ic.instruments, ic.fetchedAt, ic.ttl
+	ic.mu.Unlock()
+
+	var (
+		now        = ic.now()
+		refreshed  bool
+		refreshErr error
+	)
+	if instruments == nil || (ttl > 0 && now.Sub(fetchedAt) >= ttl) {
+		// the stale instruments (if any) are returned along with the error if they cannot be fetched.
+		instruments, refreshErr = ic.refresh(ctx)
+		if refreshErr != nil && instruments == nil {
+			return Instrument{}, false, refreshErr
+		}
+		refreshed = true
+	}
+
+	if instrument, ok := instruments[symbol]; ok {
+		return instrument, true, nil
+	}
+	if refreshErr != nil {
+		return Instrument{}, false, refreshErr
+	}
+
+	if !refreshed && now.Sub(fetchedAt) >= instrumentsMissRefreshInterval {
+		instruments, err := ic.refresh(ctx)
+		if err != nil {
+			return Instrument{}, false, err
+		}
+
+		instrument, ok := instruments[symbol]
+		return instrument, ok, nil
+	}
+
+	return Instrument{}, false, nil
+}
+
+// Refresh fetches the instruments immediately (or waits for the fetch in flight), replacing the cached instruments.
+func (ic *InstrumentsCache) Refresh(ctx context.Context) error {
+	_, err := ic.refresh(ctx)
+	return err
+}
+
+// refresh fetches the instruments, or waits for the fetch in flight, and returns them. If they cannot be fetched,
+// the cached instruments are kept and returned along with the error.
+//
+// If the fetch waited for fails because the ctx of the lookup which started it is done, it is started again.
+func (ic *InstrumentsCache) refresh(ctx context.Context) (map[string]Instrument, error) {
+	for {
+		ic.mu.Lock()
+		call, started := ic.refreshing, false
+		if call == nil {
+			call, started = &instrumentsRefresh{done: make(chan struct{})}, true
+			ic.refreshing = call
+		}
+		ic.mu.Unlock()
+
+		if started {
+			ic.fetchInstruments(ctx, call)
+			return call.instruments, call.err
+		}
+
+		select {
+		case <-ctx.Done():
+			ic.mu.Lock()
+			defer ic.mu.Unlock()
+
+			return ic.instruments, ctx.Err()
+		case <-call.done:
+		}
+
+		if stderrors.Is(call.err, context.Canceled) || stderrors.Is(call.err, context.DeadlineExceeded) {
+			continue
+		}
+
+		return call.instruments, call.err
+	}
+}
+
+// fetchInstruments fetches the instruments for call, replacing the cached instruments if they are fetched.
+func (ic *InstrumentsCache) fetchInstruments(ctx context.Context, call *instrumentsRefresh) {
+	fetched, err := ic.fetch(ctx)
+
+	ic.mu.Lock()
+	defer ic.mu.Unlock()
+
+	if err == nil {
+		ic.instruments = make(map[string]Instrument, len(fetched))
+		for _, instrument := range fetched {
+			ic.instruments[instrument.Symbol] = instrument
+		}
+		ic.fetchedAt = ic.now()
+	}
+
+	call.instruments, call.err = ic.instruments, err
+	ic.refreshing = nil
+	close(call.done)
+}
+
+// setTTL sets the TTL of the cached instruments.
+func (ic *InstrumentsCache) setTTL(ttl time.Duration) {
+	ic.mu.Lock()
+	defer ic.mu.Unlock()
+
+	ic.ttl = ttl
+}
+
+// InstrumentsCache returns the InstrumentsCache of the instruments returned by GetInstruments,
+// which is used to validate orders (see WithOrderValidation) and round prices and quantities (see RoundToTick and RoundToLot).
+//
+// The instruments are cached until an unknown symbol is looked up, unless WithInstrumentsCacheTTL is used.
+func (c *Client) InstrumentsCache() *InstrumentsCache {
+	return c.instruments
+}
+
+// instrument returns the instrument with symbol name from the InstrumentsCache of the Client.
+// false is returned if no instrument has the symbol.
+func (c *Client) instrument(ctx context.Context, name string) (Instrument, bool, error) {
+	return c.instruments.Lookup(ctx, name)
+}
+
+// RoundToTick rounds price to a multiple of the price tick size of instrument in the direction of mode
+// (e.g. RoundDown for the price of a buy order), using the InstrumentsCache of the Client.
+//
+// An errors.InvalidParameterError is returned if instrument is not a known instrument.
+func (c *Client) RoundToTick(ctx context.Context, instrument string, price Decimal, mode RoundingMode) (Decimal, error) {
+	i, err := c.knownInstrument(ctx, instrument)
+	if err != nil {
+		return Decimal{}, err
+	}
+
+	return i.RoundToTick(price, mode), nil
+}
+
+// RoundToLot rounds quantity to a multiple of the quantity tick size of instrument in the direction of mode
+// (e.g. RoundTowardZero so that it never exceeds the available balance), using the InstrumentsCache of the Client.
+//
+// An errors.InvalidParameterError is returned if instrument is not a known instrument.
+func (c *Client) RoundToLot(ctx context.Context, instrument string, quantity Decimal, mode RoundingMode) (Decimal, error) {
+	i, err := c.knownInstrument(ctx, instrument)
+	if err != nil {
+		return Decimal{}, err
+	}
+
+	return i.RoundToLot(quantity, mode), nil
+}
+
+// knownInstrument returns the instrument with symbol name, or an errors.InvalidParameterError if no instrument has the symbol.
+func (c *Client) knownInstrument(ctx context.Context, name string) (Instrument, error) {
+	instrument, ok, err := c.instrument(ctx, name)
+	if err != nil {
+		return Instrument{}, fmt.Errorf("failed to get instrument: %w", err)
+	}
+	if !ok {
+		return Instrument{}, errors.InvalidParameterError{Parameter: "instrument", Reason: fmt.Sprintf("%q is not a known instrument", name)}
+	}
+
+	return instrument, nil
+}
@@ -0,0 +1,283 @@
+package cdcexchange_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/jonboulle/clockwork"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	cdcexchange "github.com/sngyai/go-cryptocom/v2"
+	cdcerrors "github.com/sngyai/go-cryptocom/v2/errors"
+)
+
+func TestClient_RoundToTickAndLot(t *testing.T) {
+	var requests int
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Contains(t, r.URL.Path, cdcexchange.MethodGetInstruments)
+		requests++
+
+		_, err := w.Write([]byte(`{"id": 1, "code": 0, "result": {"data": [
+			{"symbol": "BTC_USD", "price_tick_size": "0.01", "qty_tick_size": "0.00001", "tradable": true}
+		]}}`))
+		require.NoError(t, err)
+	}))
+	t.Cleanup(s.Close)
+
+	client, err := cdcexchange.New("api key", "secret key",
+		cdcexchange.WithHTTPClient(s.Client()),
+		cdcexchange.WithBaseURL(fmt.Sprintf("%s/", s.URL)),
+	)
+	require.NoError(t, err)
+
+	ctx := context.Background()
+
+	price, err := client.RoundToTick(ctx, "BTC_USD", cdcexchange.MustParseDecimal("19600.019"), cdcexchange.RoundDown)
+	require.NoError(t, err)
+	assert.Equal(t, "19600.01", price.String())
+
+	price, err = client.RoundToTick(ctx, "BTC_USD", cdcexchange.MustParseDecimal("19600.011"), cdcexchange.RoundUp)
+	require.NoError(t, err)
+	assert.Equal(t, "19600.02", price.String())
+
+	quantity, err := client.RoundToLot(ctx, "BTC_USD", cdcexchange.MustParseDecimal("0.123456"), cdcexchange.RoundTowardZero)
+	require.NoError(t, err)
+	assert.Equal(t, "0.12345", quantity.String())
+
+	_, err = client.RoundToLot(ctx, "ABC_USD", cdcexchange.MustParseDecimal("1"), cdcexchange.RoundNearest)
+	assert.Equal(t, cdcerrors.InvalidParameterError{Parameter: "instrument", Reason: `"ABC_USD" is not a known instrument`}, err)
+
+	assert.Equal(t, 1, requests)
+}
+
+func TestNewInstrumentsCache(t *testing.T) {
+	var fetches int
+	cache, err := cdcexchange.NewInstrumentsCache(func(ctx context.Context) ([]cdcexchange.Instrument, error) {
+		fetches++
+		return []cdcexchange.Instrument{{Symbol: "BTC_USD"}}, nil
+	}, time.Hour)
+	require.NoError(t, err)
+
+	ctx := context.Background()
+
+	instrument, ok, err := cache.Lookup(ctx, "BTC_USD")
+	require.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, cdcexchange.Instrument{Symbol: "BTC_USD"}, instrument)
+
+	_, ok, err = cache.Lookup(ctx, "ABC_USD")
+	require.NoError(t, err)
+	assert.False(t, ok)
+	assert.Equal(t, 1, fetches)
+
+	require.NoError(t, cache.Refresh(ctx))
+	assert.Equal(t, 2, fetches)
+}
+
+func TestNewInstrumentsCache_Error(t *testing.T) {
+	fetch := func(ctx context.Context) ([]cdcexchange.Instrument, error) { return nil, nil }
+
+	_, err := cdcexchange.NewInstrumentsCache(nil, 0)
+	assert.Equal(t, cdcerrors.InvalidParameterError{Parameter: "fetch", Reason: "cannot be empty"}, err)
+
+	_, err = cdcexchange.NewInstrumentsCache(fetch, -time.Second)
+	assert.Equal(t, cdcerrors.InvalidParameterError{Parameter: "ttl", Reason: "cannot be less than 0"}, err)
+
+	someErr := errors.New("some error")
+	cache, err := cdcexchange.NewInstrumentsCache(func(ctx context.Context) ([]cdcexchange.Instrument, error) {
+		return nil, someErr
+	}, 0)
+	require.NoError(t, err)
+
+	_, _, err = cache.Lookup(context.Background(), "BTC_USD")
+	assert.ErrorIs(t, err, someErr)
+}
+
+// waitingContext signals waiting whenever Done is called, i.e. when a lookup waits for the fetch in flight.
+type waitingContext struct {
+	context.Context
+	waiting chan<- struct{}
+}
+
+func (ctx waitingContext) Done() <-chan struct{} {
+	ctx.waiting <- struct{}{}
+	return ctx.Context.Done()
+}
+
+func TestInstrumentsCache_Concurrency(t *testing.T) {
+	var (
+		fetches atomic.Int32
+		fetched = make(chan struct{})
+		release = make(chan struct{})
+	)
+	cache, err := cdcexchange.NewInstrumentsCache(func(ctx context.Context) ([]cdcexchange.Instrument, error) {
+		if fetches.Add(1) > 1 {
+			close(fetched)
+			<-release
+		}
+		return []cdcexchange.Instrument{{Symbol: "BTC_USD"}}, nil
+	}, 0)
+	require.NoError(t, err)
+
+	ctx := context.Background()
+
+	_, _, err = cache.Lookup(ctx, "BTC_USD")
+	require.NoError(t, err)
+
+	refreshErrs := make(chan error, 10)
+	go func() { refreshErrs <- cache.Refresh(ctx) }()
+	<-fetched
+
+	waiting := make(chan struct{})
+	for i := 1; i < cap(refreshErrs); i++ {
+		go func() { refreshErrs <- cache.Refresh(waitingContext{Context: ctx, waiting: waiting}) }()
+	}
+	for i := 1; i < cap(refreshErrs); i++ {
+		<-waiting
+	}
+
+	// lookups which do not need a fetch are not blocked by the fetch in flight
+	_, ok, err := cache.Lookup(ctx, "BTC_USD")
+	require.NoError(t, err)
+	assert.True(t, ok)
+
+	close(release)
+	for i := 0; i < cap(refreshErrs); i++ {
+		assert.NoError(t, <-refreshErrs)
+	}
+
+	// the concurrent refreshes share a single fetch
+	assert.Equal(t, int32(2), fetches.Load())
+}
+
+func TestClient_InstrumentsCache_StaleOnError(t *testing.T) {
+	var (
+		fail  bool
+		clock = clockwork.NewFakeClock()
+	)
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if fail {
+			w.WriteHeader(http.StatusInternalServerError)
+			_, err := w.Write([]byte(`{"id": 1, "code": 10001}`))
+			require.NoError(t, err)
+			return
+		}
+
+		_, err := w.Write([]byte(`{"id": 1, "code": 0, "result": {"data": [{"symbol": "BTC_USD"}]}}`))
+		require.NoError(t, err)
+	}))
+	t.Cleanup(s.Close)
+
+	client, err := cdcexchange.New("api key", "secret key",
+		cdcexchange.WithHTTPClient(s.Client()),
+		cdcexchange.WithBaseURL(fmt.Sprintf("%s/", s.URL)),
+		cdcexchange.WithClock(clock),
+		cdcexchange.WithInstrumentsCacheTTL(time.Hour),
+	)
+	require.NoError(t, err)
+
+	var (
+		ctx   = context.Background()
+		cache = client.InstrumentsCache()
+	)
+
+	_, _, err = cache.Lookup(ctx, "BTC_USD")
+	require.NoError(t, err)
+
+	fail = true
+	clock.Advance(time.Hour)
+
+	// the stale instruments are used once the ttl has expired if they cannot be fetched
+	instrument, ok, err := cache.Lookup(ctx, "BTC_USD")
+	require.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, "BTC_USD", instrument.Symbol)
+
+	_, ok, err = cache.Lookup(ctx, "ETH_USD")
+	assert.ErrorIs(t, err, cdcerrors.ErrSystemError)
+	assert.False(t, ok)
+
+	assert.ErrorIs(t, cache.Refresh(ctx), cdcerrors.ErrSystemError)
+}
+
+func TestClient_InstrumentsCache_Refresh(t *testing.T) {
+	var (
+		requests int
+		clock    = clockwork.NewFakeClock()
+	)
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+
+		data := `{"symbol": "BTC_USD", "price_tick_size": "0.01"}`
+		if requests > 1 {
+			data += `, {"symbol": "ETH_USD", "price_tick_size": "0.1"}`
+		}
+
+		_, err := fmt.Fprintf(w, `{"id": 1, "code": 0, "result": {"data": [%s]}}`, data)
+		require.NoError(t, err)
+	}))
+	t.Cleanup(s.Close)
+
+	client, err := cdcexchange.New("api key", "secret key",
+		cdcexchange.WithHTTPClient(s.Client()),
+		cdcexchange.WithBaseURL(fmt.Sprintf("%s/", s.URL)),
+		cdcexchange.WithClock(clock),
+		cdcexchange.WithInstrumentsCacheTTL(time.Hour),
+	)
+	require.NoError(t, err)
+
+	var (
+		ctx   = context.Background()
+		cache = client.InstrumentsCache()
+	)
+
+	_, ok, err := cache.Lookup(ctx, "BTC_USD")
+	require.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, 1, requests)
+
+	t.Run("does not refresh on a miss within a minute of the last fetch", func(t *testing.T) {
+		clock.Advance(30 * time.Second)
+
+		_, ok, err := cache.Lookup(ctx, "ETH_USD")
+		require.NoError(t, err)
+		assert.False(t, ok)
+		assert.Equal(t, 1, requests)
+	})
+
+	t.Run("refreshes on a miss a minute after the last fetch", func(t *testing.T) {
+		clock.Advance(30 * time.Second)
+
+		instrument, ok, err := cache.Lookup(ctx, "ETH_USD")
+		require.NoError(t, err)
+		assert.True(t, ok)
+		assert.Equal(t, "0.1", instrument.PriceTickSize.String())
+		assert.Equal(t, 2, requests)
+	})
+
+	t.Run("refreshes once the ttl expires", func(t *testing.T) {
+		clock.Advance(59 * time.Minute)
+
+		_, _, err := cache.Lookup(ctx, "BTC_USD")
+		require.NoError(t, err)
+		assert.Equal(t, 2, requests)
+
+		clock.Advance(time.Minute)
+
+		_, _, err = cache.Lookup(ctx, "BTC_USD")
+		require.NoError(t, err)
+		assert.Equal(t, 3, requests)
+	})
+}
+
+func TestClient_WithInstrumentsCacheTTL_Error(t *testing.T) {
+	_, err := cdcexchange.New("api key", "secret key", cdcexchange.WithInstrumentsCacheTTL(0))
+	assert.Equal(t, cdcerrors.InvalidParameterError{Parameter: "ttl", Reason: "must be greater than 0"}, err)
+}
@@ -0,0 +1,48 @@
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// readMethods are the private methods which do not start with get-, but do not mutate the account either.
+var readMethods = map[string]bool{
+	"private/user-balance":         true,
+	"private/user-balance-history": true,
+}
+
+// isMutating returns whether method changes the state of the account (e.g. creates an order, withdraws or transfers funds).
+//
+// Public methods, get-* methods and the balance methods are not mutating.
+func isMutating(method string) bool {
+	if !strings.HasPrefix(method, "private/") || readMethods[method] {
+		return false
+	}
+
+	return !strings.HasPrefix(method[strings.LastIndex(method, "/")+1:], "get-")
+}
+
+// dryRunResponse synthesizes a successful response to body, with the params of the request echoed as the result.
+func dryRunResponse(body Request) ([]byte, error) {
+	params := body.Params
+	if params == nil {
+		params = make(map[string]interface{})
+	}
+
+	b, err := json.Marshal(struct {
+		ID     int64                  `json:"id"`
+		Method string                 `json:"method"`
+		Code   int                    `json:"code"`
+		Result map[string]interface{} `json:"result"`
+	}{
+		ID:     body.ID,
+		Method: body.Method,
+		Result: params,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal dry run response: %w", err)
+	}
+
+	return b, nil
+}
@@ -0,0 +1,149 @@
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httputil"
+	"sync"
+
+	"github.com/sngyai/go-cryptocom/v2/internal/auth"
+)
+
+// sensitiveHeaders are redacted from dumps.
+var sensitiveHeaders = []string{"Authorization", "Proxy-Authorization", "Cookie", "Set-Cookie"}
+
+// Dumper writes a dump of every request sent and the response received, for troubleshooting.
+//
+// The API key, signature and signature payload of signed requests are shown, so that signature mismatches
+// can be investigated. The secret key is never sent, so never dumped, and sensitive headers are redacted.
+type Dumper struct {
+	mu sync.Mutex
+	w  io.Writer
+}
+
+// NewDumper returns a Dumper writing to w.
+func NewDumper(w io.Writer) *Dumper {
+	return &Dumper{w: w}
+}
+
+// Middleware returns a Middleware dumping every request passed through it.
+func (d *Dumper) Middleware(next RoundTripFunc) RoundTripFunc {
+	return func(req *http.Request) (*http.Response, error) {
+		reqDump := d.dumpRequest(req)
+
+		res, err := next(req)
+
+		var resDump []byte
+		if err == nil {
+			resDump = d.dumpResponse(res)
+		}
+
+		d.write(reqDump, resDump, err)
+
+		return res, err
+	}
+}
+
+func (d *Dumper) dumpRequest(req *http.Request) []byte {
+	dumpReq := req.Clone(req.Context())
+	dumpReq.Header = redactHeaders(req.Header)
+
+	var body []byte
+	if req.GetBody != nil {
+		rc, err := req.GetBody()
+		if err != nil {
+			return []byte(fmt.Sprintf("failed to get request body: %s\n", err))
+		}
+		body, err = io.ReadAll(rc)
+		rc.Close()
+		if err != nil {
+			return []byte(fmt.Sprintf("failed to read request body: %s\n", err))
+		}
+		dumpReq.Body = io.NopCloser(bytes.NewReader(body))
+	}
+
+	dump, err := httputil.DumpRequestOut(dumpReq, true)
+	if err != nil {
+		return []byte(fmt.Sprintf("failed to dump request: %s\n", err))
+	}
+
+	if payload, ok := signaturePayload(body); ok {
+		dump = append(dump, fmt.Sprintf("\n# signature payload: %s", payload)...)
+	}
+
+	return dump
+}
+
+func (d *Dumper) dumpResponse(res *http.Response) []byte {
+	dumpRes := *res
+	dumpRes.Header = redactHeaders(res.Header)
+
+	dump, err := httputil.DumpResponse(&dumpRes, true)
+	// DumpResponse replaces the body it reads with a copy, which the caller still needs to read.
+	res.Body = dumpRes.Body
+	if err != nil {
+		return []byte(fmt.Sprintf("failed to dump response: %s\n", err))
+	}
+
+	return dump
+}
+
+func (d *Dumper) write(reqDump []byte, resDump []byte, err error) {
+	var buf bytes.Buffer
+	buf.WriteString("---- request ----\n")
+	buf.Write(bytes.TrimRight(reqDump, "\r\n"))
+	buf.WriteString("\n---- response ----\n")
+	if err != nil {
+		fmt.Fprintf(&buf, "error: %s", err)
+	} else {
+		buf.Write(bytes.TrimRight(resDump, "\r\n"))
+	}
+	buf.WriteString("\n\n")
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	// errors writing the dump are ignored, so that they never fail the request.
+	_, _ = d.w.Write(buf.Bytes())
+}
+
+// signaturePayload returns the payload signed for a signed request body.
+func signaturePayload(body []byte) (string, bool) {
+	if len(body) == 0 {
+		return "", false
+	}
+
+	var req Request
+	decoder := json.NewDecoder(bytes.NewReader(body))
+	decoder.UseNumber()
+	if err := decoder.Decode(&req); err != nil || req.Signature == "" {
+		return "", false
+	}
+
+	payload, err := auth.Generator{}.Payload(auth.SignatureRequest{
+		APIKey:    req.APIKey,
+		ID:        req.ID,
+		Method:    req.Method,
+		Timestamp: req.Nonce,
+		Params:    req.Params,
+	})
+	if err != nil {
+		return "", false
+	}
+
+	return payload, true
+}
+
+func redactHeaders(header http.Header) http.Header {
+	header = header.Clone()
+	for _, name := range sensitiveHeaders {
+		if _, ok := header[name]; ok {
+			header[name] = []string{redacted}
+		}
+	}
+
+	return header
+}
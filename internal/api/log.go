@@ -0,0 +1,63 @@
+package api
+
+import (
+	"context"
+	"log/slog"
+	"time"
+)
+
+// redacted replaces credentials in logs.
+const redacted = "[REDACTED]"
+
+// LogValue implements slog.LogValuer, so that the API key and signature are never logged.
+func (r Request) LogValue() slog.Value {
+	return slog.GroupValue(
+		slog.Int64("id", r.ID),
+		slog.String("method", r.Method),
+		slog.Int64("nonce", r.Nonce),
+		slog.Any("params", r.Params),
+		slog.String("api_key", redact(r.APIKey)),
+		slog.String("sig", redact(r.Signature)),
+	)
+}
+
+func redact(s string) string {
+	if s == "" {
+		return ""
+	}
+	return redacted
+}
+
+// logResult logs the outcome of a single attempt of a request for method.
+//
+// Successful requests are logged at debug level, and failed requests at info level.
+func (r Requester) logResult(ctx context.Context, method string, attempt int, latency time.Duration, statusCode int, body []byte, err error) {
+	if r.Logger == nil {
+		return
+	}
+
+	attrs := []slog.Attr{
+		slog.String("method", method),
+		slog.Int("attempt", attempt),
+		slog.Duration("latency", latency),
+	}
+
+	if err != nil {
+		attrs = append(attrs, slog.String("error", err.Error()))
+		r.Logger.LogAttrs(ctx, slog.LevelInfo, "request failed", attrs...)
+		return
+	}
+
+	code := responseCode(body)
+	attrs = append(attrs,
+		slog.Int("status", statusCode),
+		slog.String("code", code.String()),
+	)
+
+	if isFailed(statusCode, code) {
+		r.Logger.LogAttrs(ctx, slog.LevelInfo, "error received in response", attrs...)
+		return
+	}
+
+	r.Logger.LogAttrs(ctx, slog.LevelDebug, "request completed", attrs...)
+}
@@ -0,0 +1,34 @@
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/sngyai/go-cryptocom/v2/errors"
+)
+
+// defaultMaintenanceRetryAfter is the estimated wait before retrying when the Exchange is under maintenance
+// without returning a Retry-After header.
+const defaultMaintenanceRetryAfter = time.Minute
+
+// maintenanceError returns errors.MaintenanceError if the response shows the Exchange is under maintenance, otherwise nil.
+//
+// During maintenance the Exchange returns 503 Service Unavailable, or a maintenance page instead of a JSON response.
+func maintenanceError(statusCode int, header http.Header, body []byte) error {
+	if json.Valid(body) {
+		return nil
+	}
+
+	if statusCode != http.StatusServiceUnavailable && !bytes.Contains(bytes.ToLower(body), []byte("maintenance")) {
+		return nil
+	}
+
+	wait, ok := retryAfter(header)
+	if !ok {
+		wait = defaultMaintenanceRetryAfter
+	}
+
+	return errors.MaintenanceError{HTTPStatusCode: statusCode, RetryAfter: wait}
+}
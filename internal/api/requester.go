@@ -4,16 +4,145 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	stderrors "errors"
 	"fmt"
 	"io"
+	"log/slog"
+	"math/rand"
+	"net"
 	"net/http"
+	"strconv"
+	"strings"
+	"time"
 
-	"github.com/sngyai/go-cryptocom/errors"
+	"github.com/jonboulle/clockwork"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/sngyai/go-cryptocom/v2/errors"
+	"github.com/sngyai/go-cryptocom/v2/internal/circuitbreaker"
+	"github.com/sngyai/go-cryptocom/v2/internal/ratelimit"
 )
 
+// Limiter paces requests, in the style of golang.org/x/time/rate.Limiter.
+type Limiter interface {
+	// Wait blocks until a request is allowed, or ctx is done.
+	Wait(ctx context.Context) error
+}
+
+// RoundTripFunc sends a request and returns its response.
+type RoundTripFunc func(req *http.Request) (*http.Response, error)
+
+// Middleware wraps the RoundTripFunc of every request.
+type Middleware func(next RoundTripFunc) RoundTripFunc
+
 type Requester struct {
 	Client  *http.Client
 	BaseURL string
+	// Version is the default API version path requests are made against (V1 if empty).
+	Version string
+	// MethodVersions overrides the API version path of individual methods.
+	MethodVersions map[string]string
+	// RateLimiter paces requests of each method to the documented limits (not rate limited if nil).
+	RateLimiter *ratelimit.MethodLimiter
+	// Limiter paces all requests, regardless of method (not rate limited if nil).
+	Limiter Limiter
+	// RateLimitRetry configures retries of requests which were rate limited by the Exchange (not retried if nil).
+	RateLimitRetry *RateLimitRetry
+	// RetryPolicy configures retries of idempotent requests after transient failures (not retried if nil).
+	RetryPolicy *RetryPolicy
+	// CircuitBreaker fails requests fast after consecutive failures (no circuit breaker if nil).
+	CircuitBreaker *circuitbreaker.Breaker
+	// Middlewares wrap every request sent, the first being the outermost.
+	Middlewares []Middleware
+	// Logger logs every request sent, with credentials redacted (not logged if nil).
+	Logger *slog.Logger
+	// Dumper dumps every request sent and the response received, inside any Middlewares (not dumped if nil).
+	Dumper *Dumper
+	// Tracer creates a span for every request (not traced if nil).
+	Tracer trace.Tracer
+	// Metrics records the outcome of every request (not recorded if nil).
+	Metrics Metrics
+	// ServerClock tracks the offset of the local clock from the clock of the Exchange (not tracked if nil).
+	ServerClock *ServerClock
+	// Clock is the local clock the offset of the ServerClock is measured against (the real clock if nil).
+	Clock clockwork.Clock
+	// UserAgent is the User-Agent header of every request (the default of the HTTP client if empty).
+	UserAgent string
+	// Headers are added to every request, unless the request already has a header of the same name.
+	Headers http.Header
+	// DryRun stops mutating requests being sent, returning a synthesized successful response instead.
+	DryRun bool
+	// ReadOnly stops mutating requests being sent, returning errors.ErrReadOnlyClient instead.
+	ReadOnly bool
+	// VerifyResponseID checks the id of every successful response to a POST request matches the id of the request,
+	// returning errors.ResponseIDMismatchError if it does not.
+	VerifyResponseID bool
+	// UseNumber decodes numbers into interface{} values as json.Number instead of float64.
+	UseNumber bool
+}
+
+// Metrics records the outcome of requests.
+type Metrics interface {
+	// ObserveRequest records a request for method, which returned statusCode and the response code
+	// (both empty if err is not nil) after latency, including any retries.
+	ObserveRequest(method string, statusCode int, code string, latency time.Duration, err error)
+}
+
+// RateLimitRetry configures how requests which were rate limited by the Exchange are retried.
+type RateLimitRetry struct {
+	// Backoff is the wait before the first retry if no Retry-After header is returned, doubled for each retry after.
+	Backoff time.Duration
+	// MaxWait is the maximum total time spent waiting to retry a request.
+	MaxWait time.Duration
+}
+
+// RetryPolicy configures how idempotent requests are retried after transient failures (network errors and 5xx responses).
+type RetryPolicy struct {
+	// MaxRetries is the maximum number of times a request is retried.
+	MaxRetries int
+	// Backoff is the wait before the first retry, doubled for each retry after.
+	Backoff time.Duration
+	// MaxBackoff is the maximum wait between retries (no maximum if 0).
+	MaxBackoff time.Duration
+}
+
+// URL returns the full URL of method.
+//
+// The API version is resolved in the following order:
+//   - the override in MethodVersions for method (if any).
+//   - version, for methods which only exist in a single API version.
+//   - the default Version of the Requester.
+//   - V1.
+func (r Requester) URL(method string, version string) string {
+	if v, ok := r.MethodVersions[method]; ok {
+		version = v
+	}
+	if version == "" {
+		version = r.Version
+	}
+	if version == "" {
+		version = V1
+	}
+
+	return fmt.Sprintf("%s%s%s", r.BaseURL, version, method)
+}
+
+// Wait blocks until a request for method with apiKey (empty for public methods) is allowed
+// by both the RateLimiter and the Limiter, or ctx is done.
+func (r Requester) Wait(ctx context.Context, apiKey string, method string) error {
+	if r.RateLimiter != nil {
+		if err := r.RateLimiter.Wait(ctx, apiKey, method); err != nil {
+			return fmt.Errorf("failed to wait for rate limiter: %w", err)
+		}
+	}
+
+	if r.Limiter != nil {
+		if err := r.Limiter.Wait(ctx); err != nil {
+			return fmt.Errorf("failed to wait for limiter: %w", err)
+		}
+	}
+
+	return nil
 }
 
 func (r Requester) Post(ctx context.Context, body Request, method string, response interface{}) (int, error) {
@@ -25,51 +154,474 @@ func (r Requester) Get(ctx context.Context, body Request, method string, respons
 }
 
 func (r Requester) doRequest(ctx context.Context, httpMethod string, body Request, method string, response interface{}) (int, error) {
+	if r.ReadOnly && isMutating(method) {
+		return 0, errors.ErrReadOnlyClient
+	}
+
 	b, err := json.Marshal(body)
 	if err != nil {
 		return 0, fmt.Errorf("failed to marshal request body: %w", err)
 	}
 
-	version := V1
-	if body.Version != "" {
-		version = body.Version
-	}
-
-	req, err := http.NewRequestWithContext(ctx, httpMethod, fmt.Sprintf("%s%s%s", r.BaseURL, version, method), bytes.NewBuffer(b))
+	req, err := http.NewRequestWithContext(ctx, httpMethod, r.URL(method, body.Version), bytes.NewBuffer(b))
 	if err != nil {
 		return 0, fmt.Errorf("failed to create request: %w", err)
 	}
 	req.Header.Set("Content-Type", "application/json")
 
-	res, err := r.Client.Do(req)
+	if r.DryRun && isMutating(method) {
+		if r.Logger != nil {
+			r.Logger.InfoContext(ctx, "dry run: request not sent", slog.Any("request", body))
+		}
+
+		resBytes, err := dryRunResponse(body)
+		if err != nil {
+			return 0, err
+		}
+
+		if err := r.Unmarshal(resBytes, response); err != nil {
+			return 0, fmt.Errorf("failed to unmarshal dry run response body: %s, error: %w", string(resBytes), err)
+		}
+
+		return http.StatusOK, nil
+	}
+
+	if r.Logger != nil {
+		r.Logger.DebugContext(ctx, "sending request", slog.Any("request", body))
+	}
+
+	statusCode, resBytes, err := r.do(ctx, req, method, body.APIKey, body.Params)
 	if err != nil {
-		return 0, fmt.Errorf("failed to do request: %w", err)
+		return 0, err
+	}
+
+	if err := r.Unmarshal(resBytes, response); err != nil {
+		return 0, fmt.Errorf("failed to unmarshal response body: %s, error: %w", string(resBytes), err)
+	}
+
+	if r.VerifyResponseID && httpMethod == http.MethodPost && statusCode < http.StatusBadRequest {
+		if id := responseID(resBytes); id.String() != strconv.FormatInt(body.ID, 10) {
+			return 0, errors.ResponseIDMismatchError{Method: method, RequestID: body.ID, ResponseID: id.String()}
+		}
+	}
+
+	if res, ok := response.(interface{ SetRaw([]byte) }); ok {
+		res.SetRaw(resBytes)
+	}
+
+	return statusCode, nil
+}
+
+// Unmarshal decodes data into v, decoding numbers into interface{} values as json.Number if UseNumber is set.
+func (r Requester) Unmarshal(data []byte, v interface{}) error {
+	if !r.UseNumber {
+		return json.Unmarshal(data, v)
+	}
+
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	if err := dec.Decode(v); err != nil {
+		return err
+	}
+	if dec.More() {
+		return fmt.Errorf("invalid character after top-level value")
+	}
+
+	return nil
+}
+
+// ServerTime sends req for method and returns the time of the Exchange, estimated from the Date header of the response.
+//
+// The offset of the ServerClock (if any) is re-measured, regardless of its interval.
+func (r Requester) ServerTime(ctx context.Context, req *http.Request, method string) (time.Time, error) {
+	if err := r.Wait(ctx, "", method); err != nil {
+		return time.Time{}, err
+	}
+
+	sent := r.now()
+	statusCode, header, resBytes, err := r.send(req.WithContext(ctx))
+	if err != nil {
+		return time.Time{}, err
+	}
+	received := r.now()
+
+	if err := r.CheckErrorResponse(statusCode, responseCode(resBytes)); err != nil {
+		return time.Time{}, fmt.Errorf("error received in response: %w", err)
+	}
+
+	serverTime, ok := estimateServerTime(header)
+	if !ok {
+		return time.Time{}, fmt.Errorf("invalid Date header in response: %q", header.Get("Date"))
+	}
+
+	if r.ServerClock != nil {
+		r.ServerClock.set(serverTime.Sub(midpoint(sent, received)), received)
+	}
+
+	return serverTime, nil
+}
+
+// now returns the current time of the local Clock.
+func (r Requester) now() time.Time {
+	if r.Clock == nil {
+		return time.Now()
+	}
+
+	return r.Clock.Now()
+}
+
+// Do sends req for method and returns the status code and body of the response.
+//
+// Requests are paced by the rate limiters, retried if rate limited by the Exchange and RateLimitRetry is set,
+// and retried after transient failures if RetryPolicy is set and the request is idempotent.
+func (r Requester) Do(ctx context.Context, req *http.Request, method string) (int, []byte, error) {
+	return r.do(ctx, req, method, "", nil)
+}
+
+// do sends req for method, which was created with apiKey (empty for public methods) and params (nil for GET requests).
+func (r Requester) do(ctx context.Context, req *http.Request, method string, apiKey string, params map[string]interface{}) (int, []byte, error) {
+	ctx, span := r.startSpan(ctx, req, method, params)
+	req = req.WithContext(ctx)
+
+	start := time.Now()
+	statusCode, resBytes, err := r.retry(ctx, req, method, apiKey, isIdempotent(req.Method, method, params))
+	latency := time.Since(start)
+
+	code := responseCode(resBytes)
+	r.endSpan(span, latency, statusCode, code, err)
+	if r.Metrics != nil {
+		r.Metrics.ObserveRequest(method, statusCode, code.String(), latency, err)
+	}
+
+	return statusCode, resBytes, err
+}
+
+// SentRequestError is returned when a request was sent, but no response to it was received (e.g. the connection
+// was reset or the response timed out), so whether the Exchange executed it is unknown.
+//
+// It is not returned for requests which were never sent (e.g. stopped by the rate limiters or the circuit breaker).
+type SentRequestError struct {
+	// Err is the error which left the outcome of the request unknown.
+	Err error
+}
+
+func (sre SentRequestError) Error() string {
+	return sre.Err.Error()
+}
+
+func (sre SentRequestError) Unwrap() error {
+	return sre.Err
+}
+
+// retry sends req until it succeeds, or no more retries are allowed.
+//
+// If an attempt was sent but no response was received, any error returned is a SentRequestError.
+func (r Requester) retry(ctx context.Context, req *http.Request, method string, apiKey string, idempotent bool) (int, []byte, error) {
+	var (
+		waited      time.Duration
+		rateLimited int
+		retries     int
+		// unconfirmed is whether the last attempt was sent without a response being received.
+		unconfirmed bool
+	)
+	fail := func(err error) (int, []byte, error) {
+		if unconfirmed {
+			return 0, nil, SentRequestError{Err: err}
+		}
+		return 0, nil, err
+	}
+	for attempt := 0; ; attempt++ {
+		if attempt > 0 {
+			// the body has been consumed by the previous attempt.
+			req = req.Clone(ctx)
+			if req.GetBody != nil {
+				body, err := req.GetBody()
+				if err != nil {
+					return fail(fmt.Errorf("failed to get request body: %w", err))
+				}
+				req.Body = body
+			}
+		}
+
+		if err := r.Wait(ctx, apiKey, method); err != nil {
+			return fail(err)
+		}
+
+		if r.CircuitBreaker != nil {
+			if err := r.CircuitBreaker.Allow(); err != nil {
+				return fail(fmt.Errorf("failed to do request: %w", err))
+			}
+		}
+
+		start, sent := time.Now(), r.now()
+		statusCode, header, resBytes, err := r.send(req)
+		unconfirmed = err != nil && !isDialError(err)
+		r.logResult(ctx, method, attempt, time.Since(start), statusCode, resBytes, err)
+		if err == nil {
+			r.ServerClock.observe(sent, r.now(), header)
+		}
+
+		if r.CircuitBreaker != nil && !stderrors.Is(err, context.Canceled) {
+			r.CircuitBreaker.Record(err == nil && statusCode < http.StatusInternalServerError)
+		}
+
+		var wait time.Duration
+		switch {
+		case idempotent && ctx.Err() == nil && r.RetryPolicy.retryable(statusCode, err, retries):
+			wait = r.RetryPolicy.backoff(retries)
+			retries++
+		case err != nil:
+			return fail(err)
+		default:
+			var ok bool
+			if wait, ok = r.RateLimitRetry.wait(statusCode, header, resBytes, rateLimited, waited); !ok {
+				if err := maintenanceError(statusCode, header, resBytes); err != nil {
+					return 0, nil, err
+				}
+				return statusCode, resBytes, nil
+			}
+			rateLimited++
+			waited += wait
+		}
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return fail(fmt.Errorf("failed to wait to retry request: %w", ctx.Err()))
+		case <-timer.C:
+		}
+	}
+}
+
+func (r Requester) send(req *http.Request) (int, http.Header, []byte, error) {
+	r.setHeaders(req)
+
+	roundTrip := RoundTripFunc(r.Client.Do)
+	if r.Dumper != nil {
+		roundTrip = r.Dumper.Middleware(roundTrip)
+	}
+	for i := len(r.Middlewares) - 1; i >= 0; i-- {
+		roundTrip = r.Middlewares[i](roundTrip)
+	}
+
+	res, err := roundTrip(req)
+	if err != nil {
+		return 0, nil, nil, fmt.Errorf("failed to do request: %w", err)
 	}
 	defer res.Body.Close()
 
 	resBytes, err := io.ReadAll(res.Body)
 	if err != nil {
-		return 0, fmt.Errorf("failed to read response body: %w", err)
+		return 0, nil, nil, fmt.Errorf("failed to read response body: %w", err)
 	}
 
-	if err := json.Unmarshal(resBytes, &response); err != nil {
-		return 0, fmt.Errorf("failed to unmarshal response body: %s, error: %w", string(resBytes), err)
+	return res.StatusCode, res.Header, resBytes, nil
+}
+
+// isDialError returns whether err is a failure to connect to the Exchange (e.g. the host could not be resolved),
+// in which case the request was not sent.
+func isDialError(err error) bool {
+	var opErr *net.OpError
+	return stderrors.As(err, &opErr) && opErr.Op == "dial"
+}
+
+func (r Requester) setHeaders(req *http.Request) {
+	for name, values := range r.Headers {
+		if _, ok := req.Header[name]; !ok {
+			req.Header[name] = values
+		}
 	}
 
-	return res.StatusCode, nil
+	if r.UserAgent != "" {
+		req.Header.Set("User-Agent", r.UserAgent)
+	}
 }
 
-func (Requester) CheckErrorResponse(statusCode int, responseCode json.Number) error {
-	if statusCode >= 400 {
-		code, err := responseCode.Int64()
-		if err != nil {
-			return errors.ResponseError{
-				HTTPStatusCode: statusCode,
-				Err:            fmt.Errorf("invalid response code: %v", responseCode),
+// wait returns how long to wait before retrying a request which was rate limited by the Exchange.
+//
+// false is returned if the response was not rate limited, or if retrying would exceed MaxWait.
+func (rr *RateLimitRetry) wait(statusCode int, header http.Header, body []byte, attempt int, waited time.Duration) (time.Duration, bool) {
+	if rr == nil || !isRateLimited(statusCode, body) {
+		return 0, false
+	}
+
+	wait, ok := retryAfter(header)
+	if !ok {
+		wait = rr.Backoff << attempt
+	}
+
+	if waited+wait > rr.MaxWait {
+		return 0, false
+	}
+
+	return wait, true
+}
+
+// retryable returns whether a request which failed with err, or returned statusCode, should be retried.
+//
+// Only network errors and 5xx responses are retried, up to MaxRetries times.
+func (rp *RetryPolicy) retryable(statusCode int, err error, retries int) bool {
+	if rp == nil || retries >= rp.MaxRetries {
+		return false
+	}
+
+	return err != nil || statusCode >= http.StatusInternalServerError
+}
+
+// backoff returns the wait before the next retry, which doubles for each retry (up to MaxBackoff)
+// with jitter of up to half of the wait, so clients don't retry in lockstep.
+func (rp *RetryPolicy) backoff(retries int) time.Duration {
+	wait := rp.Backoff << retries
+	if wait <= 0 || (rp.MaxBackoff > 0 && wait > rp.MaxBackoff) {
+		wait = rp.MaxBackoff
+	}
+	if wait <= 0 {
+		return 0
+	}
+
+	half := wait / 2
+	return half + time.Duration(rand.Int63n(int64(wait-half)+1))
+}
+
+const (
+	// methodCreateOrder and methodCreateOrderList are the methods the Exchange deduplicates by client_oid.
+	methodCreateOrder     = "private/create-order"
+	methodCreateOrderList = "private/create-order-list"
+)
+
+// isIdempotent returns whether a request for method can safely be sent more than once.
+//
+// All GET requests and get-* methods are idempotent, as well as order creation requests with a client_oid param
+// for every order, which the Exchange uses to reject duplicate orders. Other requests with a client_oid param
+// (e.g. private/amend-order) are not deduplicated by the Exchange, so are not idempotent.
+func isIdempotent(httpMethod string, method string, params map[string]interface{}) bool {
+	if httpMethod == http.MethodGet {
+		return true
+	}
+
+	if strings.HasPrefix(method[strings.LastIndex(method, "/")+1:], "get-") {
+		return true
+	}
+
+	switch method {
+	case methodCreateOrder:
+		return hasClientOID(params)
+	case methodCreateOrderList:
+		orderList, _ := params["order_list"].([]map[string]interface{})
+		for _, order := range orderList {
+			if !hasClientOID(order) {
+				return false
 			}
 		}
-		return errors.NewResponseError(statusCode, code)
+		return len(orderList) > 0
+	default:
+		return false
 	}
+}
 
-	return nil
+// hasClientOID returns whether params has a non-empty client_oid param.
+func hasClientOID(params map[string]interface{}) bool {
+	clientOID, _ := params["client_oid"].(string)
+	return clientOID != ""
+}
+
+// responseCode returns the response code in body, or an empty code if body is not a valid response.
+func responseCode(body []byte) json.Number {
+	var res BaseResponse
+	if err := json.Unmarshal(body, &res); err != nil {
+		return ""
+	}
+
+	return res.Code
+}
+
+// responseID returns the id in body, or an empty id if body is not a valid response.
+func responseID(body []byte) json.Number {
+	var res BaseResponse
+	if err := json.Unmarshal(body, &res); err != nil {
+		return ""
+	}
+
+	return res.ID
+}
+
+// isFailed returns whether statusCode or code is an error.
+func isFailed(statusCode int, code json.Number) bool {
+	return statusCode >= http.StatusBadRequest || (code != "" && code != "0")
+}
+
+// isRateLimited returns whether the response is a rate limit error (HTTP 429, or code 10006/10429).
+func isRateLimited(statusCode int, body []byte) bool {
+	if statusCode == http.StatusTooManyRequests {
+		return true
+	}
+
+	switch responseCode(body) {
+	case "10006", "10429":
+		return true
+	default:
+		return false
+	}
+}
+
+// retryAfter parses the Retry-After header, which is either a number of seconds or an HTTP date.
+func retryAfter(header http.Header) (time.Duration, bool) {
+	v := header.Get("Retry-After")
+	if v == "" {
+		return 0, false
+	}
+
+	if seconds, err := strconv.Atoi(v); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second, true
+	}
+
+	if t, err := http.ParseTime(v); err == nil {
+		wait := time.Until(t)
+		if wait < 0 {
+			wait = 0
+		}
+		return wait, true
+	}
+
+	return 0, false
+}
+
+func (r Requester) CheckErrorResponse(statusCode int, responseCode json.Number) error {
+	return r.CheckResponse(statusCode, BaseResponse{Code: responseCode})
+}
+
+// CheckResponse returns errors.ResponseError if statusCode is an error,
+// with the message and raw body of res to show why the request was rejected.
+func (Requester) CheckResponse(statusCode int, res BaseResponse) error {
+	if statusCode < 400 {
+		return nil
+	}
+
+	message := res.Message
+	switch {
+	case message == "":
+		message = res.Detail
+	case res.Detail != "":
+		message = fmt.Sprintf("%s (%s)", message, res.Detail)
+	}
+
+	code, err := res.Code.Int64()
+	if err != nil {
+		return errors.ResponseError{
+			HTTPStatusCode: statusCode,
+			Err:            fmt.Errorf("invalid response code: %v", res.Code),
+			Message:        message,
+			Raw:            res.Raw,
+		}
+	}
+
+	responseErr, ok := errors.NewResponseError(statusCode, code).(errors.ResponseError)
+	if !ok {
+		return nil
+	}
+	responseErr.Message = message
+	responseErr.Raw = res.Raw
+
+	return responseErr
 }
@@ -8,14 +8,16 @@ import (
 	"io"
 	"io/ioutil"
 	"net/http"
+	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
-	"github.com/sngyai/go-cryptocom/internal/api"
-	cdcerrors "github.com/sngyai/go-cryptocom/errors"
+	"github.com/sngyai/go-cryptocom/v2/internal/api"
+	cdcerrors "github.com/sngyai/go-cryptocom/v2/errors"
 )
 
 type roundTripper struct {
@@ -46,6 +48,51 @@ func (rt roundTripper) RoundTrip(*http.Request) (*http.Response, error) {
 	}, rt.err
 }
 
+func TestRequester_URL(t *testing.T) {
+	const (
+		baseURL = "https://api.crypto.com/"
+		method  = "private/get-trades"
+	)
+
+	tests := []struct {
+		name        string
+		requester   api.Requester
+		version     string
+		expectedURL string
+	}{
+		{
+			name:        "defaults to v1",
+			requester:   api.Requester{BaseURL: baseURL},
+			expectedURL: baseURL + api.V1 + method,
+		},
+		{
+			name:        "uses the default version of the requester",
+			requester:   api.Requester{BaseURL: baseURL, Version: api.V2},
+			expectedURL: baseURL + api.V2 + method,
+		},
+		{
+			name:        "uses the method specific version over the default version",
+			requester:   api.Requester{BaseURL: baseURL, Version: api.V1},
+			version:     api.V2,
+			expectedURL: baseURL + api.V2 + method,
+		},
+		{
+			name: "uses the method override over the method specific version",
+			requester: api.Requester{
+				BaseURL:        baseURL,
+				MethodVersions: map[string]string{method: api.V1},
+			},
+			version:     api.V2,
+			expectedURL: baseURL + api.V1 + method,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expectedURL, tt.requester.URL(method, tt.version))
+		})
+	}
+}
+
 func TestRequester_Post_Error(t *testing.T) {
 	type args struct {
 		ctx    context.Context
@@ -163,12 +210,62 @@ func TestRequester_Post_Success(t *testing.T) {
 			statusCode, err := api.Requester{Client: &tt.client}.Post(ctx, tt.body, tt.method, &response)
 			require.NoError(t, err)
 
+			// the raw body of the response is kept.
+			raw, err := json.Marshal(tt.client.Transport.(roundTripper).response)
+			require.NoError(t, err)
+			tt.expectedResponse.Raw = raw
+
 			assert.Equal(t, tt.expectedResponse, response)
 			assert.Equal(t, tt.expectedStatusCode, statusCode)
 		})
 	}
 }
 
+func TestRequester_Post_VerifyResponseID(t *testing.T) {
+	tests := []struct {
+		name        string
+		statusCode  int
+		responseID  json.Number
+		expectedErr error
+	}{
+		{name: "returns no error when the response id matches", responseID: "1234"},
+		{
+			name:        "returns error when the response id does not match",
+			responseID:  "5678",
+			expectedErr: cdcerrors.ResponseIDMismatchError{Method: "some method", RequestID: 1234, ResponseID: "5678"},
+		},
+		{
+			name:        "returns error when the response has no id",
+			expectedErr: cdcerrors.ResponseIDMismatchError{Method: "some method", RequestID: 1234},
+		},
+		{name: "does not verify error responses", statusCode: http.StatusBadRequest, responseID: "5678"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			response := map[string]interface{}{"code": 0}
+			if tt.responseID != "" {
+				response["id"] = tt.responseID
+			}
+
+			requester := api.Requester{
+				Client:           &http.Client{Transport: roundTripper{statusCode: tt.statusCode, response: response}},
+				VerifyResponseID: true,
+			}
+
+			var res api.BaseResponse
+			_, err := requester.Post(context.Background(), api.Request{ID: 1234}, "some method", &res)
+			if tt.expectedErr == nil {
+				require.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+
+			assert.Equal(t, tt.expectedErr, err)
+			assert.True(t, errors.Is(err, cdcerrors.ErrResponseIDMismatch))
+		})
+	}
+}
+
 func TestRequester_Get_Error(t *testing.T) {
 	type args struct {
 		ctx    context.Context
@@ -286,6 +383,11 @@ func TestRequester_Get_Success(t *testing.T) {
 			statusCode, err := api.Requester{Client: &tt.client}.Get(ctx, tt.body, tt.method, &response)
 			require.NoError(t, err)
 
+			// the raw body of the response is kept.
+			raw, err := json.Marshal(tt.client.Transport.(roundTripper).response)
+			require.NoError(t, err)
+			tt.expectedResponse.Raw = raw
+
 			assert.Equal(t, tt.expectedResponse, response)
 			assert.Equal(t, tt.expectedStatusCode, statusCode)
 		})
@@ -382,3 +484,388 @@ func TestRequester_CheckErrorResponse_Success(t *testing.T) {
 		})
 	}
 }
+
+func TestRequester_Post_RateLimitRetry(t *testing.T) {
+	tests := []struct {
+		name               string
+		retry              *api.RateLimitRetry
+		responses          []func(w http.ResponseWriter)
+		expectedRequests   int
+		expectedStatusCode int
+		expectedCode       json.Number
+	}{
+		{
+			name: "does not retry when rate limit retry is not set",
+			responses: []func(w http.ResponseWriter){
+				func(w http.ResponseWriter) {
+					w.WriteHeader(http.StatusTooManyRequests)
+					_, _ = w.Write([]byte(`{"code": 10429}`))
+				},
+			},
+			expectedRequests:   1,
+			expectedStatusCode: http.StatusTooManyRequests,
+			expectedCode:       "10429",
+		},
+		{
+			name:  "retries after the retry-after header given http 429",
+			retry: &api.RateLimitRetry{Backoff: time.Hour, MaxWait: time.Second},
+			responses: []func(w http.ResponseWriter){
+				func(w http.ResponseWriter) {
+					w.Header().Set("Retry-After", "0")
+					w.WriteHeader(http.StatusTooManyRequests)
+					_, _ = w.Write([]byte(`{"code": 10429}`))
+				},
+				func(w http.ResponseWriter) {
+					_, _ = w.Write([]byte(`{"code": 0}`))
+				},
+			},
+			expectedRequests:   2,
+			expectedStatusCode: http.StatusOK,
+			expectedCode:       "0",
+		},
+		{
+			name:  "retries with backoff given too many requests response code",
+			retry: &api.RateLimitRetry{Backoff: time.Millisecond, MaxWait: time.Second},
+			responses: []func(w http.ResponseWriter){
+				func(w http.ResponseWriter) {
+					w.WriteHeader(http.StatusBadRequest)
+					_, _ = w.Write([]byte(`{"code": 10006}`))
+				},
+				func(w http.ResponseWriter) {
+					w.WriteHeader(http.StatusBadRequest)
+					_, _ = w.Write([]byte(`{"code": 10006}`))
+				},
+				func(w http.ResponseWriter) {
+					_, _ = w.Write([]byte(`{"code": 0}`))
+				},
+			},
+			expectedRequests:   3,
+			expectedStatusCode: http.StatusOK,
+			expectedCode:       "0",
+		},
+		{
+			name:  "stops retrying once the max wait would be exceeded",
+			retry: &api.RateLimitRetry{Backoff: time.Millisecond, MaxWait: 2 * time.Millisecond},
+			responses: []func(w http.ResponseWriter){
+				func(w http.ResponseWriter) {
+					w.WriteHeader(http.StatusTooManyRequests)
+					_, _ = w.Write([]byte(`{"code": 10429}`))
+				},
+				func(w http.ResponseWriter) {
+					w.WriteHeader(http.StatusTooManyRequests)
+					_, _ = w.Write([]byte(`{"code": 10429}`))
+				},
+			},
+			expectedRequests:   2,
+			expectedStatusCode: http.StatusTooManyRequests,
+			expectedCode:       "10429",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var requests int
+			s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				var body api.Request
+				require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+				assert.Equal(t, "some method", body.Method)
+
+				require.Less(t, requests, len(tt.responses))
+				tt.responses[requests](w)
+				requests++
+			}))
+			t.Cleanup(s.Close)
+
+			requester := api.Requester{
+				Client:         s.Client(),
+				BaseURL:        s.URL + "/",
+				RateLimitRetry: tt.retry,
+			}
+
+			var response api.BaseResponse
+			statusCode, err := requester.Post(context.Background(), api.Request{Method: "some method"}, "some method", &response)
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.expectedRequests, requests)
+			assert.Equal(t, tt.expectedStatusCode, statusCode)
+			assert.Equal(t, tt.expectedCode, response.Code)
+		})
+	}
+}
+
+func TestRequester_Post_RetryPolicy(t *testing.T) {
+	policy := &api.RetryPolicy{MaxRetries: 2, Backoff: time.Millisecond}
+
+	tests := []struct {
+		name             string
+		policy           *api.RetryPolicy
+		method           string
+		params           map[string]interface{}
+		failures         int
+		expectedRequests int
+		expectedCode     int
+	}{
+		{
+			name:             "does not retry when retry policy is not set",
+			method:           "private/get-order-detail",
+			failures:         1,
+			expectedRequests: 1,
+			expectedCode:     http.StatusServiceUnavailable,
+		},
+		{
+			name:             "retries get methods given 5xx response",
+			policy:           policy,
+			method:           "private/get-order-detail",
+			failures:         2,
+			expectedRequests: 3,
+			expectedCode:     http.StatusOK,
+		},
+		{
+			name:             "stops retrying after max retries",
+			policy:           policy,
+			method:           "private/get-order-detail",
+			failures:         3,
+			expectedRequests: 3,
+			expectedCode:     http.StatusServiceUnavailable,
+		},
+		{
+			name:             "does not retry order creation without client oid",
+			policy:           policy,
+			method:           "private/create-order",
+			params:           map[string]interface{}{"instrument_name": "BTC_USDT"},
+			failures:         1,
+			expectedRequests: 1,
+			expectedCode:     http.StatusServiceUnavailable,
+		},
+		{
+			name:             "retries order creation with client oid",
+			policy:           policy,
+			method:           "private/create-order",
+			params:           map[string]interface{}{"client_oid": "some client oid"},
+			failures:         1,
+			expectedRequests: 2,
+			expectedCode:     http.StatusOK,
+		},
+		{
+			name:   "retries order list creation with a client oid for every order",
+			policy: policy,
+			method: "private/create-order-list",
+			params: map[string]interface{}{"order_list": []map[string]interface{}{
+				{"client_oid": "some client oid"},
+				{"client_oid": "some other client oid"},
+			}},
+			failures:         1,
+			expectedRequests: 2,
+			expectedCode:     http.StatusOK,
+		},
+		{
+			name:   "does not retry order list creation without a client oid for every order",
+			policy: policy,
+			method: "private/create-order-list",
+			params: map[string]interface{}{"order_list": []map[string]interface{}{
+				{"client_oid": "some client oid"},
+				{"instrument_name": "BTC_USDT"},
+			}},
+			failures:         1,
+			expectedRequests: 1,
+			expectedCode:     http.StatusServiceUnavailable,
+		},
+		{
+			name:             "does not retry order amendment with client oid",
+			policy:           policy,
+			method:           "private/amend-order",
+			params:           map[string]interface{}{"client_oid": "some client oid", "new_price": "1"},
+			failures:         1,
+			expectedRequests: 1,
+			expectedCode:     http.StatusServiceUnavailable,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var requests int
+			s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				var body api.Request
+				require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+				assert.Equal(t, tt.method, body.Method)
+
+				requests++
+				if requests <= tt.failures {
+					w.WriteHeader(http.StatusServiceUnavailable)
+				}
+				_, _ = w.Write([]byte(`{"code": 0}`))
+			}))
+			t.Cleanup(s.Close)
+
+			requester := api.Requester{
+				Client:      s.Client(),
+				BaseURL:     s.URL + "/",
+				RetryPolicy: tt.policy,
+			}
+
+			var response api.BaseResponse
+			statusCode, err := requester.Post(context.Background(), api.Request{Method: tt.method, Params: tt.params}, tt.method, &response)
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.expectedRequests, requests)
+			assert.Equal(t, tt.expectedCode, statusCode)
+		})
+	}
+}
+
+func TestRequester_Do_RetryPolicy_NetworkError(t *testing.T) {
+	var requests int
+	requester := api.Requester{
+		Client: &http.Client{
+			Transport: roundTripFunc(func(*http.Request) (*http.Response, error) {
+				requests++
+				if requests == 1 {
+					return nil, errors.New("some error")
+				}
+				return &http.Response{
+					StatusCode: http.StatusOK,
+					Body:       ioutil.NopCloser(bytes.NewBufferString(`{"code": 0}`)),
+				}, nil
+			}),
+		},
+		RetryPolicy: &api.RetryPolicy{MaxRetries: 1},
+	}
+
+	req, err := http.NewRequestWithContext(context.Background(), http.MethodGet, "https://api.crypto.com/exchange/v1/public/get-book", nil)
+	require.NoError(t, err)
+
+	statusCode, body, err := requester.Do(context.Background(), req, "public/get-book")
+	require.NoError(t, err)
+
+	assert.Equal(t, 2, requests)
+	assert.Equal(t, http.StatusOK, statusCode)
+	assert.JSONEq(t, `{"code": 0}`, string(body))
+}
+
+type roundTripFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+func TestRequester_Do_Maintenance(t *testing.T) {
+	tests := []struct {
+		name        string
+		statusCode  int
+		header      http.Header
+		body        string
+		expectedErr error
+	}{
+		{
+			name:        "returns maintenance error given 503 with maintenance page",
+			statusCode:  http.StatusServiceUnavailable,
+			header:      http.Header{"Retry-After": []string{"120"}},
+			body:        `<html><body>Service Unavailable</body></html>`,
+			expectedErr: cdcerrors.MaintenanceError{HTTPStatusCode: http.StatusServiceUnavailable, RetryAfter: 2 * time.Minute},
+		},
+		{
+			name:        "returns maintenance error with estimated retry given maintenance page",
+			statusCode:  http.StatusOK,
+			body:        `<html><body>Scheduled Maintenance</body></html>`,
+			expectedErr: cdcerrors.MaintenanceError{HTTPStatusCode: http.StatusOK, RetryAfter: time.Minute},
+		},
+		{
+			name:       "returns response given 503 with json response",
+			statusCode: http.StatusServiceUnavailable,
+			body:       `{"code": 10001}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			requester := api.Requester{
+				Client: &http.Client{
+					Transport: roundTripFunc(func(*http.Request) (*http.Response, error) {
+						return &http.Response{
+							StatusCode: tt.statusCode,
+							Header:     tt.header,
+							Body:       ioutil.NopCloser(bytes.NewBufferString(tt.body)),
+						}, nil
+					}),
+				},
+			}
+
+			req, err := http.NewRequestWithContext(context.Background(), http.MethodGet, "https://api.crypto.com/exchange/v1/public/get-book", nil)
+			require.NoError(t, err)
+
+			statusCode, body, err := requester.Do(context.Background(), req, "public/get-book")
+			if tt.expectedErr != nil {
+				require.Error(t, err)
+				assert.Equal(t, tt.expectedErr, err)
+				assert.True(t, errors.Is(err, cdcerrors.ErrExchangeMaintenance))
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.statusCode, statusCode)
+			assert.Equal(t, tt.body, string(body))
+		})
+	}
+}
+
+func TestRequester_CheckResponse(t *testing.T) {
+	tests := []struct {
+		name            string
+		res             api.BaseResponse
+		expectedMessage string
+	}{
+		{
+			name:            "keeps the message of the response",
+			res:             api.BaseResponse{Code: "213", Message: "some message", Raw: []byte(`{"code": 213}`)},
+			expectedMessage: "some message",
+		},
+		{
+			name:            "keeps the detail of the response",
+			res:             api.BaseResponse{Code: "213", Detail: "some detail", Raw: []byte(`{"code": 213}`)},
+			expectedMessage: "some detail",
+		},
+		{
+			name:            "keeps both the message and detail of the response",
+			res:             api.BaseResponse{Code: "213", Message: "some message", Detail: "some detail", Raw: []byte(`{"code": 213}`)},
+			expectedMessage: "some message (some detail)",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := api.Requester{}.CheckResponse(http.StatusBadRequest, tt.res)
+			require.Error(t, err)
+
+			var responseErr cdcerrors.ResponseError
+			require.True(t, errors.As(err, &responseErr))
+			assert.Equal(t, cdcerrors.ResponseError{
+				Code:           213,
+				HTTPStatusCode: http.StatusBadRequest,
+				Err:            cdcerrors.ErrInvalidOrderQuantity,
+				Message:        tt.expectedMessage,
+				Raw:            tt.res.Raw,
+			}, responseErr)
+		})
+	}
+}
+
+func TestBaseResponse_SetRaw(t *testing.T) {
+	tests := []struct {
+		name              string
+		raw               string
+		expectedRawResult string
+	}{
+		{name: "keeps the raw result", raw: `{"id": 1, "code": 0, "result": {"order_id": "1"}}`, expectedRawResult: `{"order_id": "1"}`},
+		{name: "keeps no result if the response has none", raw: `{"id": 1, "code": 0}`},
+		{name: "keeps no result if the response is invalid", raw: `not json`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var res api.BaseResponse
+			res.SetRaw([]byte(tt.raw))
+
+			assert.Equal(t, json.RawMessage(tt.raw), res.Raw)
+			if tt.expectedRawResult == "" {
+				assert.Empty(t, res.RawResult)
+				return
+			}
+			assert.JSONEq(t, tt.expectedRawResult, string(res.RawResult))
+		})
+	}
+}
@@ -0,0 +1,77 @@
+package api
+
+import (
+	"net/http"
+	"sync"
+	"time"
+)
+
+// ServerClock tracks the offset between the local clock and the clock of the Exchange,
+// estimated from the Date header of responses.
+type ServerClock struct {
+	interval time.Duration
+
+	mu         sync.RWMutex
+	offset     time.Duration
+	measuredAt time.Time
+}
+
+// NewServerClock creates a new instance of ServerClock, which re-measures the offset at most once per interval.
+func NewServerClock(interval time.Duration) *ServerClock {
+	return &ServerClock{interval: interval}
+}
+
+// Offset returns the duration to add to the local time to get the time of the Exchange (0 if s is nil).
+func (s *ServerClock) Offset() time.Duration {
+	if s == nil {
+		return 0
+	}
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.offset
+}
+
+// observe re-measures the offset from a response to a request sent and received at the given local times,
+// if it has not been measured within the interval.
+func (s *ServerClock) observe(sent time.Time, received time.Time, header http.Header) {
+	if s == nil {
+		return
+	}
+
+	s.mu.RLock()
+	due := received.Sub(s.measuredAt) >= s.interval
+	s.mu.RUnlock()
+	if !due {
+		return
+	}
+
+	if serverTime, ok := estimateServerTime(header); ok {
+		s.set(serverTime.Sub(midpoint(sent, received)), received)
+	}
+}
+
+func (s *ServerClock) set(offset time.Duration, measuredAt time.Time) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.offset = offset
+	s.measuredAt = measuredAt
+}
+
+// estimateServerTime estimates the time of the Exchange at the midpoint of a request from the Date header of its response.
+//
+// The Date header is truncated to the second, so half a second is added to halve the worst case error.
+func estimateServerTime(header http.Header) (time.Time, bool) {
+	date, err := http.ParseTime(header.Get("Date"))
+	if err != nil {
+		return time.Time{}, false
+	}
+
+	return date.Add(500 * time.Millisecond), true
+}
+
+func midpoint(sent time.Time, received time.Time) time.Time {
+	return sent.Add(received.Sub(sent) / 2)
+}
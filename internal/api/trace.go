@@ -0,0 +1,65 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// startSpan starts a span for a request for method, as a child of any span in ctx.
+//
+// A no-op span is returned if the Requester has no Tracer.
+func (r Requester) startSpan(ctx context.Context, req *http.Request, method string, params map[string]interface{}) (context.Context, trace.Span) {
+	if r.Tracer == nil {
+		return ctx, trace.SpanFromContext(context.Background())
+	}
+
+	attrs := []attribute.KeyValue{
+		attribute.String("cdcexchange.method", method),
+		attribute.String("http.request.method", req.Method),
+	}
+
+	instrument, _ := params["instrument_name"].(string)
+	if instrument == "" {
+		instrument = req.URL.Query().Get("instrument_name")
+	}
+	if instrument != "" {
+		attrs = append(attrs, attribute.String("cdcexchange.instrument_name", instrument))
+	}
+
+	return r.Tracer.Start(ctx, method,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(attrs...),
+	)
+}
+
+// endSpan records the outcome of a request on span, and ends it.
+func (Requester) endSpan(span trace.Span, latency time.Duration, statusCode int, code json.Number, err error) {
+	defer span.End()
+
+	if !span.IsRecording() {
+		return
+	}
+
+	span.SetAttributes(attribute.Int64("cdcexchange.latency_ms", latency.Milliseconds()))
+
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return
+	}
+
+	span.SetAttributes(
+		attribute.Int("http.response.status_code", statusCode),
+		attribute.String("cdcexchange.response_code", code.String()),
+	)
+
+	if isFailed(statusCode, code) {
+		span.SetStatus(codes.Error, "error received in response")
+	}
+}
@@ -19,8 +19,26 @@ type (
 	}
 
 	BaseResponse struct {
-		ID     json.Number `json:"id"`
-		Method string      `json:"method"`
-		Code   json.Number `json:"code"`
+		ID      json.Number `json:"id"`
+		Method  string      `json:"method"`
+		Code    json.Number `json:"code"`
+		Message string      `json:"message,omitempty"`
+		Detail  string      `json:"detail,omitempty"`
+		// Raw is the raw body of the response (set by the Requester, not decoded).
+		Raw json.RawMessage `json:"-"`
+		// RawResult is the raw result field of the response (set by the Requester, not decoded).
+		RawResult json.RawMessage `json:"-"`
 	}
 )
+
+// SetRaw sets the raw body and result of the response, which is promoted to every response embedding BaseResponse.
+func (b *BaseResponse) SetRaw(raw []byte) {
+	b.Raw = raw
+
+	var v struct {
+		Result json.RawMessage `json:"result"`
+	}
+	if err := json.Unmarshal(raw, &v); err == nil {
+		b.RawResult = v.Result
+	}
+}
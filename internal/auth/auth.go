@@ -1,11 +1,15 @@
 package auth
 
 import (
+	"bytes"
 	"crypto/hmac"
 	"crypto/sha256"
 	"encoding/hex"
+	"encoding/json"
 	"fmt"
+	"reflect"
 	"sort"
+	"strings"
 )
 
 type (
@@ -22,22 +26,18 @@ type (
 		GenerateSignature(req SignatureRequest) (string, error)
 	}
 
-	param struct {
-		key string
-		val interface{}
-	}
-
 	Generator struct{}
 )
 
 func (g Generator) GenerateSignature(req SignatureRequest) (string, error) {
-	paramStr := g.buildParamString(req.Params)
-
-	signaturePayload := fmt.Sprintf("%s%d%s%s%d", req.Method, req.ID, req.APIKey, paramStr, req.Timestamp)
+	signaturePayload, err := g.Payload(req)
+	if err != nil {
+		return "", err
+	}
 
 	h := hmac.New(sha256.New, []byte(req.SecretKey))
 
-	_, err := h.Write([]byte(signaturePayload))
+	_, err = h.Write([]byte(signaturePayload))
 	if err != nil {
 		return "", fmt.Errorf("failed to write signature: %w", err)
 	}
@@ -45,30 +45,118 @@ func (g Generator) GenerateSignature(req SignatureRequest) (string, error) {
 	return hex.EncodeToString(h.Sum(nil)), nil
 }
 
-func (g Generator) buildParamString(params map[string]interface{}) string {
-	if len(params) == 0 {
-		return ""
+// Payload returns the payload signed for req: method + id + api key + param string + nonce.
+//
+// The secret key is not part of the payload, so it can be shared when troubleshooting signature mismatches.
+func (g Generator) Payload(req SignatureRequest) (string, error) {
+	paramStr, err := g.buildParamString(req.Params)
+	if err != nil {
+		return "", fmt.Errorf("failed to build param string: %w", err)
+	}
+
+	return fmt.Sprintf("%s%d%s%s%d", req.Method, req.ID, req.APIKey, paramStr, req.Timestamp), nil
+}
+
+// buildParamString builds the param string of the signature payload, as specified by the Exchange:
+// the keys of params in ascending order, each followed by its value.
+//
+// Nested objects (maps with string keys, or structs which are encoded as their JSON representation)
+// are built recursively in the same way, and nil values are written as null.
+// Arrays are written as the concatenation of their elements (e.g. the order objects of an order list),
+// without their indices.
+func (g Generator) buildParamString(params map[string]interface{}) (string, error) {
+	var b strings.Builder
+	if err := g.writeObject(&b, params); err != nil {
+		return "", err
 	}
 
-	var paramsString string
+	return b.String(), nil
+}
+
+func (g Generator) writeObject(b *strings.Builder, obj map[string]interface{}) error {
+	keys := make([]string, 0, len(obj))
+	for k := range obj {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
 
-	for _, p := range g.sortParams(params) {
-		paramsString = fmt.Sprintf("%s%s%v", paramsString, p.key, p.val)
+	for _, k := range keys {
+		b.WriteString(k)
+		if err := g.writeValue(b, obj[k]); err != nil {
+			return fmt.Errorf("%s: %w", k, err)
+		}
 	}
 
-	return paramsString
+	return nil
 }
 
-func (Generator) sortParams(params map[string]interface{}) []param {
-	p := make([]param, 0, len(params))
+func (g Generator) writeValue(b *strings.Builder, val interface{}) error {
+	switch v := val.(type) {
+	case nil:
+		b.WriteString("null")
+		return nil
+	case map[string]interface{}:
+		return g.writeObject(b, v)
+	case json.Marshaler:
+		return g.writeJSON(b, v)
+	}
+
+	rv := reflect.ValueOf(val)
+	switch rv.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		if rv.IsNil() {
+			b.WriteString("null")
+			return nil
+		}
+		return g.writeValue(b, rv.Elem().Interface())
+	case reflect.Map:
+		if rv.Type().Key().Kind() != reflect.String {
+			return fmt.Errorf("unsupported map key type %s", rv.Type().Key())
+		}
+
+		obj := make(map[string]interface{}, rv.Len())
+		for iter := rv.MapRange(); iter.Next(); {
+			obj[iter.Key().String()] = iter.Value().Interface()
+		}
+		return g.writeObject(b, obj)
+	case reflect.Slice, reflect.Array:
+		if rv.Kind() == reflect.Slice && rv.IsNil() {
+			b.WriteString("null")
+			return nil
+		}
+		if rv.Type().Elem().Kind() == reflect.Uint8 {
+			// byte slices are encoded as base64 strings.
+			return g.writeJSON(b, val)
+		}
+
+		for i := 0; i < rv.Len(); i++ {
+			if err := g.writeValue(b, rv.Index(i).Interface()); err != nil {
+				return fmt.Errorf("[%d]: %w", i, err)
+			}
+		}
+		return nil
+	case reflect.Struct:
+		return g.writeJSON(b, val)
+	default:
+		fmt.Fprint(b, val)
+		return nil
+	}
+}
 
-	for k, v := range params {
-		p = append(p, param{key: k, val: v})
+// writeJSON writes val as its JSON representation would be written, so that the param string matches the request body.
+func (g Generator) writeJSON(b *strings.Builder, val interface{}) error {
+	raw, err := json.Marshal(val)
+	if err != nil {
+		return fmt.Errorf("failed to marshal param: %w", err)
 	}
 
-	sort.Slice(p, func(i, j int) bool {
-		return p[i].key < p[j].key
-	})
+	dec := json.NewDecoder(bytes.NewReader(raw))
+	dec.UseNumber()
+
+	var decoded interface{}
+	if err := dec.Decode(&decoded); err != nil {
+		return fmt.Errorf("failed to unmarshal param: %w", err)
+	}
 
-	return p
+	return g.writeValue(b, decoded)
 }
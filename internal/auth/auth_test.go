@@ -0,0 +1,210 @@
+package auth_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sngyai/go-cryptocom/v2/internal/auth"
+)
+
+type (
+	orderFilter struct {
+		ClientOID *string      `json:"client_oid"`
+		Status    statusFilter `json:"status"`
+	}
+
+	statusFilter struct {
+		Active    bool  `json:"active"`
+		SinceNano int64 `json:"sinceNano"`
+	}
+
+	order struct {
+		InstrumentName string `json:"instrument_name"`
+		Side           string `json:"side"`
+		Type           string `json:"type"`
+		Price          string `json:"price"`
+		Quantity       string `json:"quantity"`
+		TriggerPrice   string `json:"trigger_price,omitempty"`
+	}
+)
+
+func TestGenerator_GenerateSignature(t *testing.T) {
+	const (
+		apiKey    = "api key"
+		secretKey = "secret key"
+		timestamp = int64(1587846358253)
+	)
+
+	// expected signatures are the HMAC-SHA256 (with the secret key) of method + id + api key + param string + nonce,
+	// generated independently of the Generator.
+	tests := []struct {
+		name              string
+		req               auth.SignatureRequest
+		expectedSignature string
+	}{
+		{
+			name: "signs flat params",
+			req: auth.SignatureRequest{
+				ID:     11,
+				Method: "private/get-order-detail",
+				Params: map[string]interface{}{"order_id": 1234},
+			},
+			// private/get-order-detail11api keyorder_id12341587846358253
+			expectedSignature: "8a001569cca223689ee20cd819608d583d2f9bcad7052681db272398ee5fffc2",
+		},
+		{
+			name: "signs empty params",
+			req: auth.SignatureRequest{
+				ID:     11,
+				Method: "private/get-order-detail",
+				Params: map[string]interface{}{},
+			},
+			// private/get-order-detail11api key1587846358253
+			expectedSignature: "6ba52cc77fce65d76f5dd03222c3cef90d08a4bc8e0dbacea00fa64ebd230c37",
+		},
+		{
+			name: "signs nested map params with keys in ascending order",
+			req: auth.SignatureRequest{
+				ID:     1,
+				Method: "private/create-order",
+				Params: map[string]interface{}{
+					"order": map[string]interface{}{
+						"type":     "LIMIT",
+						"side":     "BUY",
+						"quantity": 0.1,
+						"price":    5000.5,
+					},
+					"instrument_name": "BTC_USDT",
+				},
+			},
+			// private/create-order1api keyinstrument_nameBTC_USDTorderprice5000.5quantity0.1sideBUYtypeLIMIT1587846358253
+			expectedSignature: "8e3028104d8976fa3bf158fa8df1bcefef60a1c9c650b70572286bd8e8789fd9",
+		},
+		{
+			name: "signs nested struct params as their json representation",
+			req: auth.SignatureRequest{
+				ID:     2,
+				Method: "private/get-orders",
+				Params: map[string]interface{}{
+					"instrument_name": "ETH_USDT",
+					"filter": orderFilter{
+						Status: statusFilter{Active: true, SinceNano: 1700000000000000000},
+					},
+				},
+			},
+			// private/get-orders2api keyfilterclient_oidnullstatusactivetruesinceNano1700000000000000000instrument_nameETH_USDT1587846358253
+			expectedSignature: "5cd77e1ae5f53408333f4e9e1267c14e3f55528f04594d4fc6da6ca982009b30",
+		},
+		{
+			name: "signs array of map params as the concatenation of its elements",
+			req: auth.SignatureRequest{
+				ID:     3,
+				Method: "private/create-order-list",
+				Params: map[string]interface{}{
+					"contingency_type": "LIST",
+					"order_list": []map[string]interface{}{
+						{"instrument_name": "ONE_USDT", "side": "BUY", "type": "LIMIT", "price": "0.24", "quantity": "1.0"},
+						{"instrument_name": "ONE_USDT", "side": "BUY", "type": "STOP_LIMIT", "price": "0.27", "quantity": "1.0", "trigger_price": "0.26"},
+					},
+				},
+			},
+			// private/create-order-list3api keycontingency_typeLISTorder_listinstrument_nameONE_USDTprice0.24quantity1.0sideBUYtypeLIMIT
+			// instrument_nameONE_USDTprice0.27quantity1.0sideBUYtrigger_price0.26typeSTOP_LIMIT1587846358253
+			expectedSignature: "8c7a9861286469f3508f724bd7227ffc7d60fdf8e744e790ce6f8723ab40615f",
+		},
+		{
+			name: "signs array of struct params as the concatenation of its elements",
+			req: auth.SignatureRequest{
+				ID:     3,
+				Method: "private/create-order-list",
+				Params: map[string]interface{}{
+					"contingency_type": "LIST",
+					"order_list": []order{
+						{InstrumentName: "ONE_USDT", Side: "BUY", Type: "LIMIT", Price: "0.24", Quantity: "1.0"},
+						{InstrumentName: "ONE_USDT", Side: "BUY", Type: "STOP_LIMIT", Price: "0.27", Quantity: "1.0", TriggerPrice: "0.26"},
+					},
+				},
+			},
+			// same payload as the array of map params.
+			expectedSignature: "8c7a9861286469f3508f724bd7227ffc7d60fdf8e744e790ce6f8723ab40615f",
+		},
+		{
+			name: "signs array of interface params",
+			req: auth.SignatureRequest{
+				ID:     4,
+				Method: "private/cancel-order-list",
+				Params: map[string]interface{}{
+					"contingency_type": "LIST",
+					"order_list": []interface{}{
+						map[string]interface{}{"instrument_name": "ETH_CRO", "order_id": "2015106383706015873"},
+						map[string]string{"instrument_name": "CRO_USDT", "order_id": "2015119459882149857"},
+					},
+				},
+			},
+			// private/cancel-order-list4api keycontingency_typeLISTorder_listinstrument_nameETH_CROorder_id2015106383706015873
+			// instrument_nameCRO_USDTorder_id20151194598821498571587846358253
+			expectedSignature: "484db17bcb6951c83942000e7903634591453c2e9cb159e31f4bdcf06befd0bb",
+		},
+		{
+			name: "signs array of scalar params",
+			req: auth.SignatureRequest{
+				ID:     5,
+				Method: "private/get-orders",
+				Params: map[string]interface{}{
+					"order_ids": []string{"1", "2", "3"},
+					"limit":     10,
+				},
+			},
+			// private/get-orders5api keylimit10order_ids1231587846358253
+			expectedSignature: "5b3c334a0e2b2d078b8a7cea113ca42bf4d1fb3ea855311a33d1762cbe9f0d76",
+		},
+		{
+			name: "signs fixed size array of scalar params",
+			req: auth.SignatureRequest{
+				ID:     5,
+				Method: "private/get-orders",
+				Params: map[string]interface{}{
+					"order_ids": [3]int{1, 2, 3},
+					"limit":     10,
+				},
+			},
+			// same payload as the array of scalar params.
+			expectedSignature: "5b3c334a0e2b2d078b8a7cea113ca42bf4d1fb3ea855311a33d1762cbe9f0d76",
+		},
+		{
+			name: "signs nested, empty and nil array params",
+			req: auth.SignatureRequest{
+				ID:     6,
+				Method: "private/get-orders",
+				Params: map[string]interface{}{
+					"matrix":  [][]string{{"a", "b"}, {"c"}},
+					"empty":   []string{},
+					"nothing": []string(nil),
+				},
+			},
+			// private/get-orders6api keyemptymatrixabcnothingnull1587846358253
+			expectedSignature: "498ed47ee63f5c438dbe2b57e488dd5902a2dbb7d97716be3a10a797efc59da7",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.req.APIKey = apiKey
+			tt.req.SecretKey = secretKey
+			tt.req.Timestamp = timestamp
+
+			signature, err := auth.Generator{}.GenerateSignature(tt.req)
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.expectedSignature, signature)
+		})
+	}
+}
+
+func TestGenerator_GenerateSignature_Error(t *testing.T) {
+	_, err := auth.Generator{}.GenerateSignature(auth.SignatureRequest{
+		Params: map[string]interface{}{"some param": map[int]string{1: "some value"}},
+	})
+	require.Error(t, err)
+}
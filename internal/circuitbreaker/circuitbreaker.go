@@ -0,0 +1,82 @@
+package circuitbreaker
+
+import (
+	"sync"
+	"time"
+
+	"github.com/jonboulle/clockwork"
+
+	"github.com/sngyai/go-cryptocom/v2/errors"
+)
+
+const (
+	stateClosed state = iota
+	stateOpen
+	stateHalfOpen
+)
+
+type (
+	// Breaker opens after a number of consecutive failures, failing fast until a cooldown has passed.
+	//
+	// Once the cooldown has passed, a single trial request is allowed (half-open).
+	// The Breaker is closed again if the trial succeeds, otherwise it is re-opened for another cooldown.
+	Breaker struct {
+		threshold int
+		cooldown  time.Duration
+		clock     clockwork.Clock
+
+		mu       sync.Mutex
+		state    state
+		failures int
+		openedAt time.Time
+	}
+
+	state int
+)
+
+// New creates a new instance of Breaker, which opens after threshold consecutive failures.
+func New(threshold int, cooldown time.Duration, clock clockwork.Clock) *Breaker {
+	return &Breaker{
+		threshold: threshold,
+		cooldown:  cooldown,
+		clock:     clock,
+	}
+}
+
+// Allow returns errors.ErrCircuitOpen if a request is not allowed to be made.
+func (b *Breaker) Allow() error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	switch b.state {
+	case stateOpen:
+		if b.clock.Since(b.openedAt) < b.cooldown {
+			return errors.ErrCircuitOpen
+		}
+		b.state = stateHalfOpen
+		return nil
+	case stateHalfOpen:
+		// only the single trial request is allowed until its result is recorded.
+		return errors.ErrCircuitOpen
+	default:
+		return nil
+	}
+}
+
+// Record records the result of a request which was allowed.
+func (b *Breaker) Record(success bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if success {
+		b.state = stateClosed
+		b.failures = 0
+		return
+	}
+
+	b.failures++
+	if b.state == stateHalfOpen || b.failures >= b.threshold {
+		b.state = stateOpen
+		b.openedAt = b.clock.Now()
+	}
+}
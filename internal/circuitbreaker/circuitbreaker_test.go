@@ -0,0 +1,46 @@
+package circuitbreaker_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/jonboulle/clockwork"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	cdcerrors "github.com/sngyai/go-cryptocom/v2/errors"
+	"github.com/sngyai/go-cryptocom/v2/internal/circuitbreaker"
+)
+
+func TestBreaker(t *testing.T) {
+	clock := clockwork.NewFakeClock()
+	b := circuitbreaker.New(2, time.Minute, clock)
+
+	// opens after consecutive failures.
+	require.NoError(t, b.Allow())
+	b.Record(false)
+	require.NoError(t, b.Allow())
+	b.Record(true)
+	require.NoError(t, b.Allow())
+	b.Record(false)
+	require.NoError(t, b.Allow())
+	b.Record(false)
+
+	assert.True(t, errors.Is(b.Allow(), cdcerrors.ErrCircuitOpen))
+
+	// allows a single trial request after the cooldown, re-opening if it fails.
+	clock.Advance(time.Minute)
+	require.NoError(t, b.Allow())
+	assert.True(t, errors.Is(b.Allow(), cdcerrors.ErrCircuitOpen))
+	b.Record(false)
+	assert.True(t, errors.Is(b.Allow(), cdcerrors.ErrCircuitOpen))
+
+	// closes once a trial request succeeds.
+	clock.Advance(time.Minute)
+	require.NoError(t, b.Allow())
+	b.Record(true)
+	require.NoError(t, b.Allow())
+	b.Record(false)
+	require.NoError(t, b.Allow())
+}
@@ -2,5 +2,5 @@ package internal
 
 import _ "github.com/golang/mock/mockgen/model"
 
-//go:generate mockgen -destination=./mocks/id/generator_mock.gen.go -package=id_mocks github.com/cshep4/go-cryptocom/internal/id IDGenerator
-//go:generate mockgen -destination=./mocks/signature/generator_mock.gen.go -package=signature_mocks github.com/cshep4/go-cryptocom/internal/auth SignatureGenerator
+//go:generate mockgen -destination=./mocks/id/generator_mock.gen.go -package=id_mocks github.com/sngyai/go-cryptocom/v2/internal/id IDGenerator
+//go:generate mockgen -destination=./mocks/signature/generator_mock.gen.go -package=signature_mocks github.com/sngyai/go-cryptocom/v2/internal/auth SignatureGenerator
@@ -0,0 +1,19 @@
+package id
+
+import (
+	"crypto/rand"
+	"fmt"
+)
+
+// NewUUID generates a random (version 4) UUID, e.g. 3b241101-e2bb-4255-8caf-4136c566a962.
+func NewUUID() (string, error) {
+	var b [16]byte
+	if _, err := rand.Read(b[:]); err != nil {
+		return "", fmt.Errorf("failed to read random bytes: %w", err)
+	}
+
+	b[6] = (b[6] & 0x0f) | 0x40 // version 4
+	b[8] = (b[8] & 0x3f) | 0x80 // variant 10
+
+	return fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:16]), nil
+}
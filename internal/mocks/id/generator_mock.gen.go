@@ -1,5 +1,5 @@
 // Code generated by MockGen. DO NOT EDIT.
-// Source: github.com/cshep4/go-cryptocom/internal/id (interfaces: IDGenerator)
+// Source: github.com/sngyai/go-cryptocom/v2/internal/id (interfaces: IDGenerator)
 
 // Package id_mocks is a generated GoMock package.
 package id_mocks
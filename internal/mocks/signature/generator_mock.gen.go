@@ -1,12 +1,12 @@
 // Code generated by MockGen. DO NOT EDIT.
-// Source: github.com/cshep4/go-cryptocom/internal/auth (interfaces: SignatureGenerator)
+// Source: github.com/sngyai/go-cryptocom/v2/internal/auth (interfaces: SignatureGenerator)
 
 // Package signature_mocks is a generated GoMock package.
 package signature_mocks
 
 import (
 	gomock "github.com/golang/mock/gomock"
-	auth "github.com/sngyai/go-cryptocom/internal/auth"
+	auth "github.com/sngyai/go-cryptocom/v2/internal/auth"
 	reflect "reflect"
 )
 
@@ -0,0 +1,128 @@
+package ratelimit
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/jonboulle/clockwork"
+)
+
+type (
+	// Limiter allows at most limit events within any interval, making callers wait until the next event is allowed.
+	Limiter struct {
+		limit    int
+		interval time.Duration
+		clock    clockwork.Clock
+
+		mu sync.Mutex
+		// events are the times of the most recent events (oldest first), at most limit are kept.
+		events []time.Time
+	}
+
+	// MethodLimiter rate limits each API method separately, using the documented limits of the Exchange.
+	//
+	// Private methods are limited separately for each API key, as the Exchange limits them per API key
+	// (public methods are limited per IP address).
+	MethodLimiter struct {
+		clock clockwork.Clock
+
+		mu       sync.Mutex
+		limiters map[string]*Limiter
+	}
+
+	// rule is the maximum number of requests of a method within an interval.
+	rule struct {
+		limit    int
+		interval time.Duration
+	}
+)
+
+// New creates a new instance of Limiter.
+func New(limit int, interval time.Duration, clock clockwork.Clock) *Limiter {
+	return &Limiter{
+		limit:    limit,
+		interval: interval,
+		clock:    clock,
+		events:   make([]time.Time, 0, limit),
+	}
+}
+
+// Wait blocks until an event is allowed, or ctx is done.
+func (l *Limiter) Wait(ctx context.Context) error {
+	for {
+		l.mu.Lock()
+		now := l.clock.Now()
+
+		// forget events which have fallen out of the interval.
+		expired := 0
+		for expired < len(l.events) && !now.Before(l.events[expired].Add(l.interval)) {
+			expired++
+		}
+		l.events = append(l.events[:0], l.events[expired:]...)
+
+		if len(l.events) < l.limit {
+			l.events = append(l.events, now)
+			l.mu.Unlock()
+			return nil
+		}
+
+		wait := l.events[0].Add(l.interval).Sub(now)
+		l.mu.Unlock()
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-l.clock.After(wait):
+		}
+	}
+}
+
+// NewMethodLimiter creates a new instance of MethodLimiter.
+func NewMethodLimiter(clock clockwork.Clock) *MethodLimiter {
+	return &MethodLimiter{
+		clock:    clock,
+		limiters: make(map[string]*Limiter),
+	}
+}
+
+// Wait blocks until a request for method with apiKey is allowed, or ctx is done.
+func (m *MethodLimiter) Wait(ctx context.Context, apiKey string, method string) error {
+	key := method
+	if !strings.HasPrefix(method, "public/") {
+		key = apiKey + " " + method
+	}
+
+	m.mu.Lock()
+	l, ok := m.limiters[key]
+	if !ok {
+		r := ruleFor(method)
+		l = New(r.limit, r.interval, m.clock)
+		m.limiters[key] = l
+	}
+	m.mu.Unlock()
+
+	return l.Wait(ctx)
+}
+
+// ruleFor returns the documented rate limit of method.
+func ruleFor(method string) rule {
+	switch {
+	case method == "private/create-order",
+		method == "private/cancel-order",
+		method == "private/cancel-all-orders",
+		method == "private/create-order-list",
+		method == "private/cancel-order-list":
+		return rule{limit: 15, interval: 100 * time.Millisecond}
+	case method == "private/get-order-detail":
+		return rule{limit: 30, interval: 100 * time.Millisecond}
+	case method == "private/get-trades",
+		method == "private/get-order-history":
+		return rule{limit: 1, interval: time.Second}
+	case strings.HasPrefix(method, "public/"):
+		return rule{limit: 100, interval: time.Second}
+	default:
+		return rule{limit: 3, interval: 100 * time.Millisecond}
+	}
+}
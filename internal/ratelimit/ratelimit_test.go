@@ -0,0 +1,77 @@
+package ratelimit_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/jonboulle/clockwork"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sngyai/go-cryptocom/v2/internal/ratelimit"
+)
+
+func TestLimiter_Wait(t *testing.T) {
+	clock := clockwork.NewFakeClock()
+	l := ratelimit.New(2, time.Second, clock)
+
+	require.NoError(t, l.Wait(context.Background()))
+	require.NoError(t, l.Wait(context.Background()))
+
+	done := make(chan error)
+	go func() { done <- l.Wait(context.Background()) }()
+
+	clock.BlockUntil(1)
+	select {
+	case <-done:
+		t.Fatal("expected wait to block until the interval has passed")
+	default:
+	}
+
+	clock.Advance(time.Second)
+	require.NoError(t, <-done)
+}
+
+func TestLimiter_Wait_ContextDone(t *testing.T) {
+	clock := clockwork.NewFakeClock()
+	l := ratelimit.New(1, time.Second, clock)
+
+	require.NoError(t, l.Wait(context.Background()))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error)
+	go func() { done <- l.Wait(ctx) }()
+
+	clock.BlockUntil(1)
+	cancel()
+
+	assert.True(t, errors.Is(<-done, context.Canceled))
+}
+
+func TestMethodLimiter_Wait(t *testing.T) {
+	clock := clockwork.NewFakeClock()
+	m := ratelimit.NewMethodLimiter(clock)
+
+	// private/get-trades is limited to 1 request per second.
+	require.NoError(t, m.Wait(context.Background(), "some api key", "private/get-trades"))
+
+	// methods are limited separately.
+	for i := 0; i < 15; i++ {
+		require.NoError(t, m.Wait(context.Background(), "some api key", "private/create-order"))
+	}
+	for i := 0; i < 100; i++ {
+		require.NoError(t, m.Wait(context.Background(), "", "public/get-book"))
+	}
+
+	// private methods are limited separately for each api key.
+	require.NoError(t, m.Wait(context.Background(), "another api key", "private/get-trades"))
+
+	done := make(chan error)
+	go func() { done <- m.Wait(context.Background(), "some api key", "private/get-trades") }()
+
+	clock.BlockUntil(1)
+	clock.Advance(time.Second)
+	require.NoError(t, <-done)
+}
@@ -5,19 +5,55 @@ import (
 	"time"
 )
 
+const (
+	// minMicros and minNanos are the smallest timestamps treated as microseconds and nanoseconds respectively.
+	//
+	// Millisecond timestamps only reach minMicros in the year 5138, and microsecond timestamps only reach
+	// minNanos in the year 5138, so timestamps of any precision since 1973 are decoded correctly.
+	minMicros = 1e14
+	minNanos  = 1e17
+)
+
+// Time is a timestamp returned by the Exchange, decoded from a Unix timestamp in milliseconds, microseconds or nanoseconds.
 type Time time.Time
 
+// UnmarshalJSON decodes a Unix timestamp in milliseconds (used by most APIs), or in microseconds or nanoseconds
+// (used by some v1 fields, e.g. create_time_ns), detecting the precision from the magnitude of the timestamp.
 func (t *Time) UnmarshalJSON(data []byte) error {
-	millis, err := strconv.ParseInt(string(data), 10, 64)
+	// v1 APIs return some timestamps as quoted strings (e.g. "1613547060925").
+	s, err := strconv.Unquote(string(data))
+	if err != nil {
+		s = string(data)
+	}
+
+	ts, err := strconv.ParseInt(s, 10, 64)
 	if err != nil {
 		return err
 	}
 
-	*t = Time(time.Unix(0, millis*int64(time.Millisecond)))
+	*t = Time(fromUnix(ts))
 
 	return nil
 }
 
-func (t *Time) Time() time.Time {
-	return time.Time(*t)
+// Time returns t as a time.Time.
+func (t Time) Time() time.Time {
+	return time.Time(t)
+}
+
+// fromUnix returns the time of the Unix timestamp ts, in milliseconds, microseconds or nanoseconds.
+func fromUnix(ts int64) time.Time {
+	abs := ts
+	if abs < 0 {
+		abs = -abs
+	}
+
+	switch {
+	case abs >= minNanos:
+		return time.Unix(0, ts)
+	case abs >= minMicros:
+		return time.UnixMicro(ts)
+	default:
+		return time.UnixMilli(ts)
+	}
 }
@@ -0,0 +1,44 @@
+package time_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	cdctime "github.com/sngyai/go-cryptocom/v2/internal/time"
+)
+
+func TestTime_UnmarshalJSON(t *testing.T) {
+	expected := time.Date(2021, time.February, 17, 7, 31, 0, 925523623, time.UTC)
+
+	tests := []struct {
+		name     string
+		data     string
+		expected time.Time
+		wantErr  bool
+	}{
+		{name: "milliseconds", data: `1613547060925`, expected: expected.Truncate(time.Millisecond)},
+		{name: "quoted milliseconds", data: `"1613547060925"`, expected: expected.Truncate(time.Millisecond)},
+		{name: "microseconds", data: `1613547060925523`, expected: expected.Truncate(time.Microsecond)},
+		{name: "nanoseconds", data: `1613547060925523623`, expected: expected},
+		{name: "quoted nanoseconds", data: `"1613547060925523623"`, expected: expected},
+		{name: "small milliseconds", data: `1000`, expected: time.Unix(1, 0)},
+		{name: "invalid", data: `"abc"`, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var v cdctime.Time
+			err := json.Unmarshal([]byte(tt.data), &v)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			assert.True(t, tt.expected.Equal(v.Time()), "expected %s, got %s", tt.expected, v.Time())
+		})
+	}
+}
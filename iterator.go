@@ -0,0 +1,113 @@
+package cdcexchange
+
+import (
+	"context"
+)
+
+type (
+	// Iterator walks the records of a paginated history API one at a time, fetching every page of every
+	// time window of the request (newest first) until no records are left.
+	//
+	//	it, err := client.OrderHistoryIterator(cdcexchange.GetOrderHistoryRequest{Start: start, End: end})
+	//	if err != nil {
+	//		return err
+	//	}
+	//
+	//	for it.Next(ctx) {
+	//		order := it.Value()
+	//		...
+	//	}
+	//	if err := it.Err(); err != nil {
+	//		return err
+	//	}
+	//
+	// Pages are fetched lazily, one request at a time, so requests are paced by the rate limiter of the Client.
+	//
+	// An Iterator is not safe for concurrent use.
+	Iterator[T any] struct {
+		fetch    windowPageFunc[T]
+		pageSize int
+		windows  []TimeWindow
+		pager    *Pager[T]
+		items    []T
+		value    T
+		err      error
+	}
+
+	// windowPageFunc fetches the records of a single page of a paginated API within window.
+	windowPageFunc[T any] func(ctx context.Context, window TimeWindow, page PageRequest) ([]T, error)
+)
+
+// newIterator creates an Iterator which fetches pages of pageSize records of each of windows in order using fetch.
+func newIterator[T any](windows []TimeWindow, pageSize int, fetch windowPageFunc[T]) *Iterator[T] {
+	return &Iterator[T]{
+		fetch:    fetch,
+		pageSize: pageSize,
+		windows:  windows,
+	}
+}
+
+// Next advances the Iterator to the next record, which is then returned by Value, fetching the next page if needed.
+// false is returned when there are no records left, or a page could not be fetched (see Err).
+//
+// A page which could not be fetched is fetched again by the next call to Next, so iteration can be resumed after an error.
+func (it *Iterator[T]) Next(ctx context.Context) bool {
+	it.err = nil
+
+	for len(it.items) == 0 {
+		if it.pager == nil {
+			if len(it.windows) == 0 {
+				return false
+			}
+
+			window := it.windows[0]
+			it.windows = it.windows[1:]
+			it.pager = &Pager[T]{
+				fetch: func(ctx context.Context, page PageRequest) ([]T, error) {
+					return it.fetch(ctx, window, page)
+				},
+				pageSize:    it.pageSize,
+				concurrency: 1,
+			}
+		}
+
+		if !it.pager.HasNext() {
+			it.pager = nil
+			continue
+		}
+
+		page, err := it.pager.Next(ctx)
+		if err != nil {
+			it.err = err
+			return false
+		}
+		it.items = page.Items
+	}
+
+	it.value, it.items = it.items[0], it.items[1:]
+
+	return true
+}
+
+// all returns every record left, or the error of the first page which could not be fetched.
+func (it *Iterator[T]) all(ctx context.Context) ([]T, error) {
+	var items []T
+	for it.Next(ctx) {
+		items = append(items, it.Value())
+	}
+	if err := it.Err(); err != nil {
+		return nil, err
+	}
+
+	return items, nil
+}
+
+// Value returns the record the Iterator was advanced to by the last call to Next.
+func (it *Iterator[T]) Value() T {
+	return it.value
+}
+
+// Err returns the error which stopped the last call to Next, or nil if the records were exhausted.
+func (it *Iterator[T]) Err() error {
+	return it.err
+}
@@ -0,0 +1,90 @@
+package metrics
+
+import (
+	"errors"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// codeTransportError is the code label of requests which failed without a response (e.g. network errors).
+const codeTransportError = "transport_error"
+
+// Prometheus records the metrics of the requests made by a Client with Prometheus.
+//
+// The following metrics are recorded:
+//   - cdcexchange_requests_total: counter of requests, by method and response code.
+//   - cdcexchange_request_errors_total: counter of failed requests, by method and response code.
+//   - cdcexchange_request_duration_seconds: histogram of request latencies, by method.
+type Prometheus struct {
+	requests *prometheus.CounterVec
+	errors   *prometheus.CounterVec
+	latency  *prometheus.HistogramVec
+}
+
+// NewPrometheus creates a new instance of Prometheus, registering its metrics with registerer
+// (e.g. prometheus.DefaultRegisterer).
+//
+// If the metrics are already registered with registerer (e.g. by another Client), the registered metrics are shared.
+func NewPrometheus(registerer prometheus.Registerer) (*Prometheus, error) {
+	p := &Prometheus{
+		requests: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: "cdcexchange",
+			Name:      "requests_total",
+			Help:      "Total number of requests made to the Crypto.com Exchange, by method and response code.",
+		}, []string{"method", "code"}),
+		errors: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: "cdcexchange",
+			Name:      "request_errors_total",
+			Help:      "Total number of failed requests made to the Crypto.com Exchange, by method and response code.",
+		}, []string{"method", "code"}),
+		latency: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: "cdcexchange",
+			Name:      "request_duration_seconds",
+			Help:      "Latency of requests made to the Crypto.com Exchange, including retries, by method.",
+			Buckets:   prometheus.DefBuckets,
+		}, []string{"method"}),
+	}
+
+	var err error
+	if p.requests, err = register(registerer, p.requests); err != nil {
+		return nil, err
+	}
+	if p.errors, err = register(registerer, p.errors); err != nil {
+		return nil, err
+	}
+	if p.latency, err = register(registerer, p.latency); err != nil {
+		return nil, err
+	}
+
+	return p, nil
+}
+
+// ObserveRequest records a request for method, which returned statusCode and the response code
+// (both empty if err is not nil) after latency, including any retries.
+func (p *Prometheus) ObserveRequest(method string, statusCode int, code string, latency time.Duration, err error) {
+	if err != nil {
+		code = codeTransportError
+	}
+
+	p.requests.WithLabelValues(method, code).Inc()
+	if err != nil || statusCode >= 400 || (code != "" && code != "0") {
+		p.errors.WithLabelValues(method, code).Inc()
+	}
+	p.latency.WithLabelValues(method).Observe(latency.Seconds())
+}
+
+// register registers c with registerer, returning the existing collector if one is already registered.
+func register[T prometheus.Collector](registerer prometheus.Registerer, c T) (T, error) {
+	if err := registerer.Register(c); err != nil {
+		var are prometheus.AlreadyRegisteredError
+		if errors.As(err, &are) {
+			if existing, ok := are.ExistingCollector.(T); ok {
+				return existing, nil
+			}
+		}
+		return c, err
+	}
+
+	return c, nil
+}
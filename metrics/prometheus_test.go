@@ -0,0 +1,63 @@
+package metrics_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sngyai/go-cryptocom/v2/metrics"
+)
+
+func TestPrometheus_ObserveRequest(t *testing.T) {
+	registry := prometheus.NewRegistry()
+
+	m, err := metrics.NewPrometheus(registry)
+	require.NoError(t, err)
+
+	m.ObserveRequest("private/get-trades", 200, "0", time.Millisecond, nil)
+	m.ObserveRequest("private/get-trades", 400, "30003", time.Millisecond, nil)
+	m.ObserveRequest("private/create-order", 0, "", time.Second, errors.New("some error"))
+
+	expected := `
+# HELP cdcexchange_requests_total Total number of requests made to the Crypto.com Exchange, by method and response code.
+# TYPE cdcexchange_requests_total counter
+cdcexchange_requests_total{code="0",method="private/get-trades"} 1
+cdcexchange_requests_total{code="30003",method="private/get-trades"} 1
+cdcexchange_requests_total{code="transport_error",method="private/create-order"} 1
+# HELP cdcexchange_request_errors_total Total number of failed requests made to the Crypto.com Exchange, by method and response code.
+# TYPE cdcexchange_request_errors_total counter
+cdcexchange_request_errors_total{code="30003",method="private/get-trades"} 1
+cdcexchange_request_errors_total{code="transport_error",method="private/create-order"} 1
+`
+	require.NoError(t, testutil.GatherAndCompare(registry, strings.NewReader(expected),
+		"cdcexchange_requests_total", "cdcexchange_request_errors_total"))
+
+	count, err := testutil.GatherAndCount(registry, "cdcexchange_request_duration_seconds")
+	require.NoError(t, err)
+	assert.Equal(t, 2, count)
+}
+
+func TestNewPrometheus_AlreadyRegistered(t *testing.T) {
+	registry := prometheus.NewRegistry()
+
+	m1, err := metrics.NewPrometheus(registry)
+	require.NoError(t, err)
+	m2, err := metrics.NewPrometheus(registry)
+	require.NoError(t, err)
+
+	m1.ObserveRequest("private/get-trades", 200, "0", time.Millisecond, nil)
+	m2.ObserveRequest("private/get-trades", 200, "0", time.Millisecond, nil)
+
+	expected := `
+# HELP cdcexchange_requests_total Total number of requests made to the Crypto.com Exchange, by method and response code.
+# TYPE cdcexchange_requests_total counter
+cdcexchange_requests_total{code="0",method="private/get-trades"} 2
+`
+	require.NoError(t, testutil.GatherAndCompare(registry, strings.NewReader(expected), "cdcexchange_requests_total"))
+}
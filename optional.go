@@ -0,0 +1,67 @@
+package cdcexchange
+
+import (
+	"encoding/json"
+)
+
+// Optional is an optional request field, which distinguishes an explicitly set zero value from an omitted one.
+//
+// The zero Optional is unset, so the field is omitted from the request and the Exchange default is used.
+// Some sets the field, so its value is sent even when it is the zero value of T.
+//
+// Fields whose zero value is never valid (e.g. the Price, Quantity and Notional of CreateOrderRequest, or the
+// Amount of CreateWithdrawalRequest) are not Optional: a zero value is either omitted where the field does not
+// apply, or rejected with an errors.InvalidParameterError where it is required.
+type Optional[T any] struct {
+	value T
+	set   bool
+}
+
+// Some returns an Optional set to v.
+func Some[T any](v T) Optional[T] {
+	return Optional[T]{value: v, set: true}
+}
+
+// Get returns the value of o and whether it is set.
+func (o Optional[T]) Get() (T, bool) {
+	return o.value, o.set
+}
+
+// IsSet returns whether o is set.
+func (o Optional[T]) IsSet() bool {
+	return o.set
+}
+
+// ValueOr returns the value of o if it is set, otherwise def.
+func (o Optional[T]) ValueOr(def T) T {
+	if !o.set {
+		return def
+	}
+
+	return o.value
+}
+
+// MarshalJSON encodes the value of o, or null if it is unset.
+func (o Optional[T]) MarshalJSON() ([]byte, error) {
+	if !o.set {
+		return []byte("null"), nil
+	}
+
+	return json.Marshal(o.value)
+}
+
+// UnmarshalJSON decodes a value into o, leaving it unset if the value is null.
+func (o *Optional[T]) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		*o = Optional[T]{}
+		return nil
+	}
+
+	var v T
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	*o = Some(v)
+
+	return nil
+}
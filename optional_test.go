@@ -0,0 +1,63 @@
+package cdcexchange_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	cdcexchange "github.com/sngyai/go-cryptocom/v2"
+)
+
+func TestOptional(t *testing.T) {
+	t.Run("unset", func(t *testing.T) {
+		var o cdcexchange.Optional[int]
+
+		v, ok := o.Get()
+		assert.False(t, ok)
+		assert.Zero(t, v)
+		assert.False(t, o.IsSet())
+		assert.Equal(t, 20, o.ValueOr(20))
+	})
+
+	t.Run("set to the zero value", func(t *testing.T) {
+		o := cdcexchange.Some(0)
+
+		v, ok := o.Get()
+		assert.True(t, ok)
+		assert.Zero(t, v)
+		assert.True(t, o.IsSet())
+		assert.Equal(t, 0, o.ValueOr(20))
+	})
+}
+
+func TestOptional_JSON(t *testing.T) {
+	type request struct {
+		Limit cdcexchange.Optional[int] `json:"limit"`
+	}
+
+	tests := []struct {
+		name string
+		json string
+		req  request
+	}{
+		{name: "unset", json: `{"limit":null}`, req: request{}},
+		{name: "set to the zero value", json: `{"limit":0}`, req: request{Limit: cdcexchange.Some(0)}},
+		{name: "set", json: `{"limit":50}`, req: request{Limit: cdcexchange.Some(50)}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b, err := json.Marshal(tt.req)
+			require.NoError(t, err)
+			assert.JSONEq(t, tt.json, string(b))
+
+			var req request
+			require.NoError(t, json.Unmarshal([]byte(tt.json), &req))
+			assert.Equal(t, tt.req, req)
+		})
+	}
+
+	var req request
+	assert.Error(t, json.Unmarshal([]byte(`{"limit":"fifty"}`), &req))
+}
@@ -0,0 +1,214 @@
+package cdcexchange
+
+import (
+	"strings"
+
+	"github.com/sngyai/go-cryptocom/v2/errors"
+)
+
+// OrderBuilder builds a validated CreateOrderRequest, e.g.:
+//
+//	req, err := cdcexchange.NewLimitBuy("BTC_USDT", price, quantity).PostOnly().ClientOID("my-order").Build()
+//
+// Each method returns a copy of the OrderBuilder, so a partially built order can be reused as a template.
+type OrderBuilder struct {
+	req CreateOrderRequest
+}
+
+// NewOrder returns an OrderBuilder for an order of type orderType on side of instrument,
+// whose mandatory parameters (see CreateOrderRequest) are set with the methods of the OrderBuilder.
+func NewOrder(instrument string, side OrderSide, orderType OrderType) OrderBuilder {
+	return OrderBuilder{req: CreateOrderRequest{InstrumentName: instrument, Side: side, Type: orderType}}
+}
+
+// NewLimitBuy returns an OrderBuilder for a LIMIT order to buy quantity of instrument at price.
+func NewLimitBuy(instrument string, price Decimal, quantity Decimal) OrderBuilder {
+	return NewOrder(instrument, OrderSideBuy, OrderTypeLimit).Price(price).Quantity(quantity)
+}
+
+// NewLimitSell returns an OrderBuilder for a LIMIT order to sell quantity of instrument at price.
+func NewLimitSell(instrument string, price Decimal, quantity Decimal) OrderBuilder {
+	return NewOrder(instrument, OrderSideSell, OrderTypeLimit).Price(price).Quantity(quantity)
+}
+
+// NewMarketBuy returns an OrderBuilder for a MARKET order to buy quantity of instrument.
+func NewMarketBuy(instrument string, quantity Decimal) OrderBuilder {
+	return NewOrder(instrument, OrderSideBuy, OrderTypeMarket).Quantity(quantity)
+}
+
+// NewMarketBuyNotional returns an OrderBuilder for a MARKET order to buy instrument by spending notional.
+func NewMarketBuyNotional(instrument string, notional Decimal) OrderBuilder {
+	return NewOrder(instrument, OrderSideBuy, OrderTypeMarket).Notional(notional)
+}
+
+// NewMarketSell returns an OrderBuilder for a MARKET order to sell quantity of instrument.
+func NewMarketSell(instrument string, quantity Decimal) OrderBuilder {
+	return NewOrder(instrument, OrderSideSell, OrderTypeMarket).Quantity(quantity)
+}
+
+// Price sets the price of the order.
+func (b OrderBuilder) Price(price Decimal) OrderBuilder {
+	b.req.Price = price
+	return b
+}
+
+// Quantity sets the quantity of the order.
+func (b OrderBuilder) Quantity(quantity Decimal) OrderBuilder {
+	b.req.Quantity = quantity
+	return b
+}
+
+// Notional sets the amount to spend of a BUY order.
+func (b OrderBuilder) Notional(notional Decimal) OrderBuilder {
+	b.req.Notional = notional
+	return b
+}
+
+// TriggerPrice sets the price at which a STOP_LOSS, STOP_LIMIT, TAKE_PROFIT or TAKE_PROFIT_LIMIT order is triggered.
+func (b OrderBuilder) TriggerPrice(triggerPrice Decimal) OrderBuilder {
+	b.req.TriggerPrice = triggerPrice
+	return b
+}
+
+// ClientOID sets the Client order ID of the order.
+func (b OrderBuilder) ClientOID(clientOID string) OrderBuilder {
+	b.req.ClientOID = clientOID
+	return b
+}
+
+// TimeInForce sets how long a limit order is active before being cancelled.
+func (b OrderBuilder) TimeInForce(timeInForce TimeInForce) OrderBuilder {
+	b.req.TimeInForce = timeInForce
+	return b
+}
+
+// FillOrKill makes a limit order FILL_OR_KILL.
+func (b OrderBuilder) FillOrKill() OrderBuilder {
+	return b.TimeInForce(TimeInForceFillOrKill)
+}
+
+// ImmediateOrCancel makes a limit order IMMEDIATE_OR_CANCEL.
+func (b OrderBuilder) ImmediateOrCancel() OrderBuilder {
+	return b.TimeInForce(TimeInForceImmediateOrCancel)
+}
+
+// PostOnly makes a limit order POST_ONLY, so it is rejected if it would immediately match.
+func (b OrderBuilder) PostOnly() OrderBuilder {
+	b.req.ExecInst = ExecInstPostOnly
+	return b
+}
+
+// SmartPostOnly makes a limit order SMART_POST_ONLY, so its price is adjusted so that it does not immediately match.
+func (b OrderBuilder) SmartPostOnly() OrderBuilder {
+	b.req.ExecInst = ExecInstSmartPostOnly
+	return b
+}
+
+// Margin places the order against the margin account.
+func (b OrderBuilder) Margin() OrderBuilder {
+	b.req.SpotMargin = SpotMarginMargin
+	return b
+}
+
+// STPInst sets the self-trade prevention instruction of the order.
+func (b OrderBuilder) STPInst(inst STPInst) OrderBuilder {
+	b.req.STPInst = inst
+	return b
+}
+
+// Build returns the CreateOrderRequest, or an errors.InvalidParameterError if a mandatory parameter
+// of its order type is missing, a parameter is not allowed for its order type, or the options conflict.
+func (b OrderBuilder) Build() (CreateOrderRequest, error) {
+	req := b.req
+
+	if strings.TrimSpace(req.InstrumentName) == "" {
+		return CreateOrderRequest{}, errors.InvalidParameterError{Parameter: "req.InstrumentName", Reason: "cannot be empty"}
+	}
+	if req.Side == "" {
+		return CreateOrderRequest{}, errors.InvalidParameterError{Parameter: "req.Side", Reason: "cannot be empty"}
+	}
+	if req.Type == "" {
+		return CreateOrderRequest{}, errors.InvalidParameterError{Parameter: "req.Type", Reason: "cannot be empty"}
+	}
+	if err := validateExecutionOptions(req); err != nil {
+		return CreateOrderRequest{}, err
+	}
+	if err := validateOrderParameters(req); err != nil {
+		return CreateOrderRequest{}, err
+	}
+
+	return req, nil
+}
+
+// validateOrderParameters validates the price, quantity, notional & trigger price of a CreateOrderRequest
+// against the mandatory parameters of its side and type.
+func validateOrderParameters(req CreateOrderRequest) error {
+	for _, d := range []struct {
+		parameter string
+		value     Decimal
+	}{
+		{parameter: "req.Quantity", value: req.Quantity},
+		{parameter: "req.Notional", value: req.Notional},
+	} {
+		if d.value.Sign() < 0 {
+			return errors.InvalidParameterError{Parameter: d.parameter, Reason: "cannot be less than 0"}
+		}
+	}
+
+	if err := validateOrderPrices(req); err != nil {
+		return err
+	}
+
+	switch {
+	case req.Type.IsLimit() || req.Side != OrderSideBuy:
+		if req.Quantity.IsZero() {
+			return errors.InvalidParameterError{Parameter: "req.Quantity", Reason: "cannot be empty"}
+		}
+		if !req.Notional.IsZero() {
+			return errors.InvalidParameterError{Parameter: "req.Notional", Reason: "can only be provided for MARKET, STOP_LOSS and TAKE_PROFIT BUY orders"}
+		}
+	case req.Type == OrderTypeMarket:
+		if req.Quantity.IsZero() == req.Notional.IsZero() {
+			return errors.InvalidParameterError{Parameter: "req.Notional", Reason: "or req.Quantity (but not both) must be provided for MARKET BUY orders"}
+		}
+	default:
+		if req.Notional.IsZero() {
+			return errors.InvalidParameterError{Parameter: "req.Notional", Reason: "cannot be empty for STOP_LOSS and TAKE_PROFIT BUY orders"}
+		}
+		if !req.Quantity.IsZero() {
+			return errors.InvalidParameterError{Parameter: "req.Quantity", Reason: "cannot be provided for STOP_LOSS and TAKE_PROFIT BUY orders"}
+		}
+	}
+
+	return nil
+}
+
+// validateOrderPrices validates the price & trigger price of a CreateOrderRequest against the mandatory parameters of its type.
+func validateOrderPrices(req CreateOrderRequest) error {
+	for _, d := range []struct {
+		parameter string
+		value     Decimal
+	}{
+		{parameter: "req.Price", value: req.Price},
+		{parameter: "req.TriggerPrice", value: req.TriggerPrice},
+	} {
+		if d.value.Sign() < 0 {
+			return errors.InvalidParameterError{Parameter: d.parameter, Reason: "cannot be less than 0"}
+		}
+	}
+
+	triggered := req.Type != OrderTypeLimit && req.Type != OrderTypeMarket
+
+	switch {
+	case req.Type.IsLimit() && req.Price.IsZero():
+		return errors.InvalidParameterError{Parameter: "req.Price", Reason: "cannot be empty for limit orders"}
+	case !req.Type.IsLimit() && !req.Price.IsZero():
+		return errors.InvalidParameterError{Parameter: "req.Price", Reason: "can only be provided for limit orders"}
+	case triggered && req.TriggerPrice.IsZero():
+		return errors.InvalidParameterError{Parameter: "req.TriggerPrice", Reason: "cannot be empty for trigger orders"}
+	case !triggered && !req.TriggerPrice.IsZero():
+		return errors.InvalidParameterError{Parameter: "req.TriggerPrice", Reason: "can only be provided for trigger orders"}
+	}
+
+	return nil
+}
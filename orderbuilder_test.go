@@ -0,0 +1,223 @@
+package cdcexchange_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	cdcexchange "github.com/sngyai/go-cryptocom/v2"
+	cdcerrors "github.com/sngyai/go-cryptocom/v2/errors"
+)
+
+func TestOrderBuilder_Build(t *testing.T) {
+	var (
+		price    = cdcexchange.MustParseDecimal("25000.5")
+		trigger  = cdcexchange.MustParseDecimal("24000")
+		quantity = cdcexchange.MustParseDecimal("0.01")
+		notional = cdcexchange.MustParseDecimal("100")
+	)
+
+	tests := []struct {
+		name     string
+		builder  cdcexchange.OrderBuilder
+		expected cdcexchange.CreateOrderRequest
+	}{
+		{
+			name:    "limit buy with options",
+			builder: cdcexchange.NewLimitBuy("BTC_USDT", price, quantity).PostOnly().ClientOID("some client oid").Margin().STPInst(cdcexchange.STPInstCancelMaker),
+			expected: cdcexchange.CreateOrderRequest{
+				InstrumentName: "BTC_USDT",
+				Side:           cdcexchange.OrderSideBuy,
+				Type:           cdcexchange.OrderTypeLimit,
+				Price:          price,
+				Quantity:       quantity,
+				ClientOID:      "some client oid",
+				ExecInst:       cdcexchange.ExecInstPostOnly,
+				SpotMargin:     cdcexchange.SpotMarginMargin,
+				STPInst:        cdcexchange.STPInstCancelMaker,
+			},
+		},
+		{
+			name:    "limit sell immediate or cancel",
+			builder: cdcexchange.NewLimitSell("BTC_USDT", price, quantity).ImmediateOrCancel(),
+			expected: cdcexchange.CreateOrderRequest{
+				InstrumentName: "BTC_USDT",
+				Side:           cdcexchange.OrderSideSell,
+				Type:           cdcexchange.OrderTypeLimit,
+				Price:          price,
+				Quantity:       quantity,
+				TimeInForce:    cdcexchange.TimeInForceImmediateOrCancel,
+			},
+		},
+		{
+			name:    "market buy by quantity",
+			builder: cdcexchange.NewMarketBuy("BTC_USDT", quantity),
+			expected: cdcexchange.CreateOrderRequest{
+				InstrumentName: "BTC_USDT",
+				Side:           cdcexchange.OrderSideBuy,
+				Type:           cdcexchange.OrderTypeMarket,
+				Quantity:       quantity,
+			},
+		},
+		{
+			name:    "market buy by notional",
+			builder: cdcexchange.NewMarketBuyNotional("BTC_USDT", notional),
+			expected: cdcexchange.CreateOrderRequest{
+				InstrumentName: "BTC_USDT",
+				Side:           cdcexchange.OrderSideBuy,
+				Type:           cdcexchange.OrderTypeMarket,
+				Notional:       notional,
+			},
+		},
+		{
+			name:    "market sell",
+			builder: cdcexchange.NewMarketSell("BTC_USDT", quantity),
+			expected: cdcexchange.CreateOrderRequest{
+				InstrumentName: "BTC_USDT",
+				Side:           cdcexchange.OrderSideSell,
+				Type:           cdcexchange.OrderTypeMarket,
+				Quantity:       quantity,
+			},
+		},
+		{
+			name:    "stop limit",
+			builder: cdcexchange.NewOrder("BTC_USDT", cdcexchange.OrderSideSell, cdcexchange.OrderTypeStopLimit).Price(price).Quantity(quantity).TriggerPrice(trigger),
+			expected: cdcexchange.CreateOrderRequest{
+				InstrumentName: "BTC_USDT",
+				Side:           cdcexchange.OrderSideSell,
+				Type:           cdcexchange.OrderTypeStopLimit,
+				Price:          price,
+				Quantity:       quantity,
+				TriggerPrice:   trigger,
+			},
+		},
+		{
+			name:    "stop loss buy",
+			builder: cdcexchange.NewOrder("BTC_USDT", cdcexchange.OrderSideBuy, cdcexchange.OrderTypeStopLoss).Notional(notional).TriggerPrice(trigger),
+			expected: cdcexchange.CreateOrderRequest{
+				InstrumentName: "BTC_USDT",
+				Side:           cdcexchange.OrderSideBuy,
+				Type:           cdcexchange.OrderTypeStopLoss,
+				Notional:       notional,
+				TriggerPrice:   trigger,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req, err := tt.builder.Build()
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, req)
+		})
+	}
+}
+
+func TestOrderBuilder_Build_Template(t *testing.T) {
+	template := cdcexchange.NewLimitBuy("BTC_USDT", cdcexchange.MustParseDecimal("25000"), cdcexchange.MustParseDecimal("0.01"))
+
+	postOnly, err := template.PostOnly().Build()
+	require.NoError(t, err)
+	req, err := template.Build()
+	require.NoError(t, err)
+
+	assert.Equal(t, cdcexchange.ExecInstPostOnly, postOnly.ExecInst)
+	assert.Empty(t, req.ExecInst)
+}
+
+func TestOrderBuilder_Build_Error(t *testing.T) {
+	var (
+		price    = cdcexchange.MustParseDecimal("25000.5")
+		trigger  = cdcexchange.MustParseDecimal("24000")
+		quantity = cdcexchange.MustParseDecimal("0.01")
+		notional = cdcexchange.MustParseDecimal("100")
+	)
+
+	tests := []struct {
+		name        string
+		builder     cdcexchange.OrderBuilder
+		expectedErr error
+	}{
+		{
+			name:        "returns error when instrument name is empty",
+			builder:     cdcexchange.NewLimitBuy(" ", price, quantity),
+			expectedErr: cdcerrors.InvalidParameterError{Parameter: "req.InstrumentName", Reason: "cannot be empty"},
+		},
+		{
+			name:        "returns error when side is empty",
+			builder:     cdcexchange.NewOrder("BTC_USDT", "", cdcexchange.OrderTypeLimit),
+			expectedErr: cdcerrors.InvalidParameterError{Parameter: "req.Side", Reason: "cannot be empty"},
+		},
+		{
+			name:        "returns error when type is empty",
+			builder:     cdcexchange.NewOrder("BTC_USDT", cdcexchange.OrderSideBuy, ""),
+			expectedErr: cdcerrors.InvalidParameterError{Parameter: "req.Type", Reason: "cannot be empty"},
+		},
+		{
+			name:        "returns error when options conflict",
+			builder:     cdcexchange.NewLimitBuy("BTC_USDT", price, quantity).PostOnly().FillOrKill(),
+			expectedErr: cdcerrors.InvalidParameterError{Parameter: "req.TimeInForce", Reason: "must be GOOD_TILL_CANCEL when req.ExecInst is provided"},
+		},
+		{
+			name:        "returns error when options are set on a market order",
+			builder:     cdcexchange.NewMarketSell("BTC_USDT", quantity).PostOnly(),
+			expectedErr: cdcerrors.InvalidParameterError{Parameter: "req.Type", Reason: "must be a limit order type when req.TimeInForce or req.ExecInst is provided"},
+		},
+		{
+			name:        "returns error when price is negative",
+			builder:     cdcexchange.NewLimitBuy("BTC_USDT", price.Neg(), quantity),
+			expectedErr: cdcerrors.InvalidParameterError{Parameter: "req.Price", Reason: "cannot be less than 0"},
+		},
+		{
+			name:        "returns error when price of a limit order is empty",
+			builder:     cdcexchange.NewLimitBuy("BTC_USDT", cdcexchange.Decimal{}, quantity),
+			expectedErr: cdcerrors.InvalidParameterError{Parameter: "req.Price", Reason: "cannot be empty for limit orders"},
+		},
+		{
+			name:        "returns error when price of a market order is provided",
+			builder:     cdcexchange.NewMarketSell("BTC_USDT", quantity).Price(price),
+			expectedErr: cdcerrors.InvalidParameterError{Parameter: "req.Price", Reason: "can only be provided for limit orders"},
+		},
+		{
+			name:        "returns error when trigger price of a trigger order is empty",
+			builder:     cdcexchange.NewOrder("BTC_USDT", cdcexchange.OrderSideSell, cdcexchange.OrderTypeStopLoss).Quantity(quantity),
+			expectedErr: cdcerrors.InvalidParameterError{Parameter: "req.TriggerPrice", Reason: "cannot be empty for trigger orders"},
+		},
+		{
+			name:        "returns error when trigger price of a limit order is provided",
+			builder:     cdcexchange.NewLimitSell("BTC_USDT", price, quantity).TriggerPrice(trigger),
+			expectedErr: cdcerrors.InvalidParameterError{Parameter: "req.TriggerPrice", Reason: "can only be provided for trigger orders"},
+		},
+		{
+			name:        "returns error when quantity of a limit order is empty",
+			builder:     cdcexchange.NewLimitSell("BTC_USDT", price, cdcexchange.Decimal{}),
+			expectedErr: cdcerrors.InvalidParameterError{Parameter: "req.Quantity", Reason: "cannot be empty"},
+		},
+		{
+			name:        "returns error when notional of a sell order is provided",
+			builder:     cdcexchange.NewMarketSell("BTC_USDT", quantity).Notional(notional),
+			expectedErr: cdcerrors.InvalidParameterError{Parameter: "req.Notional", Reason: "can only be provided for MARKET, STOP_LOSS and TAKE_PROFIT BUY orders"},
+		},
+		{
+			name:        "returns error when both quantity and notional of a market buy order are provided",
+			builder:     cdcexchange.NewMarketBuy("BTC_USDT", quantity).Notional(notional),
+			expectedErr: cdcerrors.InvalidParameterError{Parameter: "req.Notional", Reason: "or req.Quantity (but not both) must be provided for MARKET BUY orders"},
+		},
+		{
+			name:        "returns error when notional of a stop loss buy order is empty",
+			builder:     cdcexchange.NewOrder("BTC_USDT", cdcexchange.OrderSideBuy, cdcexchange.OrderTypeStopLoss).TriggerPrice(trigger),
+			expectedErr: cdcerrors.InvalidParameterError{Parameter: "req.Notional", Reason: "cannot be empty for STOP_LOSS and TAKE_PROFIT BUY orders"},
+		},
+		{
+			name:        "returns error when quantity of a take profit buy order is provided",
+			builder:     cdcexchange.NewOrder("BTC_USDT", cdcexchange.OrderSideBuy, cdcexchange.OrderTypeTakeProfit).Notional(notional).Quantity(quantity).TriggerPrice(trigger),
+			expectedErr: cdcerrors.InvalidParameterError{Parameter: "req.Quantity", Reason: "cannot be provided for STOP_LOSS and TAKE_PROFIT BUY orders"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := tt.builder.Build()
+			assert.Equal(t, tt.expectedErr, err)
+		})
+	}
+}
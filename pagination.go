@@ -0,0 +1,229 @@
+package cdcexchange
+
+import (
+	"context"
+	"sync"
+
+	"github.com/sngyai/go-cryptocom/v2/errors"
+)
+
+const (
+	// defaultPageSize is the page size used by the paginated APIs if none is provided.
+	defaultPageSize = 20
+	// maxPageSize is the maximum page size accepted by the paginated APIs.
+	maxPageSize = 200
+)
+
+type (
+	// PageRequest is the pagination params shared by the paginated APIs.
+	PageRequest struct {
+		// PageSize represents maximum number of records returned (for pagination)
+		// (Default: 20, Max: 200)
+		// if PageSize is not set, it will be set as 20 by default.
+		PageSize Optional[int] `json:"page_size"`
+		// Page represents the page number (for pagination)
+		// (0-based)
+		// if Page is not set, the first page is returned by default. Some(0) requests page 0 explicitly.
+		Page Optional[int] `json:"page"`
+	}
+
+	// Page is a single page of records returned by a paginated API.
+	Page[T any] struct {
+		// Number is the page number (0-based).
+		Number int
+		// Items are the records of the page.
+		Items []T
+	}
+
+	// PageFunc fetches the records of a single page of a paginated API (e.g. by calling GetTrades with page set).
+	PageFunc[T any] func(ctx context.Context, page PageRequest) ([]T, error)
+
+	// Pager enumerates the pages of a paginated API, starting with page 0, until a page with fewer records
+	// than the page size is returned.
+	//
+	//	pager, err := cdcexchange.NewPager(func(ctx context.Context, page cdcexchange.PageRequest) ([]cdcexchange.Trade, error) {
+	//		return client.GetTrades(ctx, cdcexchange.GetTradesRequest{InstrumentName: "BTC_USDT", PageRequest: page})
+	//	}, 200)
+	//	if err != nil {
+	//		return err
+	//	}
+	//
+	//	for pager.HasNext() {
+	//		page, err := pager.Next(ctx)
+	//		if err != nil {
+	//			return err
+	//		}
+	//		...
+	//	}
+	//
+	// A Pager is not safe for concurrent use.
+	Pager[T any] struct {
+		fetch       PageFunc[T]
+		pageSize    int
+		concurrency int
+		next        int
+		done        bool
+	}
+
+	// PagerOption represents optional configurations for a Pager.
+	PagerOption func(*pagerOptions) error
+
+	pagerOptions struct {
+		concurrency int
+	}
+)
+
+// validate returns an error if the page size or page number of p is out of range.
+func (p PageRequest) validate() error {
+	pageSize, _ := p.PageSize.Get()
+	if pageSize < 0 {
+		return errors.InvalidParameterError{Parameter: "req.PageSize", Reason: "cannot be less than 0"}
+	}
+	if pageSize > maxPageSize {
+		return errors.InvalidParameterError{Parameter: "req.PageSize", Reason: "cannot be greater than 200"}
+	}
+	if page, _ := p.Page.Get(); page < 0 {
+		return errors.InvalidParameterError{Parameter: "req.Page", Reason: "cannot be less than 0"}
+	}
+
+	return nil
+}
+
+// setParams sets the page_size and page params of a request from p, if they are set.
+func (p PageRequest) setParams(params map[string]interface{}) {
+	if pageSize, ok := p.PageSize.Get(); ok {
+		params["page_size"] = pageSize
+	}
+	if page, ok := p.Page.Get(); ok {
+		params["page"] = page
+	}
+}
+
+// WithPagerConcurrency sets the number of pages fetched concurrently by Pager.All (Default: 1).
+//
+// Pages are fetched in batches of n, so up to n-1 requests past the last page may be made.
+func WithPagerConcurrency(n int) PagerOption {
+	return func(o *pagerOptions) error {
+		if n < 1 {
+			return errors.InvalidParameterError{Parameter: "n", Reason: "cannot be less than 1"}
+		}
+
+		o.concurrency = n
+
+		return nil
+	}
+}
+
+// NewPager creates a Pager which fetches pages of pageSize records (Default: 20, Max: 200) using fetch.
+func NewPager[T any](fetch PageFunc[T], pageSize int, opts ...PagerOption) (*Pager[T], error) {
+	if fetch == nil {
+		return nil, errors.InvalidParameterError{Parameter: "fetch", Reason: "cannot be empty"}
+	}
+	if err := (PageRequest{PageSize: Some(pageSize)}).validate(); err != nil {
+		return nil, err
+	}
+	if pageSize == 0 {
+		pageSize = defaultPageSize
+	}
+
+	o := pagerOptions{concurrency: 1}
+	for _, opt := range opts {
+		if err := opt(&o); err != nil {
+			return nil, err
+		}
+	}
+
+	return &Pager[T]{
+		fetch:       fetch,
+		pageSize:    pageSize,
+		concurrency: o.concurrency,
+	}, nil
+}
+
+// HasNext returns whether there may be more pages to fetch.
+func (p *Pager[T]) HasNext() bool {
+	return !p.done
+}
+
+// Next fetches the next page, or returns an empty page if there are no more pages.
+//
+// The page after a failed page is the failed page, so Next can be retried after an error.
+func (p *Pager[T]) Next(ctx context.Context) (*Page[T], error) {
+	if p.done {
+		return &Page[T]{Number: p.next}, nil
+	}
+
+	items, err := p.fetch(ctx, PageRequest{PageSize: Some(p.pageSize), Page: Some(p.next)})
+	if err != nil {
+		return nil, err
+	}
+
+	page := &Page[T]{Number: p.next, Items: items}
+	p.next++
+	p.done = len(items) < p.pageSize
+
+	return page, nil
+}
+
+// All fetches all the remaining pages and returns their records in page order.
+//
+// Up to the concurrency configured with WithPagerConcurrency pages are fetched at a time.
+func (p *Pager[T]) All(ctx context.Context) ([]T, error) {
+	var all []T
+	for !p.done {
+		pages, err := p.nextBatch(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, page := range pages {
+			all = append(all, page.Items...)
+		}
+	}
+
+	return all, nil
+}
+
+// nextBatch concurrently fetches the next p.concurrency pages, and returns the pages up to and including the
+// last page. If any of those pages fails, no pages are returned so that the batch is fetched again on retry.
+func (p *Pager[T]) nextBatch(ctx context.Context) ([]*Page[T], error) {
+	if p.concurrency == 1 {
+		page, err := p.Next(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		return []*Page[T]{page}, nil
+	}
+
+	var (
+		wg    sync.WaitGroup
+		items = make([][]T, p.concurrency)
+		errs  = make([]error, p.concurrency)
+	)
+	for i := 0; i < p.concurrency; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			items[i], errs[i] = p.fetch(ctx, PageRequest{PageSize: Some(p.pageSize), Page: Some(p.next + i)})
+		}(i)
+	}
+	wg.Wait()
+
+	pages := make([]*Page[T], 0, p.concurrency)
+	for i := 0; i < p.concurrency; i++ {
+		if errs[i] != nil {
+			return nil, errs[i]
+		}
+
+		pages = append(pages, &Page[T]{Number: p.next + i, Items: items[i]})
+		if len(items[i]) < p.pageSize {
+			break
+		}
+	}
+
+	p.next += len(pages)
+	p.done = len(pages[len(pages)-1].Items) < p.pageSize
+
+	return pages, nil
+}
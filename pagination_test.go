@@ -0,0 +1,176 @@
+package cdcexchange_test
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	cdcexchange "github.com/sngyai/go-cryptocom/v2"
+	cdcerrors "github.com/sngyai/go-cryptocom/v2/errors"
+)
+
+// pages returns a PageFunc which returns total records split into pages, recording the requested pages.
+func pages(total int, requested *[]cdcexchange.PageRequest) cdcexchange.PageFunc[int] {
+	var mu sync.Mutex
+
+	return func(ctx context.Context, page cdcexchange.PageRequest) ([]int, error) {
+		mu.Lock()
+		*requested = append(*requested, page)
+		mu.Unlock()
+
+		var (
+			number, _   = page.Page.Get()
+			pageSize, _ = page.PageSize.Get()
+			items       []int
+		)
+		for i := number * pageSize; i < total && i < (number+1)*pageSize; i++ {
+			items = append(items, i)
+		}
+
+		return items, nil
+	}
+}
+
+func TestNewPager_Error(t *testing.T) {
+	fetch := func(ctx context.Context, page cdcexchange.PageRequest) ([]int, error) { return nil, nil }
+
+	tests := []struct {
+		name        string
+		fetch       cdcexchange.PageFunc[int]
+		pageSize    int
+		opts        []cdcexchange.PagerOption
+		expectedErr error
+	}{
+		{
+			name:        "returns error when fetch is nil",
+			pageSize:    10,
+			expectedErr: cdcerrors.InvalidParameterError{Parameter: "fetch", Reason: "cannot be empty"},
+		},
+		{
+			name:        "returns error when page size is less than 0",
+			fetch:       fetch,
+			pageSize:    -1,
+			expectedErr: cdcerrors.InvalidParameterError{Parameter: "req.PageSize", Reason: "cannot be less than 0"},
+		},
+		{
+			name:        "returns error when page size is greater than 200",
+			fetch:       fetch,
+			pageSize:    201,
+			expectedErr: cdcerrors.InvalidParameterError{Parameter: "req.PageSize", Reason: "cannot be greater than 200"},
+		},
+		{
+			name:        "returns error when concurrency is less than 1",
+			fetch:       fetch,
+			pageSize:    10,
+			opts:        []cdcexchange.PagerOption{cdcexchange.WithPagerConcurrency(0)},
+			expectedErr: cdcerrors.InvalidParameterError{Parameter: "n", Reason: "cannot be less than 1"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pager, err := cdcexchange.NewPager(tt.fetch, tt.pageSize, tt.opts...)
+			require.Error(t, err)
+
+			assert.Nil(t, pager)
+			assert.Equal(t, tt.expectedErr, err)
+		})
+	}
+}
+
+func TestPager_Next(t *testing.T) {
+	var requested []cdcexchange.PageRequest
+	pager, err := cdcexchange.NewPager(pages(5, &requested), 2)
+	require.NoError(t, err)
+
+	var got []cdcexchange.Page[int]
+	for pager.HasNext() {
+		page, err := pager.Next(context.Background())
+		require.NoError(t, err)
+
+		got = append(got, *page)
+	}
+
+	assert.Equal(t, []cdcexchange.Page[int]{
+		{Number: 0, Items: []int{0, 1}},
+		{Number: 1, Items: []int{2, 3}},
+		{Number: 2, Items: []int{4}},
+	}, got)
+	assert.Equal(t, []cdcexchange.PageRequest{
+		{PageSize: cdcexchange.Some(2), Page: cdcexchange.Some(0)},
+		{PageSize: cdcexchange.Some(2), Page: cdcexchange.Some(1)},
+		{PageSize: cdcexchange.Some(2), Page: cdcexchange.Some(2)},
+	}, requested)
+
+	page, err := pager.Next(context.Background())
+	require.NoError(t, err)
+	assert.Empty(t, page.Items)
+}
+
+func TestPager_All(t *testing.T) {
+	tests := []struct {
+		name             string
+		total            int
+		pageSize         int
+		concurrency      int
+		expectedRequests int
+	}{
+		{name: "fetches pages sequentially", total: 45, pageSize: 10, concurrency: 1, expectedRequests: 5},
+		{name: "fetches an extra empty page when the last page is full", total: 40, pageSize: 10, concurrency: 1, expectedRequests: 5},
+		{name: "uses the default page size", total: 45, concurrency: 1, expectedRequests: 3},
+		{name: "fetches pages concurrently", total: 45, pageSize: 10, concurrency: 3, expectedRequests: 6},
+		{name: "fetches no records", total: 0, pageSize: 10, concurrency: 4, expectedRequests: 4},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var requested []cdcexchange.PageRequest
+			pager, err := cdcexchange.NewPager(pages(tt.total, &requested), tt.pageSize, cdcexchange.WithPagerConcurrency(tt.concurrency))
+			require.NoError(t, err)
+
+			all, err := pager.All(context.Background())
+			require.NoError(t, err)
+
+			require.Len(t, all, tt.total)
+			for i, item := range all {
+				assert.Equal(t, i, item)
+			}
+			assert.Len(t, requested, tt.expectedRequests)
+			assert.False(t, pager.HasNext())
+		})
+	}
+}
+
+func TestPager_All_Error(t *testing.T) {
+	testErr := errors.New("some error")
+
+	var (
+		requested []cdcexchange.PageRequest
+		fail      = true
+		fetch     = pages(30, &requested)
+	)
+	pager, err := cdcexchange.NewPager(func(ctx context.Context, page cdcexchange.PageRequest) ([]int, error) {
+		if page.Page == cdcexchange.Some(1) && fail {
+			return nil, testErr
+		}
+
+		return fetch(ctx, page)
+	}, 10, cdcexchange.WithPagerConcurrency(2))
+	require.NoError(t, err)
+
+	all, err := pager.All(context.Background())
+	require.Error(t, err)
+
+	assert.Nil(t, all)
+	assert.True(t, errors.Is(err, testErr))
+	assert.True(t, pager.HasNext())
+
+	fail = false
+
+	all, err = pager.All(context.Background())
+	require.NoError(t, err)
+
+	assert.Len(t, all, 30)
+}
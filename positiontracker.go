@@ -0,0 +1,265 @@
+package cdcexchange
+
+import (
+	"fmt"
+	"math/big"
+	"sort"
+	"sync"
+
+	"github.com/sngyai/go-cryptocom/v2/errors"
+)
+
+// positionDecimalPlaces is the number of decimal places the average cost and PnL of a position are rounded to,
+// when they cannot be represented exactly (e.g. the average cost of 1 bought at 1 and 2 bought at 2).
+const positionDecimalPlaces = 18
+
+type (
+	// Position is the position of an instrument maintained by a PositionTracker.
+	Position struct {
+		// InstrumentName is the instrument of the position (e.g. BTC_USDT).
+		InstrumentName string
+		// Quantity is the net quantity of the position: positive if long, negative if short and 0 if flat.
+		Quantity Decimal
+		// AverageCost is the average price the open position was entered at, 0 if flat.
+		AverageCost Decimal
+		// RealizedPnL is the profit (negative for a loss) realized by closing the position, in the quote currency.
+		// Fees are not included (see Fees).
+		RealizedPnL Decimal
+		// UnrealizedPnL is the profit (negative for a loss) of the open position at MarkPrice, in the quote currency,
+		// 0 if the position has not been marked.
+		UnrealizedPnL Decimal
+		// MarkPrice is the latest price the position was marked at, 0 if it has not been marked.
+		MarkPrice Decimal
+		// Fees is the total fee charged for the trades of the position (negative for rebates), by currency.
+		Fees map[string]Decimal
+	}
+
+	// PositionTracker maintains the position, average cost and realized and unrealized PnL of instruments
+	// from the trades of the account (e.g. returned by GetTrades) and their prices (e.g. returned by GetTickers).
+	//
+	// The average cost of a position is the average price of the trades which increased it. The PnL of a trade
+	// reducing a position is realized against the average cost, and a trade which reverses a position
+	// (e.g. selling more than a long position) opens the opposite position at its price.
+	//
+	// A PositionTracker is safe for concurrent use.
+	PositionTracker struct {
+		mu        sync.RWMutex
+		positions map[string]*position
+		trades    map[string]struct{}
+	}
+
+	// position is the state of a position, kept exactly so that rounding errors don't accumulate.
+	position struct {
+		quantity  *big.Rat
+		cost      *big.Rat
+		realized  *big.Rat
+		markPrice Decimal
+		fees      map[string]Decimal
+	}
+)
+
+// NewPositionTracker creates an empty PositionTracker.
+func NewPositionTracker() *PositionTracker {
+	return &PositionTracker{
+		positions: make(map[string]*position),
+		trades:    make(map[string]struct{}),
+	}
+}
+
+// ApplyTrade updates the position of the instrument of trade with it. false is returned if a trade with the
+// same TradeID has already been applied, so that overlapping pages of trades can be applied safely.
+//
+// Trades should be applied in the order they were executed, as the average cost and realized PnL depend on it
+// (see ApplyTrades).
+//
+// An errors.InvalidParameterError is returned if the side of trade is not BUY or SELL.
+func (pt *PositionTracker) ApplyTrade(trade Trade) (bool, error) {
+	if !trade.Side.IsValid() {
+		return false, errors.InvalidParameterError{Parameter: "trade.Side", Reason: "must be BUY or SELL"}
+	}
+
+	pt.mu.Lock()
+	defer pt.mu.Unlock()
+
+	return pt.applyTrade(trade), nil
+}
+
+// ApplyTrades applies trades in the order they were executed (GetTrades returns the newest trades first),
+// and returns the number of trades which had not already been applied. Trades executed at the same time are
+// applied in the order of their TradeID, which increases with each trade.
+//
+// An errors.InvalidParameterError is returned, and no trade is applied, if the side of a trade is not BUY or SELL.
+func (pt *PositionTracker) ApplyTrades(trades []Trade) (int, error) {
+	for i, trade := range trades {
+		if !trade.Side.IsValid() {
+			return 0, errors.InvalidParameterError{Parameter: fmt.Sprintf("trades[%d].Side", i), Reason: "must be BUY or SELL"}
+		}
+	}
+
+	sorted := make([]Trade, len(trades))
+	copy(sorted, trades)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		ti, tj := sorted[i].CreateTime.Time(), sorted[j].CreateTime.Time()
+		if !ti.Equal(tj) {
+			return ti.Before(tj)
+		}
+
+		return tradeIDLess(sorted[i].TradeID, sorted[j].TradeID)
+	})
+
+	pt.mu.Lock()
+	defer pt.mu.Unlock()
+
+	var applied int
+	for _, trade := range sorted {
+		if pt.applyTrade(trade) {
+			applied++
+		}
+	}
+
+	return applied, nil
+}
+
+// Mark sets the price the position of instrument is marked at, to calculate its unrealized PnL.
+func (pt *PositionTracker) Mark(instrument string, price Decimal) {
+	pt.mu.Lock()
+	defer pt.mu.Unlock()
+
+	pt.position(instrument).markPrice = price
+}
+
+// ApplyTicker marks the position of the instrument of ticker at its latest trade price,
+// unless there weren't any trades.
+func (pt *PositionTracker) ApplyTicker(ticker Ticker) {
+	if ticker.LatestTradePrice.IsZero() {
+		return
+	}
+
+	pt.Mark(ticker.Instrument, ticker.LatestTradePrice)
+}
+
+// Position returns the position of instrument. false is returned if no trade or price of instrument has been applied.
+func (pt *PositionTracker) Position(instrument string) (Position, bool) {
+	pt.mu.RLock()
+	defer pt.mu.RUnlock()
+
+	p, ok := pt.positions[instrument]
+	if !ok {
+		return Position{}, false
+	}
+
+	return p.snapshot(instrument), true
+}
+
+// Positions returns the positions of all instruments a trade or price has been applied for, sorted by instrument.
+func (pt *PositionTracker) Positions() []Position {
+	pt.mu.RLock()
+	defer pt.mu.RUnlock()
+
+	positions := make([]Position, 0, len(pt.positions))
+	for instrument, p := range pt.positions {
+		positions = append(positions, p.snapshot(instrument))
+	}
+	sort.Slice(positions, func(i, j int) bool {
+		return positions[i].InstrumentName < positions[j].InstrumentName
+	})
+
+	return positions
+}
+
+// applyTrade applies trade, unless it has already been applied. pt.mu must be held.
+func (pt *PositionTracker) applyTrade(trade Trade) bool {
+	if trade.TradeID != "" {
+		if _, ok := pt.trades[trade.TradeID]; ok {
+			return false
+		}
+		pt.trades[trade.TradeID] = struct{}{}
+	}
+
+	p := pt.position(trade.InstrumentName)
+
+	var (
+		price    = trade.TradedPrice.rat()
+		quantity = trade.TradedQuantity.rat()
+	)
+	if trade.Side == OrderSideSell {
+		quantity.Neg(quantity)
+	}
+
+	// the part of the trade in the opposite direction of the position closes it, realizing its PnL.
+	if p.quantity.Sign() != 0 && p.quantity.Sign() != quantity.Sign() {
+		closed := new(big.Rat).Neg(quantity)
+		if new(big.Rat).Abs(closed).Cmp(new(big.Rat).Abs(p.quantity)) > 0 {
+			closed.Set(p.quantity)
+		}
+
+		// closed has the sign of the position, so the PnL is closed * (price - average cost).
+		closedCost := new(big.Rat).Mul(p.cost, new(big.Rat).Quo(closed, p.quantity))
+		p.realized.Add(p.realized, new(big.Rat).Sub(new(big.Rat).Mul(closed, price), closedCost))
+		p.cost.Sub(p.cost, closedCost)
+		p.quantity.Sub(p.quantity, closed)
+		quantity.Add(quantity, closed)
+	}
+
+	// the rest of the trade opens or increases the position.
+	p.cost.Add(p.cost, new(big.Rat).Mul(quantity, price))
+	p.quantity.Add(p.quantity, quantity)
+
+	if !trade.Fee.IsZero() {
+		p.fees[trade.FeeCurrency] = p.fees[trade.FeeCurrency].Add(trade.Fee)
+	}
+
+	return true
+}
+
+// tradeIDLess returns whether the trade with id a was executed before the trade with id b.
+// Trade ids are decimal numbers, so a shorter id is a smaller number.
+func tradeIDLess(a string, b string) bool {
+	if len(a) != len(b) {
+		return len(a) < len(b)
+	}
+
+	return a < b
+}
+
+// position returns the position of instrument, creating it if needed. pt.mu must be held.
+func (pt *PositionTracker) position(instrument string) *position {
+	p, ok := pt.positions[instrument]
+	if !ok {
+		p = &position{
+			quantity: new(big.Rat),
+			cost:     new(big.Rat),
+			realized: new(big.Rat),
+			fees:     make(map[string]Decimal),
+		}
+		pt.positions[instrument] = p
+	}
+
+	return p
+}
+
+// snapshot returns a copy of p as the Position of instrument.
+func (p *position) snapshot(instrument string) Position {
+	position := Position{
+		InstrumentName: instrument,
+		Quantity:       newDecimalFromRatRounded(p.quantity, positionDecimalPlaces),
+		RealizedPnL:    newDecimalFromRatRounded(p.realized, positionDecimalPlaces),
+		MarkPrice:      p.markPrice,
+		Fees:           make(map[string]Decimal, len(p.fees)),
+	}
+
+	if p.quantity.Sign() != 0 {
+		position.AverageCost = newDecimalFromRatRounded(new(big.Rat).Quo(p.cost, p.quantity), positionDecimalPlaces)
+
+		if !p.markPrice.IsZero() {
+			unrealized := new(big.Rat).Sub(new(big.Rat).Mul(p.quantity, p.markPrice.rat()), p.cost)
+			position.UnrealizedPnL = newDecimalFromRatRounded(unrealized, positionDecimalPlaces)
+		}
+	}
+
+	for currency, fee := range p.fees {
+		position.Fees[currency] = fee
+	}
+
+	return position
+}
@@ -0,0 +1,221 @@
+package cdcexchange_test
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	cdcexchange "github.com/sngyai/go-cryptocom/v2"
+	cdcerrors "github.com/sngyai/go-cryptocom/v2/errors"
+)
+
+func positionTrade(id string, side cdcexchange.OrderSide, quantity string, price string) cdcexchange.Trade {
+	return cdcexchange.Trade{
+		TradeID:        id,
+		InstrumentName: "BTC_USDT",
+		Side:           side,
+		TradedQuantity: cdcexchange.MustParseDecimal(quantity),
+		TradedPrice:    cdcexchange.MustParseDecimal(price),
+	}
+}
+
+func TestPositionTracker(t *testing.T) {
+	tracker := cdcexchange.NewPositionTracker()
+
+	_, ok := tracker.Position("BTC_USDT")
+	assert.False(t, ok)
+
+	buy := positionTrade("1", cdcexchange.OrderSideBuy, "1", "100")
+	buy.Fee = cdcexchange.MustParseDecimal("0.1")
+	buy.FeeCurrency = "USDT"
+	applied, err := tracker.ApplyTrade(buy)
+	require.NoError(t, err)
+	assert.True(t, applied)
+	applied, err = tracker.ApplyTrade(positionTrade("2", cdcexchange.OrderSideBuy, "1", "200"))
+	require.NoError(t, err)
+	assert.True(t, applied)
+
+	position, ok := tracker.Position("BTC_USDT")
+	require.True(t, ok)
+	assert.Equal(t, "2", position.Quantity.String())
+	assert.Equal(t, "150", position.AverageCost.String())
+	assert.True(t, position.UnrealizedPnL.IsZero())
+	assert.Equal(t, map[string]cdcexchange.Decimal{"USDT": cdcexchange.MustParseDecimal("0.1")}, position.Fees)
+
+	t.Run("realizes the PnL of reducing a position against its average cost", func(t *testing.T) {
+		applied, err := tracker.ApplyTrade(positionTrade("3", cdcexchange.OrderSideSell, "0.5", "250"))
+		require.NoError(t, err)
+		assert.True(t, applied)
+		tracker.Mark("BTC_USDT", cdcexchange.MustParseDecimal("200"))
+
+		position, _ := tracker.Position("BTC_USDT")
+		assert.Equal(t, "1.5", position.Quantity.String())
+		assert.Equal(t, "150", position.AverageCost.String())
+		assert.Equal(t, "50", position.RealizedPnL.String())
+		assert.Equal(t, "200", position.MarkPrice.String())
+		assert.Equal(t, "75", position.UnrealizedPnL.String())
+	})
+
+	t.Run("opens the opposite position with the rest of a trade reversing a position", func(t *testing.T) {
+		applied, err := tracker.ApplyTrade(positionTrade("4", cdcexchange.OrderSideSell, "2", "100"))
+		require.NoError(t, err)
+		assert.True(t, applied)
+
+		position, _ := tracker.Position("BTC_USDT")
+		assert.Equal(t, "-0.5", position.Quantity.String())
+		assert.Equal(t, "100", position.AverageCost.String())
+		assert.Equal(t, "-25", position.RealizedPnL.String())
+		assert.Equal(t, "-50", position.UnrealizedPnL.String())
+	})
+
+	t.Run("closes a short position", func(t *testing.T) {
+		applied, err := tracker.ApplyTrade(positionTrade("5", cdcexchange.OrderSideBuy, "0.5", "80"))
+		require.NoError(t, err)
+		assert.True(t, applied)
+
+		position, _ := tracker.Position("BTC_USDT")
+		assert.True(t, position.Quantity.IsZero())
+		assert.True(t, position.AverageCost.IsZero())
+		assert.Equal(t, "-15", position.RealizedPnL.String())
+		assert.True(t, position.UnrealizedPnL.IsZero())
+	})
+
+	t.Run("ignores trades which have already been applied", func(t *testing.T) {
+		applied, err := tracker.ApplyTrade(positionTrade("5", cdcexchange.OrderSideBuy, "0.5", "80"))
+		require.NoError(t, err)
+		assert.False(t, applied)
+
+		position, _ := tracker.Position("BTC_USDT")
+		assert.True(t, position.Quantity.IsZero())
+	})
+}
+
+func TestPositionTracker_ApplyTrades(t *testing.T) {
+	tracker := cdcexchange.NewPositionTracker()
+
+	now := time.Now()
+	sell := positionTrade("2", cdcexchange.OrderSideSell, "1", "200")
+	sell.CreateTime = cdcexchange.Timestamp(now)
+	buy := positionTrade("1", cdcexchange.OrderSideBuy, "1", "100")
+	buy.CreateTime = cdcexchange.Timestamp(now.Add(-time.Minute))
+
+	// trades are returned newest first by GetTrades.
+	applied, err := tracker.ApplyTrades([]cdcexchange.Trade{sell, buy})
+	require.NoError(t, err)
+	assert.Equal(t, 2, applied)
+
+	applied, err = tracker.ApplyTrades([]cdcexchange.Trade{sell, buy})
+	require.NoError(t, err)
+	assert.Equal(t, 0, applied)
+
+	position, ok := tracker.Position("BTC_USDT")
+	require.True(t, ok)
+	assert.True(t, position.Quantity.IsZero())
+	assert.Equal(t, "100", position.RealizedPnL.String())
+}
+
+func TestPositionTracker_ApplyTrades_SameTime(t *testing.T) {
+	tracker := cdcexchange.NewPositionTracker()
+
+	now := cdcexchange.Timestamp(time.Now())
+	buy := positionTrade("9", cdcexchange.OrderSideBuy, "1", "100")
+	buy.CreateTime = now
+	sell := positionTrade("10", cdcexchange.OrderSideSell, "1", "200")
+	sell.CreateTime = now
+
+	// the sell would open a short position at 200 if it was applied first.
+	applied, err := tracker.ApplyTrades([]cdcexchange.Trade{sell, buy})
+	require.NoError(t, err)
+	assert.Equal(t, 2, applied)
+
+	position, ok := tracker.Position("BTC_USDT")
+	require.True(t, ok)
+	assert.True(t, position.Quantity.IsZero())
+	assert.Equal(t, "100", position.RealizedPnL.String())
+}
+
+func TestPositionTracker_InvalidSide(t *testing.T) {
+	tracker := cdcexchange.NewPositionTracker()
+
+	applied, err := tracker.ApplyTrade(positionTrade("1", "HOLD", "1", "100"))
+	assert.ErrorIs(t, err, cdcerrors.InvalidParameterError{Parameter: "trade.Side", Reason: "must be BUY or SELL"})
+	assert.False(t, applied)
+
+	count, err := tracker.ApplyTrades([]cdcexchange.Trade{
+		positionTrade("2", cdcexchange.OrderSideBuy, "1", "100"),
+		positionTrade("3", "", "1", "100"),
+	})
+	assert.ErrorIs(t, err, cdcerrors.InvalidParameterError{Parameter: "trades[1].Side", Reason: "must be BUY or SELL"})
+	assert.Zero(t, count)
+
+	_, ok := tracker.Position("BTC_USDT")
+	assert.False(t, ok)
+
+	// a rejected trade is not recorded as applied.
+	applied, err = tracker.ApplyTrade(positionTrade("1", cdcexchange.OrderSideBuy, "1", "100"))
+	require.NoError(t, err)
+	assert.True(t, applied)
+}
+
+func TestPositionTracker_AverageCost(t *testing.T) {
+	tracker := cdcexchange.NewPositionTracker()
+	for _, trade := range []cdcexchange.Trade{
+		positionTrade("1", cdcexchange.OrderSideBuy, "1", "1"),
+		positionTrade("2", cdcexchange.OrderSideBuy, "2", "2"),
+		positionTrade("3", cdcexchange.OrderSideSell, "1.5", "2"),
+	} {
+		_, err := tracker.ApplyTrade(trade)
+		require.NoError(t, err)
+	}
+
+	position, _ := tracker.Position("BTC_USDT")
+	assert.Equal(t, "1.666666666666666667", position.AverageCost.String())
+	assert.Equal(t, "0.5", position.RealizedPnL.String())
+}
+
+func TestPositionTracker_ApplyTicker(t *testing.T) {
+	tracker := cdcexchange.NewPositionTracker()
+	_, err := tracker.ApplyTrade(positionTrade("1", cdcexchange.OrderSideBuy, "2", "100"))
+	require.NoError(t, err)
+
+	tracker.ApplyTicker(cdcexchange.Ticker{Instrument: "BTC_USDT", LatestTradePrice: cdcexchange.MustParseDecimal("110")})
+	tracker.ApplyTicker(cdcexchange.Ticker{Instrument: "BTC_USDT"})
+	tracker.ApplyTicker(cdcexchange.Ticker{Instrument: "ETH_USDT", LatestTradePrice: cdcexchange.MustParseDecimal("1800")})
+
+	positions := tracker.Positions()
+	require.Len(t, positions, 2)
+
+	assert.Equal(t, "BTC_USDT", positions[0].InstrumentName)
+	assert.Equal(t, "110", positions[0].MarkPrice.String())
+	assert.Equal(t, "20", positions[0].UnrealizedPnL.String())
+
+	assert.Equal(t, "ETH_USDT", positions[1].InstrumentName)
+	assert.True(t, positions[1].Quantity.IsZero())
+	assert.Equal(t, "1800", positions[1].MarkPrice.String())
+}
+
+func TestPositionTracker_Concurrent(t *testing.T) {
+	tracker := cdcexchange.NewPositionTracker()
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+
+			_, err := tracker.ApplyTrade(positionTrade(fmt.Sprint(i), cdcexchange.OrderSideBuy, "1", "100"))
+			assert.NoError(t, err)
+			tracker.Mark("BTC_USDT", cdcexchange.MustParseDecimal("101"))
+			tracker.Positions()
+		}(i)
+	}
+	wg.Wait()
+
+	position, _ := tracker.Position("BTC_USDT")
+	assert.Equal(t, "10", position.Quantity.String())
+	assert.Equal(t, "10", position.UnrealizedPnL.String())
+}
@@ -0,0 +1,110 @@
+package cdcexchange
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/sngyai/go-cryptocom/v2/errors"
+	"github.com/sngyai/go-cryptocom/v2/internal/api"
+	"github.com/sngyai/go-cryptocom/v2/internal/auth"
+)
+
+const (
+	methodRepay = "private/margin/repay"
+)
+
+type (
+	// RepayResponse is the base response returned from the private/margin/repay API.
+	RepayResponse struct {
+		// BaseResponse is the common response fields.
+		BaseResponse
+		// Result is the response attributes of the endpoint.
+		Result RepayResult `json:"result"`
+	}
+
+	// RepayResult is the result returned from the private/margin/repay API.
+	RepayResult struct {
+		// Currency is the currency which was repaid (e.g. USDT).
+		Currency string `json:"currency"`
+		// Amount is the amount which was repaid, including interest.
+		Amount Decimal `json:"amount"`
+		// InterestRepaid is the portion of Amount used to repay accrued interest.
+		InterestRepaid Decimal `json:"interest_repaid"`
+		// TotalBorrowed is the total amount of the currency still borrowed after the repayment.
+		TotalBorrowed Decimal `json:"total_borrowed"`
+		// Raw is the raw JSON of the result, for fields not decoded by this package.
+		Raw json.RawMessage `json:"-"`
+	}
+)
+
+// Repay repays borrowed funds in the margin account.
+//
+// Accrued interest is repaid before the borrowed amount.
+//
+// currency is validated against GetLoanCurrencies before the repayment is requested,
+// so an extra (public) request is made for each call.
+//
+// Method: private/margin/repay
+func (c *Client) Repay(ctx context.Context, currency string, amount Decimal) (*RepayResult, error) {
+	if currency == "" {
+		return nil, errors.InvalidParameterError{Parameter: "currency", Reason: "cannot be empty"}
+	}
+	if amount.Sign() <= 0 {
+		return nil, errors.InvalidParameterError{Parameter: "amount", Reason: "must be greater than 0"}
+	}
+
+	if err := c.validateLoanCurrency(ctx, currency); err != nil {
+		return nil, err
+	}
+
+	var (
+		id        = c.idGenerator.Generate()
+		timestamp = c.now().UnixMilli()
+		params    = make(map[string]interface{})
+	)
+
+	creds, err := c.credentials(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	params["currency"] = currency
+	params["amount"] = amount
+
+	signature, err := c.signatureGenerator.GenerateSignature(auth.SignatureRequest{
+		APIKey:    creds.APIKey,
+		SecretKey: creds.SecretKey,
+		ID:        id,
+		Method:    methodRepay,
+		Timestamp: timestamp,
+		Params:    params,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create signature: %w", err)
+	}
+
+	body := api.Request{
+		ID:        id,
+		Method:    methodRepay,
+		Nonce:     timestamp,
+		Params:    params,
+		Signature: signature,
+		APIKey:    creds.APIKey,
+		Version:   api.V2,
+	}
+
+	var repayResponse RepayResponse
+	statusCode, err := c.requester.Post(ctx, body, methodRepay, &repayResponse)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute post request: %w", err)
+	}
+
+	if err := c.requester.CheckResponse(statusCode, api.BaseResponse(repayResponse.BaseResponse)); err != nil {
+		return nil, fmt.Errorf("error received in response: %w", err)
+	}
+
+	repayResponse.Result.Raw = repayResponse.RawResult
+
+	return &repayResponse.Result, nil
+}
@@ -0,0 +1,165 @@
+package cdcexchange_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/jonboulle/clockwork"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	cdcexchange "github.com/sngyai/go-cryptocom/v2"
+	cdcerrors "github.com/sngyai/go-cryptocom/v2/errors"
+	"github.com/sngyai/go-cryptocom/v2/internal/api"
+	"github.com/sngyai/go-cryptocom/v2/internal/auth"
+	id_mocks "github.com/sngyai/go-cryptocom/v2/internal/mocks/id"
+	signature_mocks "github.com/sngyai/go-cryptocom/v2/internal/mocks/signature"
+)
+
+func TestClient_Repay_Error(t *testing.T) {
+	const (
+		apiKey    = "some api key"
+		secretKey = "some secret key"
+	)
+
+	tests := []struct {
+		name        string
+		currency    string
+		amount      cdcexchange.Decimal
+		expectedErr error
+	}{
+		{
+			name:        "returns error when currency is empty",
+			amount:      cdcexchange.MustParseDecimal("1"),
+			expectedErr: cdcerrors.InvalidParameterError{Parameter: "currency", Reason: "cannot be empty"},
+		},
+		{
+			name:        "returns error when amount is 0",
+			currency:    "USDT",
+			expectedErr: cdcerrors.InvalidParameterError{Parameter: "amount", Reason: "must be greater than 0"},
+		},
+		{
+			name:        "returns error when currency is not a loan currency",
+			currency:    "DOGE",
+			amount:      cdcexchange.MustParseDecimal("1"),
+			expectedErr: cdcerrors.InvalidParameterError{Parameter: "currency", Reason: "is not a loan currency"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Contains(t, r.URL.Path, cdcexchange.MethodGetLoanCurrencies)
+
+				_, err := w.Write([]byte(`{"id": 1, "code": 0, "result": ["BTC", "USDT"]}`))
+				require.NoError(t, err)
+			}))
+			t.Cleanup(s.Close)
+
+			client, err := cdcexchange.New(apiKey, secretKey,
+				cdcexchange.WithHTTPClient(s.Client()),
+				cdcexchange.WithBaseURL(fmt.Sprintf("%s/", s.URL)),
+			)
+			require.NoError(t, err)
+
+			res, err := client.Repay(context.Background(), tt.currency, tt.amount)
+			require.Error(t, err)
+
+			assert.Nil(t, res)
+			assert.True(t, errors.Is(err, tt.expectedErr))
+		})
+	}
+}
+
+func TestClient_Repay_Success(t *testing.T) {
+	const (
+		apiKey    = "some api key"
+		secretKey = "some secret key"
+		id        = int64(1234)
+		signature = "some signature"
+		currency  = "USDT"
+	)
+	var (
+		amount = cdcexchange.MustParseDecimal("100.5")
+	)
+	now := time.Now()
+
+	ctrl, ctx := gomock.WithContext(context.Background(), t)
+	t.Cleanup(ctrl.Finish)
+
+	var (
+		signatureGenerator = signature_mocks.NewMockSignatureGenerator(ctrl)
+		idGenerator        = id_mocks.NewMockIDGenerator(ctrl)
+		clock              = clockwork.NewFakeClockAt(now)
+	)
+
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if strings.HasSuffix(r.URL.Path, cdcexchange.MethodGetLoanCurrencies) {
+			_, err := w.Write([]byte(`{"id": 1, "code": 0, "result": ["BTC", "USDT"]}`))
+			require.NoError(t, err)
+			return
+		}
+
+		assert.Contains(t, r.URL.Path, api.V2+cdcexchange.MethodRepay)
+		t.Cleanup(func() { require.NoError(t, r.Body.Close()) })
+
+		var body api.Request
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+
+		assert.Equal(t, currency, body.Params["currency"])
+		assert.Equal(t, amount.String(), body.Params["amount"])
+
+		_, err := w.Write([]byte(`{
+			"id": 1234,
+			"method": "private/margin/repay",
+			"code": 0,
+			"result": {
+				"currency": "USDT",
+				"amount": 100.5,
+				"interest_repaid": 0.5,
+				"total_borrowed": 100
+			}
+		}`))
+		require.NoError(t, err)
+	}))
+	t.Cleanup(s.Close)
+
+	client, err := cdcexchange.New(apiKey, secretKey,
+		cdcexchange.WithIDGenerator(idGenerator),
+		cdcexchange.WithClock(clock),
+		cdcexchange.WithHTTPClient(s.Client()),
+		cdcexchange.WithBaseURL(fmt.Sprintf("%s/", s.URL)),
+		cdcexchange.WithSignatureGenerator(signatureGenerator),
+	)
+	require.NoError(t, err)
+
+	idGenerator.EXPECT().Generate().Return(id)
+	signatureGenerator.EXPECT().GenerateSignature(auth.SignatureRequest{
+		APIKey:    apiKey,
+		SecretKey: secretKey,
+		ID:        id,
+		Method:    cdcexchange.MethodRepay,
+		Timestamp: now.UnixMilli(),
+		Params:    map[string]interface{}{"currency": currency, "amount": amount},
+	}).Return(signature, nil)
+
+	res, err := client.Repay(ctx, currency, amount)
+	require.NoError(t, err)
+
+	require.NotEmpty(t, res.Raw)
+	res.Raw = nil
+
+	assert.Equal(t, &cdcexchange.RepayResult{
+		Currency:       currency,
+		Amount:         amount,
+		InterestRepaid: cdcexchange.MustParseDecimal("0.5"),
+		TotalBorrowed:  cdcexchange.MustParseDecimal("100"),
+	}, res)
+}
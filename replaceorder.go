@@ -0,0 +1,118 @@
+package cdcexchange
+
+import (
+	"context"
+	stderrors "errors"
+	"fmt"
+
+	"github.com/sngyai/go-cryptocom/v2/errors"
+)
+
+// ReplaceOrderResult is the result of replacing an order with ReplaceOrder.
+type ReplaceOrderResult struct {
+	// Cancelled is the final state of the replaced order, including the quantity filled before it was cancelled.
+	Cancelled Order
+	// Created is the result of creating the replacement order,
+	// nil if the replaced order was filled up to the new quantity before it was cancelled.
+	Created *CreateOrderResult
+	// Quantity is the quantity of the replacement order: the new quantity less the quantity filled by the replaced order.
+	Quantity Decimal
+}
+
+// ReplaceOrder replaces the LIMIT order with orderID by an order for quantity at price, e.g. to move a resting
+// order to a new price without risking filling more than quantity in total.
+//
+// The order is cancelled and waited for until it reaches a final status (see WaitForOrderFinal, which opts are passed to).
+// Only the remainder of quantity not filled by the order (including any fill racing the cancellation) is then
+// submitted, as a new order on the same instrument and side with the same time in force and exec inst.
+// No order is submitted if the order was filled up to quantity.
+//
+// If the replacement order cannot be created, the result is returned along with the error, so that the final state
+// of the cancelled order is known.
+func (c *Client) ReplaceOrder(ctx context.Context, orderID string, price Decimal, quantity Decimal, opts ...WaitOption) (*ReplaceOrderResult, error) {
+	if orderID == "" {
+		return nil, errors.InvalidParameterError{Parameter: "orderID", Reason: "cannot be empty"}
+	}
+	if price.Sign() <= 0 {
+		return nil, errors.InvalidParameterError{Parameter: "price", Reason: "must be greater than 0"}
+	}
+	if quantity.Sign() <= 0 {
+		return nil, errors.InvalidParameterError{Parameter: "quantity", Reason: "must be greater than 0"}
+	}
+
+	detail, err := c.GetOrderDetail(ctx, orderID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get order to replace: %w", err)
+	}
+	order := detail.OrderInfo
+	if order.OrderType != OrderTypeLimit {
+		return nil, errors.InvalidParameterError{Parameter: "orderID", Reason: fmt.Sprintf("must be a LIMIT order, not %s", order.OrderType)}
+	}
+
+	if !order.Status.IsFinal() {
+		if err := c.cancelUnlessFinal(ctx, order.InstrumentName, orderID); err != nil {
+			return nil, fmt.Errorf("failed to cancel order to replace: %w", err)
+		}
+	}
+
+	final, err := c.WaitForOrderFinal(ctx, orderID, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to wait for order to replace to be cancelled: %w", err)
+	}
+
+	result := &ReplaceOrderResult{
+		Cancelled: *final,
+		Quantity:  quantity.Sub(final.CumulativeQuantity),
+	}
+	if result.Quantity.Sign() <= 0 {
+		result.Quantity = Decimal{}
+		return result, nil
+	}
+
+	created, err := c.CreateOrder(ctx, CreateOrderRequest{
+		InstrumentName: final.InstrumentName,
+		Side:           final.Side,
+		Type:           OrderTypeLimit,
+		Price:          price,
+		Quantity:       result.Quantity,
+		TimeInForce:    final.TimeInForce,
+		ExecInst:       final.ExecInst,
+	})
+	if err != nil {
+		return result, fmt.Errorf("failed to create replacement order: %w", err)
+	}
+	result.Created = created
+
+	return result, nil
+}
+
+// cancelUnlessFinal cancels the order with orderID, unless it has reached a final status (e.g. filled)
+// since it was placed, in which case it cannot be cancelled.
+func (c *Client) cancelUnlessFinal(ctx context.Context, instrumentName string, orderID string) error {
+	if err := c.CancelOrder(ctx, instrumentName, orderID); err != nil {
+		detail, detailErr := c.GetOrderDetail(ctx, orderID)
+		if detailErr != nil || !detail.OrderInfo.Status.IsFinal() {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// stopOrder cancels the order with orderID once an execution has stopped because of err, even if ctx is done,
+// and returns its state after the cancellation, so that its fills can be accounted for.
+// err is returned joined with any error cancelling the order or getting its state.
+func (c *Client) stopOrder(ctx context.Context, instrumentName string, orderID string, err error) (*Order, error) {
+	ctx = context.WithoutCancel(ctx)
+
+	if cancelErr := c.cancelUnlessFinal(ctx, instrumentName, orderID); cancelErr != nil {
+		err = stderrors.Join(err, fmt.Errorf("failed to cancel order %s: %w", orderID, cancelErr))
+	}
+
+	detail, detailErr := c.GetOrderDetail(ctx, orderID)
+	if detailErr != nil {
+		return nil, stderrors.Join(err, fmt.Errorf("failed to get order %s: %w", orderID, detailErr))
+	}
+
+	return &detail.OrderInfo, err
+}
@@ -0,0 +1,225 @@
+package cdcexchange_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	cdcexchange "github.com/sngyai/go-cryptocom/v2"
+	cdcerrors "github.com/sngyai/go-cryptocom/v2/errors"
+	"github.com/sngyai/go-cryptocom/v2/internal/api"
+)
+
+// replaceOrderServer returns a client whose get-order-detail requests are answered with the orders in order
+// (the last order is repeated), and whose cancel-order and create-order requests are answered with the given status codes,
+// recording the methods and params of the requests.
+func replaceOrderServer(t *testing.T, orders []string, cancelStatusCode int, createStatusCode int, requests *[]api.Request) *cdcexchange.Client {
+	t.Helper()
+
+	var details int
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body api.Request
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		*requests = append(*requests, body)
+
+		var (
+			statusCode = http.StatusOK
+			res        string
+		)
+		switch {
+		case strings.HasSuffix(r.URL.Path, cdcexchange.MethodGetOrderDetail):
+			details++
+			res = fmt.Sprintf(`{"id": 1, "code": 0, "result": {"trade_list": [], "order_info": %s}}`, orders[min(details, len(orders))-1])
+		case strings.HasSuffix(r.URL.Path, cdcexchange.MethodCancelOrder):
+			statusCode = cancelStatusCode
+			res = `{"id": 1, "code": 0}`
+			if statusCode != http.StatusOK {
+				res = `{"id": 1, "code": 212}`
+			}
+		case strings.HasSuffix(r.URL.Path, cdcexchange.MethodCreateOrder):
+			statusCode = createStatusCode
+			res = `{"id": 1, "code": 0, "result": {"order_id": "2"}}`
+			if statusCode != http.StatusOK {
+				res = `{"id": 1, "code": 306}`
+			}
+		default:
+			t.Errorf("unexpected request to %s", r.URL.Path)
+		}
+
+		w.WriteHeader(statusCode)
+		_, err := w.Write([]byte(res))
+		require.NoError(t, err)
+	}))
+	t.Cleanup(s.Close)
+
+	client, err := cdcexchange.New("api key", "secret key",
+		cdcexchange.WithHTTPClient(s.Client()),
+		cdcexchange.WithBaseURL(fmt.Sprintf("%s/", s.URL)),
+	)
+	require.NoError(t, err)
+
+	return client
+}
+
+func limitOrder(status cdcexchange.OrderStatus, cumulativeQuantity string) string {
+	return fmt.Sprintf(`{
+		"order_id": "1",
+		"status": %q,
+		"type": "LIMIT",
+		"side": "BUY",
+		"instrument_name": "BTC_USDT",
+		"price": "25000",
+		"quantity": "1",
+		"cumulative_quantity": %q,
+		"time_in_force": "GOOD_TILL_CANCEL",
+		"exec_inst": "POST_ONLY"
+	}`, status, cumulativeQuantity)
+}
+
+func methods(requests []api.Request) []string {
+	var methods []string
+	for _, req := range requests {
+		methods = append(methods, req.Method)
+	}
+
+	return methods
+}
+
+func TestClient_ReplaceOrder(t *testing.T) {
+	var (
+		price    = cdcexchange.MustParseDecimal("24900")
+		quantity = cdcexchange.MustParseDecimal("1")
+		wait     = cdcexchange.WithWaitBackoff(time.Millisecond, time.Millisecond)
+	)
+
+	t.Run("submits the remainder of a partially filled order", func(t *testing.T) {
+		var requests []api.Request
+		client := replaceOrderServer(t, []string{
+			limitOrder(cdcexchange.OrderStatusActive, "0.3"),
+			limitOrder(cdcexchange.OrderStatusActive, "0.4"),
+			limitOrder(cdcexchange.OrderStatusCancelled, "0.4"),
+		}, http.StatusOK, http.StatusOK, &requests)
+
+		result, err := client.ReplaceOrder(context.Background(), "1", price, quantity, wait)
+		require.NoError(t, err)
+
+		assert.Equal(t, cdcexchange.OrderStatusCancelled, result.Cancelled.Status)
+		assert.Equal(t, "0.4", result.Cancelled.CumulativeQuantity.String())
+		assert.Equal(t, "0.6", result.Quantity.String())
+		require.NotNil(t, result.Created)
+		assert.Equal(t, "2", result.Created.OrderID)
+
+		assert.Equal(t, []string{
+			cdcexchange.MethodGetOrderDetail,
+			cdcexchange.MethodCancelOrder,
+			cdcexchange.MethodGetOrderDetail,
+			cdcexchange.MethodGetOrderDetail,
+			cdcexchange.MethodCreateOrder,
+		}, methods(requests))
+		assert.Equal(t, map[string]interface{}{"instrument_name": "BTC_USDT", "order_id": "1"}, requests[1].Params)
+		assert.Equal(t, map[string]interface{}{
+			"instrument_name": "BTC_USDT",
+			"side":            "BUY",
+			"type":            "LIMIT",
+			"price":           "24900",
+			"quantity":        "0.6",
+			"time_in_force":   "GOOD_TILL_CANCEL",
+			"exec_inst":       "POST_ONLY",
+		}, requests[4].Params)
+	})
+
+	t.Run("does not submit an order when the order was filled before it could be cancelled", func(t *testing.T) {
+		var requests []api.Request
+		client := replaceOrderServer(t, []string{
+			limitOrder(cdcexchange.OrderStatusActive, "0.3"),
+			limitOrder(cdcexchange.OrderStatusFilled, "1"),
+		}, http.StatusBadRequest, http.StatusOK, &requests)
+
+		result, err := client.ReplaceOrder(context.Background(), "1", price, quantity, wait)
+		require.NoError(t, err)
+
+		assert.Equal(t, cdcexchange.OrderStatusFilled, result.Cancelled.Status)
+		assert.True(t, result.Quantity.IsZero())
+		assert.Nil(t, result.Created)
+		assert.NotContains(t, methods(requests), cdcexchange.MethodCreateOrder)
+	})
+
+	t.Run("does not cancel an order which is already final", func(t *testing.T) {
+		var requests []api.Request
+		client := replaceOrderServer(t, []string{limitOrder(cdcexchange.OrderStatusCancelled, "0")}, http.StatusOK, http.StatusOK, &requests)
+
+		result, err := client.ReplaceOrder(context.Background(), "1", price, quantity, wait)
+		require.NoError(t, err)
+
+		assert.Equal(t, "1", result.Quantity.String())
+		assert.Equal(t, []string{
+			cdcexchange.MethodGetOrderDetail,
+			cdcexchange.MethodGetOrderDetail,
+			cdcexchange.MethodCreateOrder,
+		}, methods(requests))
+	})
+}
+
+func TestClient_ReplaceOrder_Error(t *testing.T) {
+	var (
+		price    = cdcexchange.MustParseDecimal("24900")
+		quantity = cdcexchange.MustParseDecimal("1")
+		wait     = cdcexchange.WithWaitBackoff(time.Millisecond, time.Millisecond)
+	)
+
+	t.Run("returns the result when the replacement order cannot be created", func(t *testing.T) {
+		var requests []api.Request
+		client := replaceOrderServer(t, []string{
+			limitOrder(cdcexchange.OrderStatusActive, "0"),
+			limitOrder(cdcexchange.OrderStatusCancelled, "0"),
+		}, http.StatusOK, http.StatusBadRequest, &requests)
+
+		result, err := client.ReplaceOrder(context.Background(), "1", price, quantity, wait)
+		assert.True(t, errors.Is(err, cdcerrors.ErrInsufficientAvailableBalance))
+
+		require.NotNil(t, result)
+		assert.Equal(t, cdcexchange.OrderStatusCancelled, result.Cancelled.Status)
+		assert.Nil(t, result.Created)
+	})
+
+	t.Run("returns error when the order cannot be cancelled", func(t *testing.T) {
+		var requests []api.Request
+		client := replaceOrderServer(t, []string{limitOrder(cdcexchange.OrderStatusActive, "0")}, http.StatusBadRequest, http.StatusOK, &requests)
+
+		_, err := client.ReplaceOrder(context.Background(), "1", price, quantity, wait)
+		assert.True(t, errors.Is(err, cdcerrors.ErrInvalidOrderID))
+		assert.NotContains(t, methods(requests), cdcexchange.MethodCreateOrder)
+	})
+
+	t.Run("returns error when the order is not a limit order", func(t *testing.T) {
+		var requests []api.Request
+		client := replaceOrderServer(t, []string{`{"order_id": "1", "status": "ACTIVE", "type": "STOP_LOSS"}`}, http.StatusOK, http.StatusOK, &requests)
+
+		_, err := client.ReplaceOrder(context.Background(), "1", price, quantity, wait)
+		assert.Equal(t, cdcerrors.InvalidParameterError{Parameter: "orderID", Reason: "must be a LIMIT order, not STOP_LOSS"}, err)
+		assert.Equal(t, []string{cdcexchange.MethodGetOrderDetail}, methods(requests))
+	})
+
+	t.Run("returns error when params are invalid", func(t *testing.T) {
+		client, err := cdcexchange.New("api key", "secret key")
+		require.NoError(t, err)
+
+		_, err = client.ReplaceOrder(context.Background(), "", price, quantity)
+		assert.Equal(t, cdcerrors.InvalidParameterError{Parameter: "orderID", Reason: "cannot be empty"}, err)
+
+		_, err = client.ReplaceOrder(context.Background(), "1", cdcexchange.Decimal{}, quantity)
+		assert.Equal(t, cdcerrors.InvalidParameterError{Parameter: "price", Reason: "must be greater than 0"}, err)
+
+		_, err = client.ReplaceOrder(context.Background(), "1", price, quantity.Neg())
+		assert.Equal(t, cdcerrors.InvalidParameterError{Parameter: "quantity", Reason: "must be greater than 0"}, err)
+	})
+}
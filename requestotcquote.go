@@ -0,0 +1,177 @@
+package cdcexchange
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/sngyai/go-cryptocom/v2/errors"
+	"github.com/sngyai/go-cryptocom/v2/internal/api"
+	"github.com/sngyai/go-cryptocom/v2/internal/auth"
+)
+
+const (
+	methodRequestOTCQuote = "private/otc/request-quote"
+
+	OTCQuoteDirectionBuy    OTCQuoteDirection = "BUY"
+	OTCQuoteDirectionSell   OTCQuoteDirection = "SELL"
+	OTCQuoteDirectionTwoWay OTCQuoteDirection = "TWO-WAY"
+
+	OTCQuoteStatusActive   OTCQuoteStatus = "ACTIVE"
+	OTCQuoteStatusFilled   OTCQuoteStatus = "FILLED"
+	OTCQuoteStatusExpired  OTCQuoteStatus = "EXPIRED"
+	OTCQuoteStatusRejected OTCQuoteStatus = "REJECTED"
+)
+
+type (
+	// OTCQuoteDirection is the direction of an OTC quote (BUY/SELL/TWO-WAY).
+	OTCQuoteDirection string
+	// OTCQuoteStatus is the current status of an OTC quote.
+	OTCQuoteStatus string
+
+	// RequestOTCQuoteRequest is the request params sent for the private/otc/request-quote API.
+	//
+	// Exactly one of BaseCurrencySize and QuoteCurrencySize must be provided.
+	RequestOTCQuoteRequest struct {
+		// BaseCurrency is the base currency of the quote (e.g. BTC).
+		BaseCurrency string `json:"base_currency"`
+		// QuoteCurrency is the quote currency of the quote (e.g. USDT).
+		QuoteCurrency string `json:"quote_currency"`
+		// BaseCurrencySize is the quantity of base currency to trade.
+		BaseCurrencySize Decimal `json:"base_currency_size"`
+		// QuoteCurrencySize is the quantity of quote currency to trade.
+		QuoteCurrencySize Decimal `json:"quote_currency_size"`
+		// Direction represents whether the quote is to buy, sell or both.
+		Direction OTCQuoteDirection `json:"direction"`
+	}
+
+	// RequestOTCQuoteResponse is the base response returned from the private/otc/request-quote API.
+	RequestOTCQuoteResponse struct {
+		// BaseResponse is the common response fields.
+		BaseResponse
+		// Result is the response attributes of the endpoint.
+		Result OTCQuote `json:"result"`
+	}
+
+	// OTCQuote represents the details of an OTC quote.
+	OTCQuote struct {
+		// QuoteID is the unique identifier of the quote.
+		QuoteID string `json:"quote_id"`
+		// QuoteStatus is the current status of the quote.
+		QuoteStatus OTCQuoteStatus `json:"quote_status"`
+		// QuoteDirection is the direction the quote was requested for.
+		QuoteDirection OTCQuoteDirection `json:"quote_direction"`
+		// BaseCurrency is the base currency of the quote (e.g. BTC).
+		BaseCurrency string `json:"base_currency"`
+		// QuoteCurrency is the quote currency of the quote (e.g. USDT).
+		QuoteCurrency string `json:"quote_currency"`
+		// BaseCurrencySize is the requested quantity of base currency, 0 if not provided.
+		BaseCurrencySize Decimal `json:"base_currency_size"`
+		// QuoteCurrencySize is the requested quantity of quote currency, 0 if not provided.
+		QuoteCurrencySize Decimal `json:"quote_currency_size"`
+		// QuoteBuy is the price at which the user can buy, 0 for SELL quotes.
+		QuoteBuy Decimal `json:"quote_buy"`
+		// QuoteBuyQuantity is the base currency quantity of the buy side.
+		QuoteBuyQuantity Decimal `json:"quote_buy_quantity"`
+		// QuoteBuyValue is the quote currency value of the buy side.
+		QuoteBuyValue Decimal `json:"quote_buy_value"`
+		// QuoteSell is the price at which the user can sell, 0 for BUY quotes.
+		QuoteSell Decimal `json:"quote_sell"`
+		// QuoteSellQuantity is the base currency quantity of the sell side.
+		QuoteSellQuantity Decimal `json:"quote_sell_quantity"`
+		// QuoteSellValue is the quote currency value of the sell side.
+		QuoteSellValue Decimal `json:"quote_sell_value"`
+		// QuoteDuration is the number of seconds the quote is valid for.
+		QuoteDuration int `json:"quote_duration"`
+		// QuoteTime is the time the quote was created.
+		QuoteTime Timestamp `json:"quote_time"`
+		// QuoteExpiryTime is the time after which the quote can no longer be accepted.
+		QuoteExpiryTime Timestamp `json:"quote_expiry_time"`
+		// Raw is the raw JSON of the result, for fields not decoded by this package (only set when returned by RequestOTCQuote).
+		Raw json.RawMessage `json:"-"`
+	}
+)
+
+// RequestOTCQuote requests a quote for a block-size OTC trade.
+//
+// The returned quote can be accepted with AcceptOTCQuote until its QuoteExpiryTime.
+//
+// Method: private/otc/request-quote
+func (c *Client) RequestOTCQuote(ctx context.Context, req RequestOTCQuoteRequest) (*OTCQuote, error) {
+	switch {
+	case req.BaseCurrency == "":
+		return nil, errors.InvalidParameterError{Parameter: "req.BaseCurrency", Reason: "cannot be empty"}
+	case req.QuoteCurrency == "":
+		return nil, errors.InvalidParameterError{Parameter: "req.QuoteCurrency", Reason: "cannot be empty"}
+	case req.BaseCurrencySize.Sign() < 0:
+		return nil, errors.InvalidParameterError{Parameter: "req.BaseCurrencySize", Reason: "cannot be less than 0"}
+	case req.QuoteCurrencySize.Sign() < 0:
+		return nil, errors.InvalidParameterError{Parameter: "req.QuoteCurrencySize", Reason: "cannot be less than 0"}
+	case req.BaseCurrencySize.IsZero() && req.QuoteCurrencySize.IsZero():
+		return nil, errors.InvalidParameterError{Parameter: "req.BaseCurrencySize", Reason: "or req.QuoteCurrencySize must be provided"}
+	case !req.BaseCurrencySize.IsZero() && !req.QuoteCurrencySize.IsZero():
+		return nil, errors.InvalidParameterError{Parameter: "req.BaseCurrencySize", Reason: "and req.QuoteCurrencySize are mutually exclusive"}
+	}
+
+	switch req.Direction {
+	case OTCQuoteDirectionBuy, OTCQuoteDirectionSell, OTCQuoteDirectionTwoWay:
+	default:
+		return nil, errors.InvalidParameterError{Parameter: "req.Direction", Reason: "must be BUY, SELL or TWO-WAY"}
+	}
+
+	var (
+		id        = c.idGenerator.Generate()
+		timestamp = c.now().UnixMilli()
+		params    = make(map[string]interface{})
+	)
+
+	creds, err := c.credentials(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	params["base_currency"] = req.BaseCurrency
+	params["quote_currency"] = req.QuoteCurrency
+	if !req.BaseCurrencySize.IsZero() {
+		params["base_currency_size"] = req.BaseCurrencySize
+	}
+	if !req.QuoteCurrencySize.IsZero() {
+		params["quote_currency_size"] = req.QuoteCurrencySize
+	}
+	params["direction"] = req.Direction
+
+	signature, err := c.signatureGenerator.GenerateSignature(auth.SignatureRequest{
+		APIKey:    creds.APIKey,
+		SecretKey: creds.SecretKey,
+		ID:        id,
+		Method:    methodRequestOTCQuote,
+		Timestamp: timestamp,
+		Params:    params,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create signature: %w", err)
+	}
+
+	body := api.Request{
+		ID:        id,
+		Method:    methodRequestOTCQuote,
+		Nonce:     timestamp,
+		Params:    params,
+		Signature: signature,
+		APIKey:    creds.APIKey,
+	}
+
+	var requestOTCQuoteResponse RequestOTCQuoteResponse
+	statusCode, err := c.requester.Post(ctx, body, methodRequestOTCQuote, &requestOTCQuoteResponse)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute post request: %w", err)
+	}
+
+	if err := c.requester.CheckResponse(statusCode, api.BaseResponse(requestOTCQuoteResponse.BaseResponse)); err != nil {
+		return nil, fmt.Errorf("error received in response: %w", err)
+	}
+
+	requestOTCQuoteResponse.Result.Raw = requestOTCQuoteResponse.RawResult
+
+	return &requestOTCQuoteResponse.Result, nil
+}
@@ -0,0 +1,189 @@
+package cdcexchange_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/jonboulle/clockwork"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	cdcexchange "github.com/sngyai/go-cryptocom/v2"
+	cdcerrors "github.com/sngyai/go-cryptocom/v2/errors"
+	"github.com/sngyai/go-cryptocom/v2/internal/api"
+	"github.com/sngyai/go-cryptocom/v2/internal/auth"
+	id_mocks "github.com/sngyai/go-cryptocom/v2/internal/mocks/id"
+	signature_mocks "github.com/sngyai/go-cryptocom/v2/internal/mocks/signature"
+)
+
+func TestClient_RequestOTCQuote_Error(t *testing.T) {
+	const (
+		apiKey    = "some api key"
+		secretKey = "some secret key"
+	)
+
+	tests := []struct {
+		name        string
+		req         cdcexchange.RequestOTCQuoteRequest
+		expectedErr error
+	}{
+		{
+			name:        "returns error when base currency is empty",
+			req:         cdcexchange.RequestOTCQuoteRequest{QuoteCurrency: "USDT"},
+			expectedErr: cdcerrors.InvalidParameterError{Parameter: "req.BaseCurrency", Reason: "cannot be empty"},
+		},
+		{
+			name:        "returns error when quote currency is empty",
+			req:         cdcexchange.RequestOTCQuoteRequest{BaseCurrency: "BTC"},
+			expectedErr: cdcerrors.InvalidParameterError{Parameter: "req.QuoteCurrency", Reason: "cannot be empty"},
+		},
+		{
+			name:        "returns error when no size is provided",
+			req:         cdcexchange.RequestOTCQuoteRequest{BaseCurrency: "BTC", QuoteCurrency: "USDT"},
+			expectedErr: cdcerrors.InvalidParameterError{Parameter: "req.BaseCurrencySize", Reason: "or req.QuoteCurrencySize must be provided"},
+		},
+		{
+			name: "returns error when both sizes are provided",
+			req: cdcexchange.RequestOTCQuoteRequest{
+				BaseCurrency:      "BTC",
+				QuoteCurrency:     "USDT",
+				BaseCurrencySize:  cdcexchange.MustParseDecimal("1"),
+				QuoteCurrencySize: cdcexchange.MustParseDecimal("1"),
+			},
+			expectedErr: cdcerrors.InvalidParameterError{Parameter: "req.BaseCurrencySize", Reason: "and req.QuoteCurrencySize are mutually exclusive"},
+		},
+		{
+			name: "returns error when direction is invalid",
+			req: cdcexchange.RequestOTCQuoteRequest{
+				BaseCurrency:     "BTC",
+				QuoteCurrency:    "USDT",
+				BaseCurrencySize: cdcexchange.MustParseDecimal("1"),
+			},
+			expectedErr: cdcerrors.InvalidParameterError{Parameter: "req.Direction", Reason: "must be BUY, SELL or TWO-WAY"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client, err := cdcexchange.New(apiKey, secretKey)
+			require.NoError(t, err)
+
+			quote, err := client.RequestOTCQuote(context.Background(), tt.req)
+			require.Error(t, err)
+
+			assert.Nil(t, quote)
+			assert.True(t, errors.Is(err, tt.expectedErr))
+		})
+	}
+}
+
+func TestClient_RequestOTCQuote_Success(t *testing.T) {
+	const (
+		apiKey    = "some api key"
+		secretKey = "some secret key"
+		id        = int64(1234)
+		signature = "some signature"
+	)
+	now := time.Now()
+
+	ctrl, ctx := gomock.WithContext(context.Background(), t)
+	t.Cleanup(ctrl.Finish)
+
+	var (
+		signatureGenerator = signature_mocks.NewMockSignatureGenerator(ctrl)
+		idGenerator        = id_mocks.NewMockIDGenerator(ctrl)
+		clock              = clockwork.NewFakeClockAt(now)
+	)
+
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Contains(t, r.URL.Path, cdcexchange.MethodRequestOTCQuote)
+		t.Cleanup(func() { require.NoError(t, r.Body.Close()) })
+
+		var body api.Request
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+
+		assert.Equal(t, "BTC", body.Params["base_currency"])
+		assert.Equal(t, "USDT", body.Params["quote_currency"])
+		assert.Equal(t, "1.5", body.Params["base_currency_size"])
+		assert.Equal(t, "BUY", body.Params["direction"])
+		assert.NotContains(t, body.Params, "quote_currency_size")
+
+		_, err := w.Write([]byte(`{
+			"id": 1234,
+			"method": "private/otc/request-quote",
+			"code": 0,
+			"result": {
+				"quote_id": "2412323",
+				"quote_status": "ACTIVE",
+				"quote_direction": "BUY",
+				"base_currency": "BTC",
+				"quote_currency": "USDT",
+				"base_currency_size": "1.5",
+				"quote_buy": "39640.0",
+				"quote_buy_quantity": "1.5",
+				"quote_buy_value": "59460.0",
+				"quote_duration": 2,
+				"quote_time": 1635410000000,
+				"quote_expiry_time": 1635410002000
+			}
+		}`))
+		require.NoError(t, err)
+	}))
+	t.Cleanup(s.Close)
+
+	client, err := cdcexchange.New(apiKey, secretKey,
+		cdcexchange.WithIDGenerator(idGenerator),
+		cdcexchange.WithClock(clock),
+		cdcexchange.WithHTTPClient(s.Client()),
+		cdcexchange.WithBaseURL(fmt.Sprintf("%s/", s.URL)),
+		cdcexchange.WithSignatureGenerator(signatureGenerator),
+	)
+	require.NoError(t, err)
+
+	idGenerator.EXPECT().Generate().Return(id)
+	signatureGenerator.EXPECT().GenerateSignature(auth.SignatureRequest{
+		APIKey:    apiKey,
+		SecretKey: secretKey,
+		ID:        id,
+		Method:    cdcexchange.MethodRequestOTCQuote,
+		Timestamp: now.UnixMilli(),
+		Params: map[string]interface{}{
+			"base_currency":      "BTC",
+			"quote_currency":     "USDT",
+			"base_currency_size": cdcexchange.MustParseDecimal("1.5"),
+			"direction":          cdcexchange.OTCQuoteDirectionBuy,
+		},
+	}).Return(signature, nil)
+
+	quote, err := client.RequestOTCQuote(ctx, cdcexchange.RequestOTCQuoteRequest{
+		BaseCurrency:     "BTC",
+		QuoteCurrency:    "USDT",
+		BaseCurrencySize: cdcexchange.MustParseDecimal("1.5"),
+		Direction:        cdcexchange.OTCQuoteDirectionBuy,
+	})
+	require.NoError(t, err)
+
+	require.NotEmpty(t, quote.Raw)
+	quote.Raw = nil
+
+	assert.Equal(t, &cdcexchange.OTCQuote{
+		QuoteID:          "2412323",
+		QuoteStatus:      cdcexchange.OTCQuoteStatusActive,
+		QuoteDirection:   cdcexchange.OTCQuoteDirectionBuy,
+		BaseCurrency:     "BTC",
+		QuoteCurrency:    "USDT",
+		BaseCurrencySize: cdcexchange.MustParseDecimal("1.5"),
+		QuoteBuy:         cdcexchange.MustParseDecimal("39640"),
+		QuoteBuyQuantity: cdcexchange.MustParseDecimal("1.5"),
+		QuoteBuyValue:    cdcexchange.MustParseDecimal("59460"),
+		QuoteDuration:    2,
+		QuoteTime:        cdcexchange.Timestamp(time.UnixMilli(1635410000000)),
+		QuoteExpiryTime:  cdcexchange.Timestamp(time.UnixMilli(1635410002000)),
+	}, quote)
+}
@@ -0,0 +1,30 @@
+package cdcexchange
+
+import (
+	"encoding/json"
+
+	"github.com/sngyai/go-cryptocom/v2/internal/api"
+)
+
+// BaseResponse is the common fields of every response of the Exchange, embedded in every response type.
+type BaseResponse struct {
+	// ID is the id of the request the response is for.
+	ID json.Number `json:"id"`
+	// Method is the method of the request the response is for.
+	Method string `json:"method"`
+	// Code is the response code of the Exchange (0 for success), see errors.ResponseError.
+	Code json.Number `json:"code"`
+	// Message is the error message of the Exchange, if any.
+	Message string `json:"message,omitempty"`
+	// Detail is the detail of the error, if any.
+	Detail string `json:"detail,omitempty"`
+	// Raw is the raw body of the response (set by the Client, not decoded).
+	Raw json.RawMessage `json:"-"`
+	// RawResult is the raw result field of the response (set by the Client, not decoded).
+	RawResult json.RawMessage `json:"-"`
+}
+
+// SetRaw sets the raw body and result of the response, which is promoted to every response embedding BaseResponse.
+func (b *BaseResponse) SetRaw(raw []byte) {
+	(*api.BaseResponse)(b).SetRaw(raw)
+}
@@ -0,0 +1,112 @@
+package cdcexchange
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/sngyai/go-cryptocom/v2/errors"
+	"github.com/sngyai/go-cryptocom/v2/internal/api"
+	"github.com/sngyai/go-cryptocom/v2/internal/auth"
+)
+
+const (
+	methodSetSTPSettings = "private/set-stp-settings"
+
+	STPScopeMasterAccount STPScope = "M"
+	STPScopeSubAccount    STPScope = "S"
+
+	STPInstCancelMaker STPInst = "M"
+	STPInstCancelTaker STPInst = "T"
+	STPInstCancelBoth  STPInst = "B"
+)
+
+type (
+	// STPScope is the scope across which self-trade prevention is applied.
+	// M matches against orders from the master account and all of its sub-accounts,
+	// S matches against orders from the same sub-account only.
+	STPScope string
+	// STPInst is the self-trade prevention instruction, i.e. which side of a self-trade is cancelled.
+	// M cancels the maker order, T cancels the taker order and B cancels both orders.
+	STPInst string
+
+	// STPSettings is the request params sent for the private/set-stp-settings API.
+	STPSettings struct {
+		// Scope is the scope across which self-trade prevention is applied.
+		Scope STPScope `json:"stp_scope"`
+		// Inst is the instruction applied when a self-trade is detected.
+		// It can be overridden for individual orders with CreateOrderRequest.STPInst.
+		Inst STPInst `json:"stp_inst"`
+	}
+
+	// SetSTPSettingsResponse is the base response returned from the private/set-stp-settings API.
+	SetSTPSettingsResponse struct {
+		// BaseResponse is the common response fields.
+		BaseResponse
+	}
+)
+
+// SetSTPSettings configures the self-trade prevention scope and instruction for the account.
+//
+// The settings apply to all subsequent orders, unless overridden with CreateOrderRequest.STPInst.
+//
+// Method: private/set-stp-settings
+func (c *Client) SetSTPSettings(ctx context.Context, settings STPSettings) error {
+	switch settings.Scope {
+	case STPScopeMasterAccount, STPScopeSubAccount:
+	default:
+		return errors.InvalidParameterError{Parameter: "settings.Scope", Reason: "must be M or S"}
+	}
+
+	switch settings.Inst {
+	case STPInstCancelMaker, STPInstCancelTaker, STPInstCancelBoth:
+	default:
+		return errors.InvalidParameterError{Parameter: "settings.Inst", Reason: "must be M, T or B"}
+	}
+
+	var (
+		id        = c.idGenerator.Generate()
+		timestamp = c.now().UnixMilli()
+		params    = make(map[string]interface{})
+	)
+
+	creds, err := c.credentials(ctx)
+	if err != nil {
+		return err
+	}
+
+	params["stp_scope"] = settings.Scope
+	params["stp_inst"] = settings.Inst
+
+	signature, err := c.signatureGenerator.GenerateSignature(auth.SignatureRequest{
+		APIKey:    creds.APIKey,
+		SecretKey: creds.SecretKey,
+		ID:        id,
+		Method:    methodSetSTPSettings,
+		Timestamp: timestamp,
+		Params:    params,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create signature: %w", err)
+	}
+
+	body := api.Request{
+		ID:        id,
+		Method:    methodSetSTPSettings,
+		Nonce:     timestamp,
+		Params:    params,
+		Signature: signature,
+		APIKey:    creds.APIKey,
+	}
+
+	var setSTPSettingsResponse SetSTPSettingsResponse
+	statusCode, err := c.requester.Post(ctx, body, methodSetSTPSettings, &setSTPSettingsResponse)
+	if err != nil {
+		return fmt.Errorf("failed to execute post request: %w", err)
+	}
+
+	if err := c.requester.CheckResponse(statusCode, api.BaseResponse(setSTPSettingsResponse.BaseResponse)); err != nil {
+		return fmt.Errorf("error received in response: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,198 @@
+package cdcexchange_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/jonboulle/clockwork"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	cdcexchange "github.com/sngyai/go-cryptocom/v2"
+	cdcerrors "github.com/sngyai/go-cryptocom/v2/errors"
+	"github.com/sngyai/go-cryptocom/v2/internal/api"
+	"github.com/sngyai/go-cryptocom/v2/internal/auth"
+	id_mocks "github.com/sngyai/go-cryptocom/v2/internal/mocks/id"
+	signature_mocks "github.com/sngyai/go-cryptocom/v2/internal/mocks/signature"
+)
+
+func TestClient_SetSTPSettings_Error(t *testing.T) {
+	const (
+		apiKey    = "some api key"
+		secretKey = "some secret key"
+		id        = int64(1234)
+	)
+	testErr := errors.New("some error")
+
+	validSettings := cdcexchange.STPSettings{
+		Scope: cdcexchange.STPScopeMasterAccount,
+		Inst:  cdcexchange.STPInstCancelBoth,
+	}
+
+	tests := []struct {
+		name         string
+		settings     cdcexchange.STPSettings
+		client       http.Client
+		signatureErr error
+		expectedErr  error
+	}{
+		{
+			name:        "returns error when scope is invalid",
+			settings:    cdcexchange.STPSettings{Scope: "X", Inst: cdcexchange.STPInstCancelBoth},
+			expectedErr: cdcerrors.InvalidParameterError{Parameter: "settings.Scope", Reason: "must be M or S"},
+		},
+		{
+			name:        "returns error when inst is empty",
+			settings:    cdcexchange.STPSettings{Scope: cdcexchange.STPScopeSubAccount},
+			expectedErr: cdcerrors.InvalidParameterError{Parameter: "settings.Inst", Reason: "must be M, T or B"},
+		},
+		{
+			name:         "returns error given error generating signature",
+			settings:     validSettings,
+			signatureErr: testErr,
+			expectedErr:  testErr,
+		},
+		{
+			name:     "returns error given error making request",
+			settings: validSettings,
+			client: http.Client{
+				Transport: roundTripper{
+					err: testErr,
+				},
+			},
+			expectedErr: testErr,
+		},
+		{
+			name:     "returns error given error response",
+			settings: validSettings,
+			client: http.Client{
+				Transport: roundTripper{
+					statusCode: http.StatusTeapot,
+					response: api.BaseResponse{
+						Code: "10003",
+					},
+				},
+			},
+			expectedErr: cdcerrors.ResponseError{
+				Code:           10003,
+				HTTPStatusCode: http.StatusTeapot,
+				Err:            cdcerrors.ErrIllegalIP,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl, ctx := gomock.WithContext(context.Background(), t)
+			t.Cleanup(ctrl.Finish)
+
+			var (
+				idGenerator        = id_mocks.NewMockIDGenerator(ctrl)
+				signatureGenerator = signature_mocks.NewMockSignatureGenerator(ctrl)
+				now                = time.Now()
+				clock              = clockwork.NewFakeClockAt(now)
+			)
+
+			client, err := cdcexchange.New(apiKey, secretKey,
+				cdcexchange.WithIDGenerator(idGenerator),
+				cdcexchange.WithClock(clock),
+				cdcexchange.WithHTTPClient(&tt.client),
+				cdcexchange.WithSignatureGenerator(signatureGenerator),
+			)
+			require.NoError(t, err)
+
+			var invalidParameterError cdcerrors.InvalidParameterError
+			if !errors.As(tt.expectedErr, &invalidParameterError) {
+				idGenerator.EXPECT().Generate().Return(id)
+				signatureGenerator.EXPECT().GenerateSignature(auth.SignatureRequest{
+					APIKey:    apiKey,
+					SecretKey: secretKey,
+					ID:        id,
+					Method:    cdcexchange.MethodSetSTPSettings,
+					Timestamp: now.UnixMilli(),
+					Params: map[string]interface{}{
+						"stp_scope": validSettings.Scope,
+						"stp_inst":  validSettings.Inst,
+					},
+				}).Return("signature", tt.signatureErr)
+			}
+
+			err = client.SetSTPSettings(ctx, tt.settings)
+			require.Error(t, err)
+
+			assert.True(t, errors.Is(err, tt.expectedErr))
+		})
+	}
+}
+
+func TestClient_SetSTPSettings_Success(t *testing.T) {
+	const (
+		apiKey    = "some api key"
+		secretKey = "some secret key"
+		id        = int64(1234)
+		signature = "some signature"
+	)
+	now := time.Now()
+
+	ctrl, ctx := gomock.WithContext(context.Background(), t)
+	t.Cleanup(ctrl.Finish)
+
+	var (
+		signatureGenerator = signature_mocks.NewMockSignatureGenerator(ctrl)
+		idGenerator        = id_mocks.NewMockIDGenerator(ctrl)
+		clock              = clockwork.NewFakeClockAt(now)
+	)
+
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Contains(t, r.URL.Path, cdcexchange.MethodSetSTPSettings)
+		t.Cleanup(func() { require.NoError(t, r.Body.Close()) })
+
+		var body api.Request
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+
+		assert.Equal(t, cdcexchange.MethodSetSTPSettings, body.Method)
+		assert.Equal(t, id, body.ID)
+		assert.Equal(t, apiKey, body.APIKey)
+		assert.Equal(t, now.UnixMilli(), body.Nonce)
+		assert.Equal(t, signature, body.Signature)
+		assert.Equal(t, string(cdcexchange.STPScopeSubAccount), body.Params["stp_scope"])
+		assert.Equal(t, string(cdcexchange.STPInstCancelTaker), body.Params["stp_inst"])
+
+		require.NoError(t, json.NewEncoder(w).Encode(cdcexchange.SetSTPSettingsResponse{}))
+	}))
+	t.Cleanup(s.Close)
+
+	client, err := cdcexchange.New(apiKey, secretKey,
+		cdcexchange.WithIDGenerator(idGenerator),
+		cdcexchange.WithClock(clock),
+		cdcexchange.WithHTTPClient(s.Client()),
+		cdcexchange.WithBaseURL(fmt.Sprintf("%s/", s.URL)),
+		cdcexchange.WithSignatureGenerator(signatureGenerator),
+	)
+	require.NoError(t, err)
+
+	idGenerator.EXPECT().Generate().Return(id)
+	signatureGenerator.EXPECT().GenerateSignature(auth.SignatureRequest{
+		APIKey:    apiKey,
+		SecretKey: secretKey,
+		ID:        id,
+		Method:    cdcexchange.MethodSetSTPSettings,
+		Timestamp: now.UnixMilli(),
+		Params: map[string]interface{}{
+			"stp_scope": cdcexchange.STPScopeSubAccount,
+			"stp_inst":  cdcexchange.STPInstCancelTaker,
+		},
+	}).Return(signature, nil)
+
+	err = client.SetSTPSettings(ctx, cdcexchange.STPSettings{
+		Scope: cdcexchange.STPScopeSubAccount,
+		Inst:  cdcexchange.STPInstCancelTaker,
+	})
+	require.NoError(t, err)
+}
@@ -0,0 +1,121 @@
+package cdcexchange
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/sngyai/go-cryptocom/v2/errors"
+	"github.com/sngyai/go-cryptocom/v2/internal/api"
+	"github.com/sngyai/go-cryptocom/v2/internal/auth"
+)
+
+const (
+	methodStake = "private/staking/stake"
+
+	StakingStatusNew               StakingStatus = "NEW"
+	StakingStatusPending           StakingStatus = "PENDING"
+	StakingStatusStaked            StakingStatus = "STAKED"
+	StakingStatusCompleted         StakingStatus = "COMPLETED"
+	StakingStatusRejected          StakingStatus = "REJECTED"
+	StakingStatusPendingWithdrawal StakingStatus = "PENDING_WITHDRAWAL"
+	StakingStatusPendingUnstaking  StakingStatus = "PENDING_UNSTAKING"
+)
+
+type (
+	// StakingStatus is the status of a stake or unstake request.
+	StakingStatus string
+
+	// StakeResponse is the base response returned from the private/staking/stake API.
+	StakeResponse struct {
+		// BaseResponse is the common response fields.
+		BaseResponse
+		// Result is the response attributes of the endpoint.
+		Result StakeResult `json:"result"`
+	}
+
+	// StakeResult is the result returned from the private/staking/stake API.
+	StakeResult struct {
+		// StakingID is the unique identifier of the stake request.
+		StakingID string `json:"staking_id"`
+		// InstrumentName is the staking instrument name (e.g. SOL.staked).
+		InstrumentName string `json:"instrument_name"`
+		// Status is the status of the stake request.
+		Status StakingStatus `json:"status"`
+		// Quantity is the quantity requested to be staked.
+		Quantity Decimal `json:"quantity"`
+		// UnderlyingInstName is the underlying instrument name (e.g. SOL).
+		UnderlyingInstName string `json:"underlying_inst_name"`
+		// PreStakeChargeRateInBps is the pre-stake charge rate in basis points.
+		PreStakeChargeRateInBps Decimal `json:"pre_stake_charge_rate_in_bps"`
+		// PreStakeCharge is the pre-stake charge value.
+		PreStakeCharge Decimal `json:"pre_stake_charge"`
+		// Reason is the reason code, set when the request is rejected.
+		Reason string `json:"reason"`
+		// Raw is the raw JSON of the result, for fields not decoded by this package.
+		Raw json.RawMessage `json:"-"`
+	}
+)
+
+// Stake creates a request to stake a quantity of an instrument.
+//
+// The returned StakingID can be used to track the request via GetOpenStake and GetStakeHistory.
+//
+// Method: private/staking/stake
+func (c *Client) Stake(ctx context.Context, instrumentName string, quantity Decimal) (*StakeResult, error) {
+	if instrumentName == "" {
+		return nil, errors.InvalidParameterError{Parameter: "instrumentName", Reason: "cannot be empty"}
+	}
+	if err := validateStakingQuantity("quantity", quantity); err != nil {
+		return nil, err
+	}
+
+	var (
+		id        = c.idGenerator.Generate()
+		timestamp = c.now().UnixMilli()
+		params    = make(map[string]interface{})
+	)
+
+	creds, err := c.credentials(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	params["instrument_name"] = instrumentName
+	params["quantity"] = quantity.String()
+
+	signature, err := c.signatureGenerator.GenerateSignature(auth.SignatureRequest{
+		APIKey:    creds.APIKey,
+		SecretKey: creds.SecretKey,
+		ID:        id,
+		Method:    methodStake,
+		Timestamp: timestamp,
+		Params:    params,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create signature: %w", err)
+	}
+
+	body := api.Request{
+		ID:        id,
+		Method:    methodStake,
+		Nonce:     timestamp,
+		Params:    params,
+		Signature: signature,
+		APIKey:    creds.APIKey,
+	}
+
+	var stakeResponse StakeResponse
+	statusCode, err := c.requester.Post(ctx, body, methodStake, &stakeResponse)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute post request: %w", err)
+	}
+
+	if err := c.requester.CheckResponse(statusCode, api.BaseResponse(stakeResponse.BaseResponse)); err != nil {
+		return nil, fmt.Errorf("error received in response: %w", err)
+	}
+
+	stakeResponse.Result.Raw = stakeResponse.RawResult
+
+	return &stakeResponse.Result, nil
+}
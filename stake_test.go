@@ -0,0 +1,245 @@
+package cdcexchange_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/jonboulle/clockwork"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	cdcexchange "github.com/sngyai/go-cryptocom/v2"
+	cdcerrors "github.com/sngyai/go-cryptocom/v2/errors"
+	"github.com/sngyai/go-cryptocom/v2/internal/api"
+	"github.com/sngyai/go-cryptocom/v2/internal/auth"
+	id_mocks "github.com/sngyai/go-cryptocom/v2/internal/mocks/id"
+	signature_mocks "github.com/sngyai/go-cryptocom/v2/internal/mocks/signature"
+)
+
+func TestClient_Stake_Error(t *testing.T) {
+	const (
+		apiKey         = "some api key"
+		secretKey      = "some secret key"
+		id             = int64(1234)
+		instrumentName = "SOL.staked"
+	)
+	var (
+		quantity = cdcexchange.MustParseDecimal("1.5")
+		testErr  = errors.New("some error")
+	)
+
+	type args struct {
+		instrumentName string
+		quantity       cdcexchange.Decimal
+	}
+	tests := []struct {
+		name string
+		args
+		client       http.Client
+		signatureErr error
+		expectedErr  error
+	}{
+		{
+			name: "returns error when instrument name is empty",
+			args: args{
+				quantity: quantity,
+			},
+			expectedErr: cdcerrors.InvalidParameterError{Parameter: "instrumentName", Reason: "cannot be empty"},
+		},
+		{
+			name: "returns error when quantity is not positive",
+			args: args{
+				instrumentName: instrumentName,
+			},
+			expectedErr: cdcerrors.InvalidParameterError{Parameter: "quantity", Reason: "must be greater than 0"},
+		},
+		{
+			name: "returns error given error generating signature",
+			args: args{
+				instrumentName: instrumentName,
+				quantity:       quantity,
+			},
+			signatureErr: testErr,
+			expectedErr:  testErr,
+		},
+		{
+			name: "returns error given error making request",
+			args: args{
+				instrumentName: instrumentName,
+				quantity:       quantity,
+			},
+			client: http.Client{
+				Transport: roundTripper{
+					err: testErr,
+				},
+			},
+			expectedErr: testErr,
+		},
+		{
+			name: "returns error given error response",
+			args: args{
+				instrumentName: instrumentName,
+				quantity:       quantity,
+			},
+			client: http.Client{
+				Transport: roundTripper{
+					statusCode: http.StatusTeapot,
+					response: api.BaseResponse{
+						Code: "20002",
+					},
+				},
+			},
+			expectedErr: cdcerrors.ResponseError{
+				Code:           20002,
+				HTTPStatusCode: http.StatusTeapot,
+				Err:            cdcerrors.ErrNegativeBalance,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl, ctx := gomock.WithContext(context.Background(), t)
+			t.Cleanup(ctrl.Finish)
+
+			var (
+				idGenerator        = id_mocks.NewMockIDGenerator(ctrl)
+				signatureGenerator = signature_mocks.NewMockSignatureGenerator(ctrl)
+				now                = time.Now()
+				clock              = clockwork.NewFakeClockAt(now)
+			)
+
+			client, err := cdcexchange.New(apiKey, secretKey,
+				cdcexchange.WithIDGenerator(idGenerator),
+				cdcexchange.WithClock(clock),
+				cdcexchange.WithHTTPClient(&tt.client),
+				cdcexchange.WithSignatureGenerator(signatureGenerator),
+			)
+			require.NoError(t, err)
+
+			if tt.instrumentName != "" && tt.quantity.Sign() > 0 {
+				idGenerator.EXPECT().Generate().Return(id)
+				signatureGenerator.EXPECT().GenerateSignature(auth.SignatureRequest{
+					APIKey:    apiKey,
+					SecretKey: secretKey,
+					ID:        id,
+					Method:    cdcexchange.MethodStake,
+					Timestamp: now.UnixMilli(),
+					Params: map[string]interface{}{
+						"instrument_name": instrumentName,
+						"quantity":        "1.5",
+					},
+				}).Return("signature", tt.signatureErr)
+			}
+
+			res, err := client.Stake(ctx, tt.instrumentName, tt.quantity)
+			require.Error(t, err)
+
+			assert.Nil(t, res)
+			assert.True(t, errors.Is(err, tt.expectedErr))
+		})
+	}
+}
+
+func TestClient_Stake_Success(t *testing.T) {
+	const (
+		apiKey    = "some api key"
+		secretKey = "some secret key"
+		id        = int64(1234)
+		signature = "some signature"
+
+		instrumentName = "SOL.staked"
+	)
+	var (
+		quantity = cdcexchange.MustParseDecimal("1.5")
+	)
+	now := time.Now()
+
+	ctrl, ctx := gomock.WithContext(context.Background(), t)
+	t.Cleanup(ctrl.Finish)
+
+	var (
+		signatureGenerator = signature_mocks.NewMockSignatureGenerator(ctrl)
+		idGenerator        = id_mocks.NewMockIDGenerator(ctrl)
+		clock              = clockwork.NewFakeClockAt(now)
+	)
+
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Contains(t, r.URL.Path, cdcexchange.MethodStake)
+		t.Cleanup(func() { require.NoError(t, r.Body.Close()) })
+
+		var body api.Request
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+
+		assert.Equal(t, cdcexchange.MethodStake, body.Method)
+		assert.Equal(t, id, body.ID)
+		assert.Equal(t, apiKey, body.APIKey)
+		assert.Equal(t, now.UnixMilli(), body.Nonce)
+		assert.Equal(t, signature, body.Signature)
+		assert.Equal(t, instrumentName, body.Params["instrument_name"])
+		assert.Equal(t, "1.5", body.Params["quantity"])
+
+		_, err := w.Write([]byte(`{
+			"id": 1234,
+			"method": "private/staking/stake",
+			"code": 0,
+			"result": {
+				"staking_id": "1",
+				"instrument_name": "SOL.staked",
+				"status": "NEW",
+				"quantity": "1.5",
+				"underlying_inst_name": "SOL",
+				"pre_stake_charge_rate_in_bps": "50",
+				"pre_stake_charge": "0.0075",
+				"reason": "NO_ERROR"
+			}
+		}`))
+		require.NoError(t, err)
+	}))
+	t.Cleanup(s.Close)
+
+	client, err := cdcexchange.New(apiKey, secretKey,
+		cdcexchange.WithIDGenerator(idGenerator),
+		cdcexchange.WithClock(clock),
+		cdcexchange.WithHTTPClient(s.Client()),
+		cdcexchange.WithBaseURL(fmt.Sprintf("%s/", s.URL)),
+		cdcexchange.WithSignatureGenerator(signatureGenerator),
+	)
+	require.NoError(t, err)
+
+	idGenerator.EXPECT().Generate().Return(id)
+	signatureGenerator.EXPECT().GenerateSignature(auth.SignatureRequest{
+		APIKey:    apiKey,
+		SecretKey: secretKey,
+		ID:        id,
+		Method:    cdcexchange.MethodStake,
+		Timestamp: now.UnixMilli(),
+		Params: map[string]interface{}{
+			"instrument_name": instrumentName,
+			"quantity":        "1.5",
+		},
+	}).Return(signature, nil)
+
+	res, err := client.Stake(ctx, instrumentName, quantity)
+	require.NoError(t, err)
+
+	require.NotEmpty(t, res.Raw)
+	res.Raw = nil
+
+	assert.Equal(t, &cdcexchange.StakeResult{
+		StakingID:               "1",
+		InstrumentName:          instrumentName,
+		Status:                  cdcexchange.StakingStatusNew,
+		Quantity:                quantity,
+		UnderlyingInstName:      "SOL",
+		PreStakeChargeRateInBps: cdcexchange.MustParseDecimal("50"),
+		PreStakeCharge:          cdcexchange.MustParseDecimal("0.0075"),
+		Reason:                  "NO_ERROR",
+	}, res)
+}
@@ -0,0 +1,141 @@
+package cdcexchange
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/sngyai/go-cryptocom/v2/errors"
+)
+
+const (
+	InstrumentTypeSpot          InstrumentType = "CCY_PAIR"
+	InstrumentTypePerpetualSwap InstrumentType = "PERPETUAL_SWAP"
+	InstrumentTypeFuture        InstrumentType = "FUTURE"
+	InstrumentTypeIndex         InstrumentType = "INDEX"
+
+	// perpetualSuffix and indexSuffix are the suffixes of perpetual swap and index symbols (e.g. BTCUSD-PERP).
+	perpetualSuffix = "PERP"
+	indexSuffix     = "INDEX"
+	// expiryLayout is the layout of the expiry date suffix of future symbols (e.g. BTCUSD-230929).
+	expiryLayout = "060102"
+)
+
+// derivativeQuoteCurrencies are the quote currencies of derivative symbols, which have no separator between
+// the base and quote currency (e.g. BTCUSD-PERP), longest first so that USDT is not parsed as USD.
+var derivativeQuoteCurrencies = []string{"USDT", "USDC", "USD", "EUR"}
+
+type (
+	// InstrumentType is the type of an instrument (e.g. CCY_PAIR or PERPETUAL_SWAP).
+	InstrumentType string
+
+	// Symbol is an instrument symbol split into its parts.
+	//
+	// Spot symbols are formatted BASE_QUOTE (e.g. BTC_USDT), perpetual swaps BASEQUOTE-PERP (e.g. BTCUSD-PERP),
+	// futures BASEQUOTE-YYMMDD (e.g. BTCUSD-230929) and indexes BASEQUOTE-INDEX (e.g. BTCUSD-INDEX).
+	Symbol struct {
+		// Base is the base currency (e.g. BTC).
+		Base string
+		// Quote is the quote currency (e.g. USDT).
+		Quote string
+		// Type is the type of the instrument.
+		Type InstrumentType
+		// Expiry is the expiry date of a future (UTC), zero for other instrument types.
+		Expiry time.Time
+	}
+)
+
+// NormalizeSymbol returns symbol in the canonical form used by the Exchange: trimmed, upper case,
+// and with a slash between the base and quote currency replaced by an underscore (e.g. " btc/usdt" becomes BTC_USDT).
+func NormalizeSymbol(symbol string) string {
+	return strings.ReplaceAll(strings.ToUpper(strings.TrimSpace(symbol)), "/", "_")
+}
+
+// SpotSymbol returns the symbol of the spot pair of base and quote (e.g. BTC_USDT).
+func SpotSymbol(base string, quote string) string {
+	return Symbol{Base: base, Quote: quote, Type: InstrumentTypeSpot}.String()
+}
+
+// PerpetualSymbol returns the symbol of the perpetual swap of base and quote (e.g. BTCUSD-PERP).
+func PerpetualSymbol(base string, quote string) string {
+	return Symbol{Base: base, Quote: quote, Type: InstrumentTypePerpetualSwap}.String()
+}
+
+// ParseSymbol splits a (normalized) instrument symbol into its parts.
+//
+// The quote currency of derivative symbols is detected from a list of known quote currencies (USDT, USDC, USD and EUR),
+// so Instrument.BaseCurrency and Instrument.QuoteCurrency should be preferred if the instrument metadata is available.
+func ParseSymbol(symbol string) (Symbol, error) {
+	symbol = NormalizeSymbol(symbol)
+
+	if base, quote, ok := strings.Cut(symbol, "_"); ok {
+		if base == "" || quote == "" || strings.ContainsAny(quote, "_-") {
+			return Symbol{}, errors.InvalidParameterError{Parameter: "symbol", Reason: fmt.Sprintf("%q is not a valid spot symbol", symbol)}
+		}
+
+		return Symbol{Base: base, Quote: quote, Type: InstrumentTypeSpot}, nil
+	}
+
+	pair, suffix, ok := strings.Cut(symbol, "-")
+	if !ok {
+		return Symbol{}, errors.InvalidParameterError{Parameter: "symbol", Reason: fmt.Sprintf("%q is not a valid symbol", symbol)}
+	}
+
+	s := Symbol{}
+	switch suffix {
+	case perpetualSuffix:
+		s.Type = InstrumentTypePerpetualSwap
+	case indexSuffix:
+		s.Type = InstrumentTypeIndex
+	default:
+		expiry, err := time.Parse(expiryLayout, suffix)
+		if err != nil {
+			return Symbol{}, errors.InvalidParameterError{Parameter: "symbol", Reason: fmt.Sprintf("%q has an unknown suffix %s", symbol, suffix)}
+		}
+
+		s.Type = InstrumentTypeFuture
+		s.Expiry = expiry
+	}
+
+	for _, quote := range derivativeQuoteCurrencies {
+		if base, ok := strings.CutSuffix(pair, quote); ok && base != "" {
+			s.Base = base
+			s.Quote = quote
+
+			return s, nil
+		}
+	}
+
+	return Symbol{}, errors.InvalidParameterError{Parameter: "symbol", Reason: fmt.Sprintf("%q has an unknown quote currency", symbol)}
+}
+
+// String returns the symbol used by the Exchange for s (e.g. BTC_USDT or BTCUSD-PERP).
+func (s Symbol) String() string {
+	base, quote := strings.ToUpper(s.Base), strings.ToUpper(s.Quote)
+
+	switch s.Type {
+	case InstrumentTypePerpetualSwap:
+		return base + quote + "-" + perpetualSuffix
+	case InstrumentTypeIndex:
+		return base + quote + "-" + indexSuffix
+	case InstrumentTypeFuture:
+		return base + quote + "-" + s.Expiry.Format(expiryLayout)
+	default:
+		return base + "_" + quote
+	}
+}
+
+// IsSpot returns whether s is a spot pair (e.g. BTC_USDT).
+func (s Symbol) IsSpot() bool {
+	return s.Type == InstrumentTypeSpot
+}
+
+// IsPerpetual returns whether s is a perpetual swap (e.g. BTCUSD-PERP).
+func (s Symbol) IsPerpetual() bool {
+	return s.Type == InstrumentTypePerpetualSwap
+}
+
+// IsFuture returns whether s is a future with an expiry date (e.g. BTCUSD-230929).
+func (s Symbol) IsFuture() bool {
+	return s.Type == InstrumentTypeFuture
+}
@@ -0,0 +1,100 @@
+package cdcexchange_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	cdcexchange "github.com/sngyai/go-cryptocom/v2"
+)
+
+func TestNormalizeSymbol(t *testing.T) {
+	assert.Equal(t, "BTC_USDT", cdcexchange.NormalizeSymbol(" btc/usdt "))
+	assert.Equal(t, "BTC_USDT", cdcexchange.NormalizeSymbol("btc_usdt"))
+	assert.Equal(t, "BTCUSD-PERP", cdcexchange.NormalizeSymbol("btcusd-perp"))
+}
+
+func TestParseSymbol(t *testing.T) {
+	tests := []struct {
+		name     string
+		symbol   string
+		expected cdcexchange.Symbol
+		wantErr  bool
+	}{
+		{
+			name:     "spot",
+			symbol:   "BTC_USDT",
+			expected: cdcexchange.Symbol{Base: "BTC", Quote: "USDT", Type: cdcexchange.InstrumentTypeSpot},
+		},
+		{
+			name:     "spot in lower case with a slash",
+			symbol:   "cro/usd",
+			expected: cdcexchange.Symbol{Base: "CRO", Quote: "USD", Type: cdcexchange.InstrumentTypeSpot},
+		},
+		{
+			name:     "perpetual swap",
+			symbol:   "BTCUSD-PERP",
+			expected: cdcexchange.Symbol{Base: "BTC", Quote: "USD", Type: cdcexchange.InstrumentTypePerpetualSwap},
+		},
+		{
+			name:     "perpetual swap with a USDT quote",
+			symbol:   "1INCHUSDT-PERP",
+			expected: cdcexchange.Symbol{Base: "1INCH", Quote: "USDT", Type: cdcexchange.InstrumentTypePerpetualSwap},
+		},
+		{
+			name:     "index",
+			symbol:   "ETHUSD-INDEX",
+			expected: cdcexchange.Symbol{Base: "ETH", Quote: "USD", Type: cdcexchange.InstrumentTypeIndex},
+		},
+		{
+			name:   "future",
+			symbol: "BTCUSD-230929",
+			expected: cdcexchange.Symbol{
+				Base:   "BTC",
+				Quote:  "USD",
+				Type:   cdcexchange.InstrumentTypeFuture,
+				Expiry: time.Date(2023, time.September, 29, 0, 0, 0, 0, time.UTC),
+			},
+		},
+		{name: "no separator", symbol: "BTCUSDT", wantErr: true},
+		{name: "empty base", symbol: "_USDT", wantErr: true},
+		{name: "unknown suffix", symbol: "BTCUSD-SWAP", wantErr: true},
+		{name: "unknown quote currency", symbol: "BTCJPY-PERP", wantErr: true},
+		{name: "no base currency", symbol: "USD-PERP", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			symbol, err := cdcexchange.ParseSymbol(tt.symbol)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.expected, symbol)
+			assert.Equal(t, cdcexchange.NormalizeSymbol(tt.symbol), symbol.String())
+		})
+	}
+}
+
+func TestSymbol(t *testing.T) {
+	assert.Equal(t, "BTC_USDT", cdcexchange.SpotSymbol("btc", "usdt"))
+	assert.Equal(t, "BTCUSD-PERP", cdcexchange.PerpetualSymbol("BTC", "USD"))
+
+	spot, err := cdcexchange.ParseSymbol("BTC_USDT")
+	require.NoError(t, err)
+	assert.True(t, spot.IsSpot())
+	assert.False(t, spot.IsPerpetual())
+	assert.False(t, spot.IsFuture())
+
+	perpetual, err := cdcexchange.ParseSymbol("BTCUSD-PERP")
+	require.NoError(t, err)
+	assert.False(t, perpetual.IsSpot())
+	assert.True(t, perpetual.IsPerpetual())
+
+	future, err := cdcexchange.ParseSymbol("BTCUSD-230929")
+	require.NoError(t, err)
+	assert.True(t, future.IsFuture())
+}
@@ -0,0 +1,29 @@
+package cdcexchange
+
+import (
+	"time"
+
+	cdctime "github.com/sngyai/go-cryptocom/v2/internal/time"
+)
+
+// Timestamp is a timestamp returned by the Exchange (e.g. Order.CreateTime), decoded from a Unix timestamp
+// in milliseconds, microseconds or nanoseconds. Timestamp.Time returns it as a time.Time.
+type Timestamp time.Time
+
+// UnmarshalJSON decodes a Unix timestamp in milliseconds, microseconds or nanoseconds (quoted or not),
+// detecting the precision from the magnitude of the timestamp.
+func (t *Timestamp) UnmarshalJSON(data []byte) error {
+	var ts cdctime.Time
+	if err := ts.UnmarshalJSON(data); err != nil {
+		return err
+	}
+
+	*t = Timestamp(ts)
+
+	return nil
+}
+
+// Time returns t as a time.Time.
+func (t Timestamp) Time() time.Time {
+	return time.Time(t)
+}
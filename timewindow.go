@@ -0,0 +1,149 @@
+package cdcexchange
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/sngyai/go-cryptocom/v2/errors"
+)
+
+// MaxHistoryWindow is the maximum duration between the start and end timestamps of a history request.
+const MaxHistoryWindow = 24 * time.Hour
+
+type (
+	// TimeWindow is a range of time between Start and End (inclusive).
+	TimeWindow struct {
+		// Start is the start of the window.
+		Start time.Time
+		// End is the end of the window.
+		End time.Time
+	}
+
+	// TimeWindowOption represents optional configurations for ForEachTimeWindow.
+	TimeWindowOption func(*timeWindowOptions) error
+
+	timeWindowOptions struct {
+		limiter Limiter
+	}
+)
+
+// WithTimeWindowLimiter will make ForEachTimeWindow wait for limiter before each window.
+func WithTimeWindowLimiter(limiter Limiter) TimeWindowOption {
+	return func(o *timeWindowOptions) error {
+		if limiter == nil {
+			return errors.InvalidParameterError{Parameter: "limiter", Reason: "cannot be empty"}
+		}
+
+		o.limiter = limiter
+
+		return nil
+	}
+}
+
+// SplitTimeRange splits the range between start and end into consecutive windows no longer than size
+// (e.g. MaxHistoryWindow), so that a time ranged API can be called for each window.
+//
+// Windows are returned newest first, and adjacent windows do not overlap: each window ends 1ms before the start
+// of the window after it, as the Exchange treats both the start and end timestamps as inclusive.
+func SplitTimeRange(start time.Time, end time.Time, size time.Duration) ([]TimeWindow, error) {
+	if size < time.Millisecond {
+		return nil, errors.InvalidParameterError{Parameter: "size", Reason: "cannot be less than 1ms"}
+	}
+	if end.Before(start) {
+		return nil, errors.DateRangeError{Start: start, End: end, MaxRange: size}
+	}
+
+	return splitTimeRange(start, end, size), nil
+}
+
+// ForEachTimeWindow calls fn for each window of the range between start and end split by SplitTimeRange, newest first,
+// stopping at the first error returned by fn (or ctx being done).
+//
+// Each request made by fn is paced by the rate limiter of the Client it is made with, while WithTimeWindowLimiter
+// can be used to pace the windows themselves.
+func ForEachTimeWindow(ctx context.Context, start time.Time, end time.Time, size time.Duration, fn func(ctx context.Context, window TimeWindow) error, opts ...TimeWindowOption) error {
+	if fn == nil {
+		return errors.InvalidParameterError{Parameter: "fn", Reason: "cannot be empty"}
+	}
+
+	var o timeWindowOptions
+	for _, opt := range opts {
+		if err := opt(&o); err != nil {
+			return err
+		}
+	}
+
+	windows, err := SplitTimeRange(start, end, size)
+	if err != nil {
+		return err
+	}
+
+	for _, window := range windows {
+		if o.limiter != nil {
+			if err := o.limiter.Wait(ctx); err != nil {
+				return err
+			}
+		}
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		if err := fn(ctx, window); err != nil {
+			return fmt.Errorf("window %s to %s: %w", window.Start.Format(time.RFC3339Nano), window.End.Format(time.RFC3339Nano), err)
+		}
+	}
+
+	return nil
+}
+
+// splitTimeRange splits the range between start and end into consecutive windows no longer than size,
+// which must be at least 1ms.
+//
+// Windows are returned newest first, so that results merged in order keep the newest first ordering of the API.
+// Adjacent windows do not overlap, as each window ends 1ms before the start of the window after it.
+func splitTimeRange(start time.Time, end time.Time, size time.Duration) []TimeWindow {
+	if !end.After(start) || end.Sub(start) <= size {
+		return []TimeWindow{{Start: start, End: end}}
+	}
+
+	var windows []TimeWindow
+	for windowEnd := end; !windowEnd.Before(start); {
+		windowStart := windowEnd.Add(-size)
+		if windowStart.Before(start) {
+			windowStart = start
+		}
+
+		windows = append(windows, TimeWindow{Start: windowStart, End: windowEnd})
+		windowEnd = windowStart.Add(-time.Millisecond)
+	}
+
+	return windows
+}
+
+// historyWindows returns the windows a history request between start and end is split into: the range itself if
+// either is zero (as the Exchange defaults them), otherwise windows no longer than MaxHistoryWindow, newest first.
+func historyWindows(start time.Time, end time.Time) []TimeWindow {
+	if start.IsZero() || end.IsZero() {
+		return []TimeWindow{{Start: start, End: end}}
+	}
+
+	return splitTimeRange(start, end, MaxHistoryWindow)
+}
+
+// validateTimeRange returns an errors.DateRangeError if end is before start, or the range between them
+// is longer than max. A zero end defaults to now, as it does on the Exchange, and a zero start is not validated.
+func validateTimeRange(start time.Time, end time.Time, now time.Time, max time.Duration) error {
+	if start.IsZero() {
+		return nil
+	}
+	if end.IsZero() {
+		end = now
+	}
+
+	if end.Before(start) || end.Sub(start) > max {
+		return errors.DateRangeError{Start: start, End: end, MaxRange: max}
+	}
+
+	return nil
+}
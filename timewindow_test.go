@@ -0,0 +1,156 @@
+package cdcexchange_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	cdcexchange "github.com/sngyai/go-cryptocom/v2"
+	cdcerrors "github.com/sngyai/go-cryptocom/v2/errors"
+)
+
+type countingLimiter struct {
+	waits int
+	err   error
+}
+
+func (l *countingLimiter) Wait(ctx context.Context) error {
+	l.waits++
+	return l.err
+}
+
+func TestSplitTimeRange(t *testing.T) {
+	start := time.UnixMilli(1686000000000)
+
+	tests := []struct {
+		name     string
+		start    time.Time
+		end      time.Time
+		size     time.Duration
+		expected []cdcexchange.TimeWindow
+	}{
+		{
+			name:     "returns the range when it is no longer than size",
+			start:    start,
+			end:      start.Add(24 * time.Hour),
+			size:     cdcexchange.MaxHistoryWindow,
+			expected: []cdcexchange.TimeWindow{{Start: start, End: start.Add(24 * time.Hour)}},
+		},
+		{
+			name:  "returns windows newest first without overlapping",
+			start: start,
+			end:   start.Add(50 * time.Hour),
+			size:  cdcexchange.MaxHistoryWindow,
+			expected: []cdcexchange.TimeWindow{
+				{Start: start.Add(26 * time.Hour), End: start.Add(50 * time.Hour)},
+				{Start: start.Add(2*time.Hour - time.Millisecond), End: start.Add(26*time.Hour - time.Millisecond)},
+				{Start: start, End: start.Add(2*time.Hour - 2*time.Millisecond)},
+			},
+		},
+		{
+			name:     "returns a single window when start and end are equal",
+			start:    start,
+			end:      start,
+			size:     time.Hour,
+			expected: []cdcexchange.TimeWindow{{Start: start, End: start}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			windows, err := cdcexchange.SplitTimeRange(tt.start, tt.end, tt.size)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, windows)
+		})
+	}
+}
+
+func TestSplitTimeRange_Error(t *testing.T) {
+	start := time.UnixMilli(1686000000000)
+
+	_, err := cdcexchange.SplitTimeRange(start, start.Add(time.Hour), 0)
+	assert.Equal(t, cdcerrors.InvalidParameterError{Parameter: "size", Reason: "cannot be less than 1ms"}, err)
+
+	_, err = cdcexchange.SplitTimeRange(start, start.Add(-time.Hour), time.Hour)
+	assert.ErrorIs(t, err, cdcerrors.ErrInvalidDateRange)
+}
+
+func TestForEachTimeWindow(t *testing.T) {
+	start := time.UnixMilli(1686000000000)
+	end := start.Add(30 * time.Hour)
+	limiter := &countingLimiter{}
+
+	var windows []cdcexchange.TimeWindow
+	err := cdcexchange.ForEachTimeWindow(context.Background(), start, end, cdcexchange.MaxHistoryWindow,
+		func(ctx context.Context, window cdcexchange.TimeWindow) error {
+			windows = append(windows, window)
+			return nil
+		},
+		cdcexchange.WithTimeWindowLimiter(limiter),
+	)
+	require.NoError(t, err)
+
+	assert.Equal(t, []cdcexchange.TimeWindow{
+		{Start: start.Add(6 * time.Hour), End: end},
+		{Start: start, End: start.Add(6*time.Hour - time.Millisecond)},
+	}, windows)
+	assert.Equal(t, 2, limiter.waits)
+}
+
+func TestForEachTimeWindow_Error(t *testing.T) {
+	start := time.UnixMilli(1686000000000)
+	end := start.Add(30 * time.Hour)
+	someErr := errors.New("some error")
+
+	t.Run("stops at the first error returned by fn", func(t *testing.T) {
+		var calls int
+		err := cdcexchange.ForEachTimeWindow(context.Background(), start, end, cdcexchange.MaxHistoryWindow,
+			func(ctx context.Context, window cdcexchange.TimeWindow) error {
+				calls++
+				return someErr
+			},
+		)
+		assert.ErrorIs(t, err, someErr)
+		assert.Equal(t, 1, calls)
+	})
+
+	t.Run("returns the error of the limiter", func(t *testing.T) {
+		err := cdcexchange.ForEachTimeWindow(context.Background(), start, end, cdcexchange.MaxHistoryWindow,
+			func(ctx context.Context, window cdcexchange.TimeWindow) error {
+				t.Fatal("fn should not be called")
+				return nil
+			},
+			cdcexchange.WithTimeWindowLimiter(&countingLimiter{err: someErr}),
+		)
+		assert.ErrorIs(t, err, someErr)
+	})
+
+	t.Run("returns error when ctx is done", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		err := cdcexchange.ForEachTimeWindow(ctx, start, end, cdcexchange.MaxHistoryWindow,
+			func(ctx context.Context, window cdcexchange.TimeWindow) error {
+				t.Fatal("fn should not be called")
+				return nil
+			},
+		)
+		assert.ErrorIs(t, err, context.Canceled)
+	})
+
+	t.Run("returns error when fn is nil", func(t *testing.T) {
+		err := cdcexchange.ForEachTimeWindow(context.Background(), start, end, cdcexchange.MaxHistoryWindow, nil)
+		assert.Equal(t, cdcerrors.InvalidParameterError{Parameter: "fn", Reason: "cannot be empty"}, err)
+	})
+
+	t.Run("returns error when limiter is nil", func(t *testing.T) {
+		err := cdcexchange.ForEachTimeWindow(context.Background(), start, end, cdcexchange.MaxHistoryWindow,
+			func(ctx context.Context, window cdcexchange.TimeWindow) error { return nil },
+			cdcexchange.WithTimeWindowLimiter(nil),
+		)
+		assert.Equal(t, cdcerrors.InvalidParameterError{Parameter: "limiter", Reason: "cannot be empty"}, err)
+	})
+}
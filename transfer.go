@@ -0,0 +1,103 @@
+package cdcexchange
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/sngyai/go-cryptocom/v2/errors"
+	"github.com/sngyai/go-cryptocom/v2/internal/api"
+	"github.com/sngyai/go-cryptocom/v2/internal/auth"
+)
+
+const (
+	methodTransfer = "private/deriv/transfer"
+
+	WalletSpot        Wallet = "SPOT"
+	WalletDerivatives Wallet = "DERIVATIVES"
+)
+
+type (
+	// Wallet is the wallet funds can be transferred between (SPOT/DERIVATIVES).
+	Wallet string
+
+	// TransferResponse is the base response returned from the private/deriv/transfer API.
+	TransferResponse struct {
+		// BaseResponse is the common response fields.
+		BaseResponse
+	}
+)
+
+// Transfer transfers funds between the SPOT and DERIVATIVES wallets.
+//
+// Method: private/deriv/transfer
+func (c *Client) Transfer(ctx context.Context, currency string, amount Decimal, from Wallet, to Wallet) error {
+	switch {
+	case currency == "":
+		return errors.InvalidParameterError{Parameter: "currency", Reason: "cannot be empty"}
+	case amount.Sign() <= 0:
+		return errors.InvalidParameterError{Parameter: "amount", Reason: "must be greater than 0"}
+	case from == to:
+		return errors.InvalidParameterError{Parameter: "to", Reason: "cannot be the same as from"}
+	}
+
+	switch from {
+	case WalletSpot, WalletDerivatives:
+	default:
+		return errors.InvalidParameterError{Parameter: "from", Reason: "must be SPOT or DERIVATIVES"}
+	}
+	switch to {
+	case WalletSpot, WalletDerivatives:
+	default:
+		return errors.InvalidParameterError{Parameter: "to", Reason: "must be SPOT or DERIVATIVES"}
+	}
+
+	var (
+		id        = c.idGenerator.Generate()
+		timestamp = c.now().UnixMilli()
+		params    = make(map[string]interface{})
+	)
+
+	creds, err := c.credentials(ctx)
+	if err != nil {
+		return err
+	}
+
+	params["currency"] = currency
+	params["amount"] = amount
+	params["from"] = from
+	params["to"] = to
+
+	signature, err := c.signatureGenerator.GenerateSignature(auth.SignatureRequest{
+		APIKey:    creds.APIKey,
+		SecretKey: creds.SecretKey,
+		ID:        id,
+		Method:    methodTransfer,
+		Timestamp: timestamp,
+		Params:    params,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create signature: %w", err)
+	}
+
+	body := api.Request{
+		ID:        id,
+		Method:    methodTransfer,
+		Nonce:     timestamp,
+		Params:    params,
+		Signature: signature,
+		APIKey:    creds.APIKey,
+		Version:   api.V2,
+	}
+
+	var transferResponse TransferResponse
+	statusCode, err := c.requester.Post(ctx, body, methodTransfer, &transferResponse)
+	if err != nil {
+		return fmt.Errorf("failed to execute post request: %w", err)
+	}
+
+	if err := c.requester.CheckResponse(statusCode, api.BaseResponse(transferResponse.BaseResponse)); err != nil {
+		return fmt.Errorf("error received in response: %w", err)
+	}
+
+	return nil
+}
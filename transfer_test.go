@@ -0,0 +1,159 @@
+package cdcexchange_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/jonboulle/clockwork"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	cdcexchange "github.com/sngyai/go-cryptocom/v2"
+	cdcerrors "github.com/sngyai/go-cryptocom/v2/errors"
+	"github.com/sngyai/go-cryptocom/v2/internal/api"
+	"github.com/sngyai/go-cryptocom/v2/internal/auth"
+	id_mocks "github.com/sngyai/go-cryptocom/v2/internal/mocks/id"
+	signature_mocks "github.com/sngyai/go-cryptocom/v2/internal/mocks/signature"
+)
+
+func TestClient_Transfer_Error(t *testing.T) {
+	const (
+		apiKey    = "some api key"
+		secretKey = "some secret key"
+		currency  = "USDT"
+	)
+	amount := cdcexchange.MustParseDecimal("1.5")
+
+	tests := []struct {
+		name        string
+		currency    string
+		amount      cdcexchange.Decimal
+		from        cdcexchange.Wallet
+		to          cdcexchange.Wallet
+		expectedErr error
+	}{
+		{
+			name:        "returns error when currency is empty",
+			amount:      amount,
+			from:        cdcexchange.WalletSpot,
+			to:          cdcexchange.WalletDerivatives,
+			expectedErr: cdcerrors.InvalidParameterError{Parameter: "currency", Reason: "cannot be empty"},
+		},
+		{
+			name:        "returns error when amount is not positive",
+			currency:    currency,
+			from:        cdcexchange.WalletSpot,
+			to:          cdcexchange.WalletDerivatives,
+			expectedErr: cdcerrors.InvalidParameterError{Parameter: "amount", Reason: "must be greater than 0"},
+		},
+		{
+			name:        "returns error when from and to are the same",
+			currency:    currency,
+			amount:      amount,
+			from:        cdcexchange.WalletSpot,
+			to:          cdcexchange.WalletSpot,
+			expectedErr: cdcerrors.InvalidParameterError{Parameter: "to", Reason: "cannot be the same as from"},
+		},
+		{
+			name:        "returns error when from is invalid",
+			currency:    currency,
+			amount:      amount,
+			from:        "MARGIN",
+			to:          cdcexchange.WalletSpot,
+			expectedErr: cdcerrors.InvalidParameterError{Parameter: "from", Reason: "must be SPOT or DERIVATIVES"},
+		},
+		{
+			name:        "returns error when to is invalid",
+			currency:    currency,
+			amount:      amount,
+			from:        cdcexchange.WalletSpot,
+			to:          "MARGIN",
+			expectedErr: cdcerrors.InvalidParameterError{Parameter: "to", Reason: "must be SPOT or DERIVATIVES"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client, err := cdcexchange.New(apiKey, secretKey)
+			require.NoError(t, err)
+
+			err = client.Transfer(context.Background(), tt.currency, tt.amount, tt.from, tt.to)
+			require.Error(t, err)
+
+			assert.True(t, errors.Is(err, tt.expectedErr))
+		})
+	}
+}
+
+func TestClient_Transfer_Success(t *testing.T) {
+	const (
+		apiKey    = "some api key"
+		secretKey = "some secret key"
+		id        = int64(1234)
+		signature = "some signature"
+		currency  = "USDT"
+	)
+	var (
+		amount = cdcexchange.MustParseDecimal("1.5")
+	)
+	now := time.Now()
+
+	ctrl, ctx := gomock.WithContext(context.Background(), t)
+	t.Cleanup(ctrl.Finish)
+
+	var (
+		signatureGenerator = signature_mocks.NewMockSignatureGenerator(ctrl)
+		idGenerator        = id_mocks.NewMockIDGenerator(ctrl)
+		clock              = clockwork.NewFakeClockAt(now)
+	)
+
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Contains(t, r.URL.Path, api.V2+cdcexchange.MethodTransfer)
+		t.Cleanup(func() { require.NoError(t, r.Body.Close()) })
+
+		var body api.Request
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+
+		assert.Equal(t, currency, body.Params["currency"])
+		assert.Equal(t, amount.String(), body.Params["amount"])
+		assert.Equal(t, "SPOT", body.Params["from"])
+		assert.Equal(t, "DERIVATIVES", body.Params["to"])
+
+		_, err := w.Write([]byte(`{"id": 1234, "method": "private/deriv/transfer", "code": 0}`))
+		require.NoError(t, err)
+	}))
+	t.Cleanup(s.Close)
+
+	client, err := cdcexchange.New(apiKey, secretKey,
+		cdcexchange.WithIDGenerator(idGenerator),
+		cdcexchange.WithClock(clock),
+		cdcexchange.WithHTTPClient(s.Client()),
+		cdcexchange.WithBaseURL(fmt.Sprintf("%s/", s.URL)),
+		cdcexchange.WithSignatureGenerator(signatureGenerator),
+	)
+	require.NoError(t, err)
+
+	idGenerator.EXPECT().Generate().Return(id)
+	signatureGenerator.EXPECT().GenerateSignature(auth.SignatureRequest{
+		APIKey:    apiKey,
+		SecretKey: secretKey,
+		ID:        id,
+		Method:    cdcexchange.MethodTransfer,
+		Timestamp: now.UnixMilli(),
+		Params: map[string]interface{}{
+			"currency": currency,
+			"amount":   amount,
+			"from":     cdcexchange.WalletSpot,
+			"to":       cdcexchange.WalletDerivatives,
+		},
+	}).Return(signature, nil)
+
+	err = client.Transfer(ctx, currency, amount, cdcexchange.WalletSpot, cdcexchange.WalletDerivatives)
+	require.NoError(t, err)
+}
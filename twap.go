@@ -0,0 +1,166 @@
+package cdcexchange
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/sngyai/go-cryptocom/v2/errors"
+)
+
+type (
+	// TWAPRequest is a parent order executed by ExecuteTWAP.
+	TWAPRequest struct {
+		// InstrumentName represents the currency pair to trade (e.g. ETH_CRO or BTC_USDT).
+		InstrumentName string
+		// Side represents whether the orders are buy or sell orders.
+		Side OrderSide
+		// Type is the type of the child orders, LIMIT or MARKET.
+		Type OrderType
+		// Price is the price of LIMIT child orders (must be empty for MARKET child orders).
+		Price Decimal
+		// Quantity is the total quantity to execute.
+		Quantity Decimal
+		// Duration is the time over which the child orders are placed.
+		Duration time.Duration
+		// Slices is the number of child orders, placed at equal intervals over Duration.
+		Slices int
+	}
+)
+
+// ExecuteTWAP executes req as a time-weighted average price (TWAP) algorithm: the quantity of the parent order is
+// sliced into req.Slices child orders, placed at equal intervals over req.Duration.
+//
+// Each child order is for the remaining quantity divided by the number of remaining slices, rounded towards zero to
+// the quantity tick size of the instrument, so that the quantity not filled by a child order is carried over to the
+// next slices. LIMIT child orders rest until the next slice is due, then are cancelled. Each child order is waited
+// for until it reaches a final status (see WaitForOrderFinal, which opts are passed to), and its fills are added to
+// the result. No more child orders are placed once the parent order is filled.
+//
+// All requests are made through the Client, so they are paced by its rate limiters.
+//
+// If ctx is done or a request fails, the open child order is cancelled and the result so far, including the fills of
+// that child order before it was cancelled, is returned along with the error (and any error cancelling it).
+func (c *Client) ExecuteTWAP(ctx context.Context, req TWAPRequest, opts ...WaitOption) (*ExecutionResult, error) {
+	if err := req.validate(); err != nil {
+		return nil, err
+	}
+
+	instrument, err := c.knownInstrument(ctx, req.InstrumentName)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		result   = &ExecutionResult{RemainingQuantity: req.Quantity}
+		start    = c.clock.Now()
+		interval = req.Duration / time.Duration(req.Slices)
+	)
+	for i := 0; i < req.Slices && result.RemainingQuantity.Sign() > 0; i++ {
+		end := start.Add(interval * time.Duration(i+1))
+
+		quantity := quoToStep(result.RemainingQuantity, int64(req.Slices-i), instrument.QtyTickSize)
+		if quantity.Sign() > 0 {
+			order, err := c.executeTWAPSlice(ctx, req, quantity, end, opts)
+			if order != nil {
+				result.add(*order)
+			}
+			if err != nil {
+				return result, fmt.Errorf("slice %d of %d: %w", i+1, req.Slices, err)
+			}
+		}
+
+		if i < req.Slices-1 {
+			if err := c.sleepUntil(ctx, end); err != nil {
+				return result, err
+			}
+		}
+	}
+
+	return result, nil
+}
+
+// executeTWAPSlice places a child order of req for quantity, and returns it once it reaches a final status.
+// LIMIT child orders are cancelled at end.
+//
+// If ctx is done or a request fails once the child order has been placed, it is cancelled and its state after the
+// cancellation is returned along with the error.
+func (c *Client) executeTWAPSlice(ctx context.Context, req TWAPRequest, quantity Decimal, end time.Time, opts []WaitOption) (*Order, error) {
+	created, err := c.CreateOrder(ctx, CreateOrderRequest{
+		InstrumentName: req.InstrumentName,
+		Side:           req.Side,
+		Type:           req.Type,
+		Price:          req.Price,
+		Quantity:       quantity,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create child order: %w", err)
+	}
+
+	if req.Type == OrderTypeLimit {
+		if err := c.sleepUntil(ctx, end); err != nil {
+			// the child order must not be left resting once the execution has stopped.
+			return c.stopOrder(ctx, req.InstrumentName, created.OrderID, err)
+		}
+
+		if err := c.cancelUnlessFinal(ctx, req.InstrumentName, created.OrderID); err != nil {
+			return c.stopOrder(ctx, req.InstrumentName, created.OrderID, fmt.Errorf("failed to cancel child order: %w", err))
+		}
+	}
+
+	final, err := c.WaitForOrderFinal(ctx, created.OrderID, opts...)
+	if err != nil {
+		return c.stopOrder(ctx, req.InstrumentName, created.OrderID, fmt.Errorf("failed to wait for child order: %w", err))
+	}
+
+	return final, nil
+}
+
+// sleepUntil waits until t, or ctx is done.
+func (c *Client) sleepUntil(ctx context.Context, t time.Time) error {
+	d := t.Sub(c.clock.Now())
+	if d <= 0 {
+		return nil
+	}
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-c.clock.After(d):
+		return nil
+	}
+}
+
+func (req TWAPRequest) validate() error {
+	if req.InstrumentName == "" {
+		return errors.InvalidParameterError{Parameter: "req.InstrumentName", Reason: "cannot be empty"}
+	}
+	if !req.Side.IsValid() {
+		return errors.InvalidParameterError{Parameter: "req.Side", Reason: "must be BUY or SELL"}
+	}
+
+	switch req.Type {
+	case OrderTypeLimit:
+		if req.Price.Sign() <= 0 {
+			return errors.InvalidParameterError{Parameter: "req.Price", Reason: "must be greater than 0 for LIMIT orders"}
+		}
+	case OrderTypeMarket:
+		if !req.Price.IsZero() {
+			return errors.InvalidParameterError{Parameter: "req.Price", Reason: "can only be provided for LIMIT orders"}
+		}
+	default:
+		return errors.InvalidParameterError{Parameter: "req.Type", Reason: "must be LIMIT or MARKET"}
+	}
+
+	if req.Quantity.Sign() <= 0 {
+		return errors.InvalidParameterError{Parameter: "req.Quantity", Reason: "must be greater than 0"}
+	}
+	if req.Duration < 0 {
+		return errors.InvalidParameterError{Parameter: "req.Duration", Reason: "cannot be less than 0"}
+	}
+	if req.Slices < 1 {
+		return errors.InvalidParameterError{Parameter: "req.Slices", Reason: "must be at least 1"}
+	}
+
+	return nil
+}
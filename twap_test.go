@@ -0,0 +1,305 @@
+package cdcexchange_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	cdcexchange "github.com/sngyai/go-cryptocom/v2"
+	cdcerrors "github.com/sngyai/go-cryptocom/v2/errors"
+	"github.com/sngyai/go-cryptocom/v2/internal/api"
+)
+
+// twapServer returns a client for an exchange listing BTC_USDT with a quantity tick size of 0.0001,
+// on which the child orders reach status, filling their quantity multiplied by fillRatio at a price of 25000.
+// The requests are recorded, onCreate is called for each create-order request,
+// and cancel-order requests are answered with cancelStatusCode.
+func twapServer(t *testing.T, status cdcexchange.OrderStatus, fillRatio string, onCreate func(), cancelStatusCode int, requests *[]api.Request) *cdcexchange.Client {
+	t.Helper()
+
+	var (
+		mu         sync.Mutex
+		quantities []cdcexchange.Decimal
+	)
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body api.Request
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+
+		mu.Lock()
+		defer mu.Unlock()
+		*requests = append(*requests, body)
+
+		var (
+			statusCode = http.StatusOK
+			res        string
+		)
+		switch {
+		case strings.HasSuffix(r.URL.Path, cdcexchange.MethodGetInstruments):
+			res = `{"id": 1, "code": 0, "result": {"data": [{"symbol": "BTC_USDT", "price_tick_size": "0.01", "qty_tick_size": "0.0001", "tradable": true}]}}`
+		case strings.HasSuffix(r.URL.Path, cdcexchange.MethodCreateOrder):
+			quantities = append(quantities, cdcexchange.MustParseDecimal(body.Params["quantity"].(string)))
+			res = fmt.Sprintf(`{"id": 1, "code": 0, "result": {"order_id": "%d"}}`, len(quantities))
+			if onCreate != nil {
+				onCreate()
+			}
+		case strings.HasSuffix(r.URL.Path, cdcexchange.MethodCancelOrder):
+			statusCode = cancelStatusCode
+			res = `{"id": 1, "code": 0}`
+			if statusCode != http.StatusOK {
+				res = `{"id": 1, "code": 212}`
+			}
+		case strings.HasSuffix(r.URL.Path, cdcexchange.MethodGetOrderDetail):
+			i, err := strconv.Atoi(body.Params["order_id"].(string))
+			require.NoError(t, err)
+
+			filled := quantities[i-1].Mul(cdcexchange.MustParseDecimal(fillRatio))
+			res = fmt.Sprintf(`{"id": 1, "code": 0, "result": {"trade_list": [], "order_info": {
+				"order_id": "%d",
+				"status": %q,
+				"instrument_name": "BTC_USDT",
+				"quantity": %q,
+				"cumulative_quantity": %q,
+				"cumulative_value": %q,
+				"cumulative_fee": "0.1"
+			}}}`, i, status, quantities[i-1], filled, filled.Mul(cdcexchange.MustParseDecimal("25000")))
+		default:
+			t.Errorf("unexpected request to %s", r.URL.Path)
+		}
+
+		w.WriteHeader(statusCode)
+		_, err := w.Write([]byte(res))
+		require.NoError(t, err)
+	}))
+	t.Cleanup(s.Close)
+
+	client, err := cdcexchange.New("api key", "secret key",
+		cdcexchange.WithHTTPClient(s.Client()),
+		cdcexchange.WithBaseURL(fmt.Sprintf("%s/", s.URL)),
+	)
+	require.NoError(t, err)
+
+	return client
+}
+
+func createOrderParams(requests []api.Request) []map[string]interface{} {
+	var params []map[string]interface{}
+	for _, req := range requests {
+		if req.Method == cdcexchange.MethodCreateOrder {
+			params = append(params, req.Params)
+		}
+	}
+
+	return params
+}
+
+func TestClient_ExecuteTWAP(t *testing.T) {
+	wait := cdcexchange.WithWaitBackoff(time.Millisecond, time.Millisecond)
+
+	t.Run("slices the quantity into market orders", func(t *testing.T) {
+		var requests []api.Request
+		client := twapServer(t, cdcexchange.OrderStatusFilled, "1", nil, http.StatusOK, &requests)
+
+		result, err := client.ExecuteTWAP(context.Background(), cdcexchange.TWAPRequest{
+			InstrumentName: "BTC_USDT",
+			Side:           cdcexchange.OrderSideBuy,
+			Type:           cdcexchange.OrderTypeMarket,
+			Quantity:       cdcexchange.MustParseDecimal("1"),
+			Duration:       3 * time.Millisecond,
+			Slices:         3,
+		}, wait)
+		require.NoError(t, err)
+
+		params := createOrderParams(requests)
+		require.Len(t, params, 3)
+		assert.Equal(t, map[string]interface{}{
+			"instrument_name": "BTC_USDT",
+			"side":            "BUY",
+			"type":            "MARKET",
+			"quantity":        "0.3333",
+		}, params[0])
+		assert.Equal(t, "0.3333", params[1]["quantity"])
+		assert.Equal(t, "0.3334", params[2]["quantity"])
+
+		assert.Len(t, result.Orders, 3)
+		assert.Equal(t, "1", result.FilledQuantity.String())
+		assert.Equal(t, "25000", result.FilledValue.String())
+		assert.Equal(t, "0.3", result.FilledFee.String())
+		assert.True(t, result.RemainingQuantity.IsZero())
+		assert.NotContains(t, methods(requests), cdcexchange.MethodCancelOrder)
+	})
+
+	t.Run("carries the quantity not filled by limit orders over to the next slices", func(t *testing.T) {
+		var requests []api.Request
+		client := twapServer(t, cdcexchange.OrderStatusCancelled, "0.5", nil, http.StatusOK, &requests)
+
+		result, err := client.ExecuteTWAP(context.Background(), cdcexchange.TWAPRequest{
+			InstrumentName: "BTC_USDT",
+			Side:           cdcexchange.OrderSideSell,
+			Type:           cdcexchange.OrderTypeLimit,
+			Price:          cdcexchange.MustParseDecimal("25000"),
+			Quantity:       cdcexchange.MustParseDecimal("1"),
+			Duration:       2 * time.Millisecond,
+			Slices:         2,
+		}, wait)
+		require.NoError(t, err)
+
+		params := createOrderParams(requests)
+		require.Len(t, params, 2)
+		assert.Equal(t, map[string]interface{}{
+			"instrument_name": "BTC_USDT",
+			"side":            "SELL",
+			"type":            "LIMIT",
+			"price":           "25000",
+			"quantity":        "0.5",
+		}, params[0])
+		assert.Equal(t, "0.75", params[1]["quantity"])
+
+		assert.Equal(t, "0.625", result.FilledQuantity.String())
+		assert.Equal(t, "0.375", result.RemainingQuantity.String())
+
+		var cancels int
+		for _, method := range methods(requests) {
+			if method == cdcexchange.MethodCancelOrder {
+				cancels++
+			}
+		}
+		assert.Equal(t, 2, cancels)
+	})
+}
+
+// twapLimitRequest returns a request for 4 LIMIT child orders of 0.25 over an hour.
+func twapLimitRequest() cdcexchange.TWAPRequest {
+	return cdcexchange.TWAPRequest{
+		InstrumentName: "BTC_USDT",
+		Side:           cdcexchange.OrderSideBuy,
+		Type:           cdcexchange.OrderTypeLimit,
+		Price:          cdcexchange.MustParseDecimal("25000"),
+		Quantity:       cdcexchange.MustParseDecimal("1"),
+		Duration:       time.Hour,
+		Slices:         4,
+	}
+}
+
+func TestClient_ExecuteTWAP_Error(t *testing.T) {
+	t.Run("cancels the open child order when ctx is done", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		t.Cleanup(cancel)
+
+		var requests []api.Request
+		// ctx is done while the first child order is resting, after filling half of its quantity.
+		client := twapServer(t, cdcexchange.OrderStatusCancelled, "0.5", func() { time.AfterFunc(10*time.Millisecond, cancel) }, http.StatusOK, &requests)
+
+		result, err := client.ExecuteTWAP(ctx, twapLimitRequest())
+		assert.True(t, errors.Is(err, context.Canceled))
+
+		require.NotNil(t, result)
+		require.Len(t, result.Orders, 1)
+		assert.Equal(t, "0.125", result.FilledQuantity.String())
+		assert.Equal(t, "0.875", result.RemainingQuantity.String())
+
+		require.Equal(t, []string{
+			cdcexchange.MethodGetInstruments,
+			cdcexchange.MethodCreateOrder,
+			cdcexchange.MethodCancelOrder,
+			cdcexchange.MethodGetOrderDetail,
+		}, methods(requests))
+		assert.Equal(t, map[string]interface{}{"instrument_name": "BTC_USDT", "order_id": "1"}, requests[2].Params)
+	})
+
+	t.Run("returns the error cancelling the open child order", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		t.Cleanup(cancel)
+
+		var requests []api.Request
+		client := twapServer(t, cdcexchange.OrderStatusActive, "0.5", func() { time.AfterFunc(10*time.Millisecond, cancel) }, http.StatusBadRequest, &requests)
+
+		result, err := client.ExecuteTWAP(ctx, twapLimitRequest())
+		assert.True(t, errors.Is(err, context.Canceled))
+		assert.True(t, errors.Is(err, cdcerrors.ErrInvalidOrderID))
+
+		require.NotNil(t, result)
+		assert.Equal(t, "0.125", result.FilledQuantity.String())
+	})
+
+	t.Run("returns error when params are invalid", func(t *testing.T) {
+		client, err := cdcexchange.New("api key", "secret key")
+		require.NoError(t, err)
+
+		valid := cdcexchange.TWAPRequest{
+			InstrumentName: "BTC_USDT",
+			Side:           cdcexchange.OrderSideBuy,
+			Type:           cdcexchange.OrderTypeMarket,
+			Quantity:       cdcexchange.MustParseDecimal("1"),
+			Duration:       time.Minute,
+			Slices:         3,
+		}
+
+		tests := []struct {
+			name        string
+			modify      func(req *cdcexchange.TWAPRequest)
+			expectedErr error
+		}{
+			{
+				name:        "empty instrument",
+				modify:      func(req *cdcexchange.TWAPRequest) { req.InstrumentName = "" },
+				expectedErr: cdcerrors.InvalidParameterError{Parameter: "req.InstrumentName", Reason: "cannot be empty"},
+			},
+			{
+				name:        "invalid side",
+				modify:      func(req *cdcexchange.TWAPRequest) { req.Side = "" },
+				expectedErr: cdcerrors.InvalidParameterError{Parameter: "req.Side", Reason: "must be BUY or SELL"},
+			},
+			{
+				name:        "unsupported type",
+				modify:      func(req *cdcexchange.TWAPRequest) { req.Type = cdcexchange.OrderTypeStopLoss },
+				expectedErr: cdcerrors.InvalidParameterError{Parameter: "req.Type", Reason: "must be LIMIT or MARKET"},
+			},
+			{
+				name:        "limit order without price",
+				modify:      func(req *cdcexchange.TWAPRequest) { req.Type = cdcexchange.OrderTypeLimit },
+				expectedErr: cdcerrors.InvalidParameterError{Parameter: "req.Price", Reason: "must be greater than 0 for LIMIT orders"},
+			},
+			{
+				name:        "market order with price",
+				modify:      func(req *cdcexchange.TWAPRequest) { req.Price = cdcexchange.MustParseDecimal("25000") },
+				expectedErr: cdcerrors.InvalidParameterError{Parameter: "req.Price", Reason: "can only be provided for LIMIT orders"},
+			},
+			{
+				name:        "empty quantity",
+				modify:      func(req *cdcexchange.TWAPRequest) { req.Quantity = cdcexchange.Decimal{} },
+				expectedErr: cdcerrors.InvalidParameterError{Parameter: "req.Quantity", Reason: "must be greater than 0"},
+			},
+			{
+				name:        "negative duration",
+				modify:      func(req *cdcexchange.TWAPRequest) { req.Duration = -time.Second },
+				expectedErr: cdcerrors.InvalidParameterError{Parameter: "req.Duration", Reason: "cannot be less than 0"},
+			},
+			{
+				name:        "no slices",
+				modify:      func(req *cdcexchange.TWAPRequest) { req.Slices = 0 },
+				expectedErr: cdcerrors.InvalidParameterError{Parameter: "req.Slices", Reason: "must be at least 1"},
+			},
+		}
+		for _, tt := range tests {
+			tt := tt
+			t.Run(tt.name, func(t *testing.T) {
+				req := valid
+				tt.modify(&req)
+
+				_, err := client.ExecuteTWAP(context.Background(), req)
+				assert.Equal(t, tt.expectedErr, err)
+			})
+		}
+	})
+}
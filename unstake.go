@@ -0,0 +1,133 @@
+package cdcexchange
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/sngyai/go-cryptocom/v2/errors"
+	"github.com/sngyai/go-cryptocom/v2/internal/api"
+	"github.com/sngyai/go-cryptocom/v2/internal/auth"
+)
+
+const (
+	methodUnstake = "private/staking/unstake"
+
+	// maxStakingQuantityDecimals is the maximum number of decimal places accepted for staking quantities.
+	maxStakingQuantityDecimals = 8
+)
+
+type (
+	// UnstakeRequest is the request params sent for the private/staking/unstake API.
+	UnstakeRequest struct {
+		// InstrumentName is the staking instrument name (e.g. SOL.staked).
+		InstrumentName string `json:"instrument_name"`
+		// Quantity is the quantity to unstake, with at most 8 decimal places.
+		Quantity Decimal `json:"quantity"`
+	}
+
+	// UnstakeResponse is the base response returned from the private/staking/unstake API.
+	UnstakeResponse struct {
+		// BaseResponse is the common response fields.
+		BaseResponse
+		// Result is the response attributes of the endpoint.
+		Result UnstakeResult `json:"result"`
+	}
+
+	// UnstakeResult is the result returned from the private/staking/unstake API.
+	UnstakeResult struct {
+		// StakingID is the unique identifier of the unstake request.
+		StakingID string `json:"staking_id"`
+		// InstrumentName is the staking instrument name (e.g. SOL.staked).
+		InstrumentName string `json:"instrument_name"`
+		// Status is the status of the unstake request.
+		Status StakingStatus `json:"status"`
+		// Quantity is the quantity requested to be unstaked.
+		Quantity Decimal `json:"quantity"`
+		// UnderlyingInstName is the underlying instrument name (e.g. SOL).
+		UnderlyingInstName string `json:"underlying_inst_name"`
+		// Reason is the reason code, set when the request is rejected.
+		Reason string `json:"reason"`
+		// Raw is the raw JSON of the result, for fields not decoded by this package.
+		Raw json.RawMessage `json:"-"`
+	}
+)
+
+// Unstake creates a request to unstake a quantity of an instrument.
+//
+// Method: private/staking/unstake
+func (c *Client) Unstake(ctx context.Context, req UnstakeRequest) (*UnstakeResult, error) {
+	if req.InstrumentName == "" {
+		return nil, errors.InvalidParameterError{Parameter: "req.InstrumentName", Reason: "cannot be empty"}
+	}
+	if err := validateStakingQuantity("req.Quantity", req.Quantity); err != nil {
+		return nil, err
+	}
+
+	var (
+		id        = c.idGenerator.Generate()
+		timestamp = c.now().UnixMilli()
+		params    = make(map[string]interface{})
+	)
+
+	creds, err := c.credentials(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	params["instrument_name"] = req.InstrumentName
+	params["quantity"] = req.Quantity
+
+	signature, err := c.signatureGenerator.GenerateSignature(auth.SignatureRequest{
+		APIKey:    creds.APIKey,
+		SecretKey: creds.SecretKey,
+		ID:        id,
+		Method:    methodUnstake,
+		Timestamp: timestamp,
+		Params:    params,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create signature: %w", err)
+	}
+
+	body := api.Request{
+		ID:        id,
+		Method:    methodUnstake,
+		Nonce:     timestamp,
+		Params:    params,
+		Signature: signature,
+		APIKey:    creds.APIKey,
+	}
+
+	var unstakeResponse UnstakeResponse
+	statusCode, err := c.requester.Post(ctx, body, methodUnstake, &unstakeResponse)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute post request: %w", err)
+	}
+
+	if err := c.requester.CheckResponse(statusCode, api.BaseResponse(unstakeResponse.BaseResponse)); err != nil {
+		return nil, fmt.Errorf("error received in response: %w", err)
+	}
+
+	unstakeResponse.Result.Raw = unstakeResponse.RawResult
+
+	return &unstakeResponse.Result, nil
+}
+
+// validateStakingQuantity checks that quantity is positive and does not exceed the staking precision.
+func validateStakingQuantity(parameter string, quantity Decimal) error {
+	if quantity.Sign() <= 0 {
+		return errors.InvalidParameterError{Parameter: parameter, Reason: "must be greater than 0"}
+	}
+
+	s := quantity.String()
+	if i := strings.IndexByte(s, '.'); i >= 0 && len(s)-i-1 > maxStakingQuantityDecimals {
+		return errors.InvalidParameterError{
+			Parameter: parameter,
+			Reason:    fmt.Sprintf("cannot have more than %d decimal places", maxStakingQuantityDecimals),
+		}
+	}
+
+	return nil
+}
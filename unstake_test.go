@@ -0,0 +1,232 @@
+package cdcexchange_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/jonboulle/clockwork"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	cdcexchange "github.com/sngyai/go-cryptocom/v2"
+	cdcerrors "github.com/sngyai/go-cryptocom/v2/errors"
+	"github.com/sngyai/go-cryptocom/v2/internal/api"
+	"github.com/sngyai/go-cryptocom/v2/internal/auth"
+	id_mocks "github.com/sngyai/go-cryptocom/v2/internal/mocks/id"
+	signature_mocks "github.com/sngyai/go-cryptocom/v2/internal/mocks/signature"
+)
+
+func TestClient_Unstake_Error(t *testing.T) {
+	const (
+		apiKey         = "some api key"
+		secretKey      = "some secret key"
+		id             = int64(1234)
+		instrumentName = "SOL.staked"
+	)
+	var (
+		quantity = cdcexchange.MustParseDecimal("1.5")
+	)
+	testErr := errors.New("some error")
+
+	tests := []struct {
+		name         string
+		req          cdcexchange.UnstakeRequest
+		client       http.Client
+		signatureErr error
+		expectedErr  error
+	}{
+		{
+			name:        "returns error when instrument name is empty",
+			req:         cdcexchange.UnstakeRequest{Quantity: quantity},
+			expectedErr: cdcerrors.InvalidParameterError{Parameter: "req.InstrumentName", Reason: "cannot be empty"},
+		},
+		{
+			name:        "returns error when quantity is not positive",
+			req:         cdcexchange.UnstakeRequest{InstrumentName: instrumentName, Quantity: cdcexchange.MustParseDecimal("-1")},
+			expectedErr: cdcerrors.InvalidParameterError{Parameter: "req.Quantity", Reason: "must be greater than 0"},
+		},
+		{
+			name:        "returns error when quantity has too many decimal places",
+			req:         cdcexchange.UnstakeRequest{InstrumentName: instrumentName, Quantity: cdcexchange.MustParseDecimal("0.123456789")},
+			expectedErr: cdcerrors.InvalidParameterError{Parameter: "req.Quantity", Reason: "cannot have more than 8 decimal places"},
+		},
+		{
+			name:         "returns error given error generating signature",
+			req:          cdcexchange.UnstakeRequest{InstrumentName: instrumentName, Quantity: quantity},
+			signatureErr: testErr,
+			expectedErr:  testErr,
+		},
+		{
+			name: "returns error given error making request",
+			req:  cdcexchange.UnstakeRequest{InstrumentName: instrumentName, Quantity: quantity},
+			client: http.Client{
+				Transport: roundTripper{
+					err: testErr,
+				},
+			},
+			expectedErr: testErr,
+		},
+		{
+			name: "returns error given error response",
+			req:  cdcexchange.UnstakeRequest{InstrumentName: instrumentName, Quantity: quantity},
+			client: http.Client{
+				Transport: roundTripper{
+					statusCode: http.StatusTeapot,
+					response: api.BaseResponse{
+						Code: "20002",
+					},
+				},
+			},
+			expectedErr: cdcerrors.ResponseError{
+				Code:           20002,
+				HTTPStatusCode: http.StatusTeapot,
+				Err:            cdcerrors.ErrNegativeBalance,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl, ctx := gomock.WithContext(context.Background(), t)
+			t.Cleanup(ctrl.Finish)
+
+			var (
+				idGenerator        = id_mocks.NewMockIDGenerator(ctrl)
+				signatureGenerator = signature_mocks.NewMockSignatureGenerator(ctrl)
+				now                = time.Now()
+				clock              = clockwork.NewFakeClockAt(now)
+			)
+
+			client, err := cdcexchange.New(apiKey, secretKey,
+				cdcexchange.WithIDGenerator(idGenerator),
+				cdcexchange.WithClock(clock),
+				cdcexchange.WithHTTPClient(&tt.client),
+				cdcexchange.WithSignatureGenerator(signatureGenerator),
+			)
+			require.NoError(t, err)
+
+			var invalidParameterError cdcerrors.InvalidParameterError
+			if !errors.As(tt.expectedErr, &invalidParameterError) {
+				idGenerator.EXPECT().Generate().Return(id)
+				signatureGenerator.EXPECT().GenerateSignature(auth.SignatureRequest{
+					APIKey:    apiKey,
+					SecretKey: secretKey,
+					ID:        id,
+					Method:    cdcexchange.MethodUnstake,
+					Timestamp: now.UnixMilli(),
+					Params: map[string]interface{}{
+						"instrument_name": instrumentName,
+						"quantity":        quantity,
+					},
+				}).Return("signature", tt.signatureErr)
+			}
+
+			res, err := client.Unstake(ctx, tt.req)
+			require.Error(t, err)
+
+			assert.Nil(t, res)
+			assert.True(t, errors.Is(err, tt.expectedErr))
+		})
+	}
+}
+
+func TestClient_Unstake_Success(t *testing.T) {
+	const (
+		apiKey    = "some api key"
+		secretKey = "some secret key"
+		id        = int64(1234)
+		signature = "some signature"
+
+		instrumentName = "SOL.staked"
+	)
+	var (
+		quantity = cdcexchange.MustParseDecimal("0.12345678")
+	)
+	now := time.Now()
+
+	ctrl, ctx := gomock.WithContext(context.Background(), t)
+	t.Cleanup(ctrl.Finish)
+
+	var (
+		signatureGenerator = signature_mocks.NewMockSignatureGenerator(ctrl)
+		idGenerator        = id_mocks.NewMockIDGenerator(ctrl)
+		clock              = clockwork.NewFakeClockAt(now)
+	)
+
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Contains(t, r.URL.Path, cdcexchange.MethodUnstake)
+		t.Cleanup(func() { require.NoError(t, r.Body.Close()) })
+
+		var body api.Request
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+
+		assert.Equal(t, cdcexchange.MethodUnstake, body.Method)
+		assert.Equal(t, id, body.ID)
+		assert.Equal(t, apiKey, body.APIKey)
+		assert.Equal(t, signature, body.Signature)
+		assert.Equal(t, instrumentName, body.Params["instrument_name"])
+		assert.Equal(t, "0.12345678", body.Params["quantity"])
+
+		_, err := w.Write([]byte(`{
+			"id": 1234,
+			"method": "private/staking/unstake",
+			"code": 0,
+			"result": {
+				"staking_id": "2",
+				"instrument_name": "SOL.staked",
+				"status": "NEW",
+				"quantity": "0.12345678",
+				"underlying_inst_name": "SOL",
+				"reason": "NO_ERROR"
+			}
+		}`))
+		require.NoError(t, err)
+	}))
+	t.Cleanup(s.Close)
+
+	client, err := cdcexchange.New(apiKey, secretKey,
+		cdcexchange.WithIDGenerator(idGenerator),
+		cdcexchange.WithClock(clock),
+		cdcexchange.WithHTTPClient(s.Client()),
+		cdcexchange.WithBaseURL(fmt.Sprintf("%s/", s.URL)),
+		cdcexchange.WithSignatureGenerator(signatureGenerator),
+	)
+	require.NoError(t, err)
+
+	idGenerator.EXPECT().Generate().Return(id)
+	signatureGenerator.EXPECT().GenerateSignature(auth.SignatureRequest{
+		APIKey:    apiKey,
+		SecretKey: secretKey,
+		ID:        id,
+		Method:    cdcexchange.MethodUnstake,
+		Timestamp: now.UnixMilli(),
+		Params: map[string]interface{}{
+			"instrument_name": instrumentName,
+			"quantity":        quantity,
+		},
+	}).Return(signature, nil)
+
+	res, err := client.Unstake(ctx, cdcexchange.UnstakeRequest{
+		InstrumentName: instrumentName,
+		Quantity:       quantity,
+	})
+	require.NoError(t, err)
+
+	require.NotEmpty(t, res.Raw)
+	res.Raw = nil
+
+	assert.Equal(t, &cdcexchange.UnstakeResult{
+		StakingID:          "2",
+		InstrumentName:     instrumentName,
+		Status:             cdcexchange.StakingStatusNew,
+		Quantity:           quantity,
+		UnderlyingInstName: "SOL",
+		Reason:             "NO_ERROR",
+	}, res)
+}
@@ -2,11 +2,12 @@ package cdcexchange
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"time"
 
-	"github.com/sngyai/go-cryptocom/internal/api"
-	"github.com/sngyai/go-cryptocom/internal/auth"
+	"github.com/sngyai/go-cryptocom/v2/internal/api"
+	"github.com/sngyai/go-cryptocom/v2/internal/auth"
 )
 
 const (
@@ -14,54 +15,70 @@ const (
 )
 
 type (
+	// UserBalance is the balance of the account at a point in time.
 	UserBalance struct {
-		T int64  `json:"t"`
-		C string `json:"c"`
+		// Timestamp is the time of the balance (milliseconds since the Unix epoch).
+		Timestamp int64 `json:"t"`
+		// Balance is the total cash balance of the account.
+		Balance Decimal `json:"c"`
 	}
+
 	// UserBalanceHistoryRequest is the request params sent for the private/user-balance-history API.
 	UserBalanceHistoryRequest struct {
-		Timeframe string    `json:"timeframe"`
-		EndTime   time.Time `json:"end_time"`
-		Limit     int       `json:"limit"`
+		// Timeframe is the period of the balances (H1 - hourly, D1 - daily (Default)).
+		Timeframe string `json:"timeframe"`
+		// EndTime is the time of the latest balance (Default: now).
+		EndTime time.Time `json:"end_time"`
+		// Limit is the maximum number of balances returned (Default: 10).
+		Limit Optional[int] `json:"limit"`
 	}
 
 	// UserBalanceHistoryResponse is the base response returned from the private/user-balance-history API.
 	UserBalanceHistoryResponse struct {
-		// api.BaseResponse is the common response fields.
-		api.BaseResponse
+		// BaseResponse is the common response fields.
+		BaseResponse
 		// Result is the response attributes of the endpoint.
 		Result UserBalanceHistoryResult `json:"result"`
 	}
 
 	// UserBalanceHistoryResult is the result returned from the private/user-balance-history API.
 	UserBalanceHistoryResult struct {
-		InstrumentName string        `json:"instrument_name"`
-		Data           []UserBalance `json:"data"`
+		// InstrumentName is the currency the balances are denominated in (e.g. USD).
+		InstrumentName string `json:"instrument_name"`
+		// Data is the list of balances, oldest first.
+		Data []UserBalance `json:"data"`
+		// Raw is the raw JSON of the result, for fields not decoded by this package.
+		Raw json.RawMessage `json:"-"`
 	}
 )
 
-// UserBalanceHistory gets all executed trades for a particular instrument.
+// UserBalanceHistory gets the balance history of the account.
 // Method: private/user-balance-history
 func (c *Client) UserBalanceHistory(ctx context.Context, req UserBalanceHistoryRequest) (*UserBalanceHistoryResult, error) {
 	var (
 		id        = c.idGenerator.Generate()
-		timestamp = c.clock.Now().UnixMilli()
+		timestamp = c.now().UnixMilli()
 		params    = make(map[string]interface{})
 	)
 
+	creds, err := c.credentials(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	if req.Timeframe != "" {
 		params["timeframe"] = req.Timeframe
 	}
-	if req.Limit != 0 {
-		params["limit"] = req.Limit
+	if limit, ok := req.Limit.Get(); ok {
+		params["limit"] = limit
 	}
 	if !req.EndTime.IsZero() {
 		params["end_time"] = req.EndTime.UnixMilli()
 	}
 
 	signature, err := c.signatureGenerator.GenerateSignature(auth.SignatureRequest{
-		APIKey:    c.apiKey,
-		SecretKey: c.secretKey,
+		APIKey:    creds.APIKey,
+		SecretKey: creds.SecretKey,
 		ID:        id,
 		Method:    methodUserBalanceHistory,
 		Timestamp: timestamp,
@@ -77,7 +94,7 @@ func (c *Client) UserBalanceHistory(ctx context.Context, req UserBalanceHistoryR
 		Nonce:     timestamp,
 		Params:    params,
 		Signature: signature,
-		APIKey:    c.apiKey,
+		APIKey:    creds.APIKey,
 		Version:   api.V1,
 	}
 
@@ -87,9 +104,11 @@ func (c *Client) UserBalanceHistory(ctx context.Context, req UserBalanceHistoryR
 		return nil, fmt.Errorf("failed to execute post request: %w", err)
 	}
 
-	if err := c.requester.CheckErrorResponse(statusCode, userBalanceHistoryResponse.Code); err != nil {
+	if err := c.requester.CheckResponse(statusCode, api.BaseResponse(userBalanceHistoryResponse.BaseResponse)); err != nil {
 		return nil, fmt.Errorf("error received in response: %w", err)
 	}
 
+	userBalanceHistoryResponse.Result.Raw = userBalanceHistoryResponse.RawResult
+
 	return &userBalanceHistoryResponse.Result, nil
 }
@@ -0,0 +1,123 @@
+package cdcexchange
+
+import (
+	"context"
+	stderrors "errors"
+	"fmt"
+	"time"
+
+	"github.com/sngyai/go-cryptocom/v2/errors"
+)
+
+const (
+	// defaultWaitBackoff is the default wait between the first polls of WaitForOrderFinal.
+	defaultWaitBackoff = 500 * time.Millisecond
+	// defaultWaitMaxBackoff is the default maximum wait between polls of WaitForOrderFinal.
+	defaultWaitMaxBackoff = 5 * time.Second
+)
+
+type (
+	// WaitOption represents optional configurations for WaitForOrderFinal.
+	WaitOption func(*waitOptions) error
+
+	waitOptions struct {
+		backoff    time.Duration
+		maxBackoff time.Duration
+	}
+)
+
+// WithWaitBackoff will make WaitForOrderFinal wait backoff between the first polls (Default: 500ms),
+// doubled after each poll up to maxBackoff (Default: 5s).
+func WithWaitBackoff(backoff time.Duration, maxBackoff time.Duration) WaitOption {
+	return func(o *waitOptions) error {
+		if backoff <= 0 {
+			return errors.InvalidParameterError{Parameter: "backoff", Reason: "must be greater than 0"}
+		}
+		if maxBackoff < backoff {
+			return errors.InvalidParameterError{Parameter: "maxBackoff", Reason: "cannot be less than backoff"}
+		}
+
+		o.backoff = backoff
+		o.maxBackoff = maxBackoff
+
+		return nil
+	}
+}
+
+// IsFinal returns whether s is a terminal status (CANCELED, FILLED, REJECTED or EXPIRED),
+// after which the order can no longer change.
+func (s OrderStatus) IsFinal() bool {
+	switch s {
+	case OrderStatusCancelled, OrderStatusFilled, OrderStatusRejected, OrderStatusExpired:
+		return true
+	default:
+		return false
+	}
+}
+
+// WaitForOrderFinal waits until the order with orderID reaches a final status (see OrderStatus.IsFinal),
+// or ctx is done, and returns the final order.
+//
+// The order is polled with GetOrderDetail with an exponential backoff (see WithWaitBackoff).
+// As orders are created asynchronously, an order which cannot be found yet is polled again.
+//
+// The order is always polled, as this package has no websocket client yet to receive user.order updates from,
+// so each wait costs at least one private/get-order-detail request (which counts towards its rate limit).
+func (c *Client) WaitForOrderFinal(ctx context.Context, orderID string, opts ...WaitOption) (*Order, error) {
+	if orderID == "" {
+		return nil, errors.InvalidParameterError{Parameter: "orderID", Reason: "cannot be empty"}
+	}
+
+	return c.waitForOrderFinal(ctx, orderID, func(ctx context.Context) (*GetOrderDetailResult, error) {
+		return c.GetOrderDetail(ctx, orderID)
+	}, opts)
+}
+
+// WaitForOrderFinalByClientOID waits until the order with clientOID reaches a final status (see OrderStatus.IsFinal),
+// or ctx is done, and returns the final order.
+//
+// This allows an order to be waited for even if the response to CreateOrder was lost (see WaitForOrderFinal).
+func (c *Client) WaitForOrderFinalByClientOID(ctx context.Context, clientOID string, opts ...WaitOption) (*Order, error) {
+	if clientOID == "" {
+		return nil, errors.InvalidParameterError{Parameter: "clientOID", Reason: "cannot be empty"}
+	}
+
+	return c.waitForOrderFinal(ctx, clientOID, func(ctx context.Context) (*GetOrderDetailResult, error) {
+		return c.GetOrderDetailByClientOID(ctx, clientOID)
+	}, opts)
+}
+
+// waitForOrderFinal polls the order identified by ref with get until it reaches a final status, or ctx is done.
+func (c *Client) waitForOrderFinal(
+	ctx context.Context,
+	ref string,
+	get func(ctx context.Context) (*GetOrderDetailResult, error),
+	opts []WaitOption,
+) (*Order, error) {
+	o := waitOptions{backoff: defaultWaitBackoff, maxBackoff: defaultWaitMaxBackoff}
+	for _, opt := range opts {
+		if err := opt(&o); err != nil {
+			return nil, err
+		}
+	}
+
+	for backoff := o.backoff; ; backoff = min(backoff*2, o.maxBackoff) {
+		detail, err := get(ctx)
+		switch {
+		case err == nil:
+			if detail.OrderInfo.Status.IsFinal() {
+				return &detail.OrderInfo, nil
+			}
+		case stderrors.Is(err, errors.ErrInvalidOrderID), stderrors.Is(err, errors.ErrNotFound):
+			// the order has not been created yet.
+		default:
+			return nil, err
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, fmt.Errorf("order %s did not reach a final status: %w", ref, ctx.Err())
+		case <-c.clock.After(backoff):
+		}
+	}
+}
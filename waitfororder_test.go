@@ -0,0 +1,141 @@
+package cdcexchange_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	cdcexchange "github.com/sngyai/go-cryptocom/v2"
+	cdcerrors "github.com/sngyai/go-cryptocom/v2/errors"
+	"github.com/sngyai/go-cryptocom/v2/internal/api"
+)
+
+type orderDetailRes struct {
+	statusCode int
+	body       string
+}
+
+// orderDetailServer returns a client whose get-order-detail requests are answered with responses in order
+// (the last response is repeated), recording the params of the requests.
+func orderDetailServer(t *testing.T, responses []orderDetailRes, params *[]map[string]interface{}) *cdcexchange.Client {
+	t.Helper()
+
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Contains(t, r.URL.Path, cdcexchange.MethodGetOrderDetail)
+
+		var body api.Request
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		*params = append(*params, body.Params)
+
+		res := responses[min(len(*params), len(responses))-1]
+		w.WriteHeader(res.statusCode)
+		_, err := w.Write([]byte(res.body))
+		require.NoError(t, err)
+	}))
+	t.Cleanup(s.Close)
+
+	client, err := cdcexchange.New("api key", "secret key",
+		cdcexchange.WithHTTPClient(s.Client()),
+		cdcexchange.WithBaseURL(fmt.Sprintf("%s/", s.URL)),
+	)
+	require.NoError(t, err)
+
+	return client
+}
+
+func orderDetailResponse(status cdcexchange.OrderStatus) orderDetailRes {
+	return orderDetailRes{
+		statusCode: http.StatusOK,
+		body:       fmt.Sprintf(`{"id": 1, "code": 0, "result": {"trade_list": [], "order_info": {"order_id": "1", "client_oid": "my-order", "status": %q}}}`, status),
+	}
+}
+
+func TestOrderStatus_IsFinal(t *testing.T) {
+	for _, status := range []cdcexchange.OrderStatus{cdcexchange.OrderStatusCancelled, cdcexchange.OrderStatusFilled, cdcexchange.OrderStatusRejected, cdcexchange.OrderStatusExpired} {
+		assert.True(t, status.IsFinal(), status)
+	}
+	for _, status := range []cdcexchange.OrderStatus{cdcexchange.OrderStatusNew, cdcexchange.OrderStatusPending, cdcexchange.OrderStatusActive, ""} {
+		assert.False(t, status.IsFinal(), status)
+	}
+}
+
+func TestClient_WaitForOrderFinal(t *testing.T) {
+	var params []map[string]interface{}
+	client := orderDetailServer(t, []orderDetailRes{
+		{statusCode: http.StatusNotFound, body: `{"id": 1, "code": 40401}`},
+		orderDetailResponse(cdcexchange.OrderStatusActive),
+		orderDetailResponse(cdcexchange.OrderStatusFilled),
+	}, &params)
+
+	order, err := client.WaitForOrderFinal(context.Background(), "1", cdcexchange.WithWaitBackoff(time.Millisecond, 2*time.Millisecond))
+	require.NoError(t, err)
+
+	assert.Equal(t, cdcexchange.OrderStatusFilled, order.Status)
+	assert.Equal(t, "1", order.OrderID)
+	assert.Len(t, params, 3)
+	assert.Equal(t, map[string]interface{}{"order_id": "1"}, params[0])
+}
+
+func TestClient_WaitForOrderFinalByClientOID(t *testing.T) {
+	var params []map[string]interface{}
+	client := orderDetailServer(t, []orderDetailRes{orderDetailResponse(cdcexchange.OrderStatusCancelled)}, &params)
+
+	order, err := client.WaitForOrderFinalByClientOID(context.Background(), "my-order")
+	require.NoError(t, err)
+
+	assert.Equal(t, cdcexchange.OrderStatusCancelled, order.Status)
+	assert.Equal(t, []map[string]interface{}{{"client_oid": "my-order"}}, params)
+}
+
+func TestClient_WaitForOrderFinal_Error(t *testing.T) {
+	t.Run("returns error when ctx is done before the order is final", func(t *testing.T) {
+		var params []map[string]interface{}
+		client := orderDetailServer(t, []orderDetailRes{orderDetailResponse(cdcexchange.OrderStatusActive)}, &params)
+
+		ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+		t.Cleanup(cancel)
+
+		_, err := client.WaitForOrderFinal(ctx, "1", cdcexchange.WithWaitBackoff(time.Millisecond, time.Millisecond))
+		assert.True(t, errors.Is(err, context.DeadlineExceeded))
+		assert.NotEmpty(t, params)
+	})
+
+	t.Run("returns error received in response", func(t *testing.T) {
+		var params []map[string]interface{}
+		client := orderDetailServer(t, []orderDetailRes{{statusCode: http.StatusUnauthorized, body: `{"id": 1, "code": 10002}`}}, &params)
+
+		_, err := client.WaitForOrderFinal(context.Background(), "1")
+		assert.True(t, errors.Is(err, cdcerrors.ErrUnauthorized))
+		assert.Len(t, params, 1)
+	})
+
+	t.Run("returns error when order id is empty", func(t *testing.T) {
+		client, err := cdcexchange.New("api key", "secret key")
+		require.NoError(t, err)
+
+		_, err = client.WaitForOrderFinal(context.Background(), "")
+		assert.Equal(t, cdcerrors.InvalidParameterError{Parameter: "orderID", Reason: "cannot be empty"}, err)
+
+		_, err = client.WaitForOrderFinalByClientOID(context.Background(), "")
+		assert.Equal(t, cdcerrors.InvalidParameterError{Parameter: "clientOID", Reason: "cannot be empty"}, err)
+	})
+
+	t.Run("returns error when backoff is invalid", func(t *testing.T) {
+		client, err := cdcexchange.New("api key", "secret key")
+		require.NoError(t, err)
+
+		_, err = client.WaitForOrderFinal(context.Background(), "1", cdcexchange.WithWaitBackoff(0, time.Second))
+		assert.Equal(t, cdcerrors.InvalidParameterError{Parameter: "backoff", Reason: "must be greater than 0"}, err)
+
+		_, err = client.WaitForOrderFinal(context.Background(), "1", cdcexchange.WithWaitBackoff(time.Second, time.Millisecond))
+		assert.Equal(t, cdcerrors.InvalidParameterError{Parameter: "maxBackoff", Reason: "cannot be less than backoff"}, err)
+	})
+}